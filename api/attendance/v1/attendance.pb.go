@@ -0,0 +1,832 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: attendance/v1/attendance.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttendanceRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId uint32                 `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 1 出勤，2 缺勤，3 迟到，4 请假
+	Status        int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttendanceRecord) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AttendanceSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 日期，格式 2006-01-02
+	Date          string              `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	GroupLabel    string              `protobuf:"bytes,3,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
+	TeacherId     uint32              `protobuf:"varint,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Records       []*AttendanceRecord `protobuf:"bytes,5,rep,name=records,proto3" json:"records,omitempty"`
+	CreatedAt     string              `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string              `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceSession) Reset() {
+	*x = AttendanceSession{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceSession) ProtoMessage() {}
+
+func (x *AttendanceSession) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceSession.ProtoReflect.Descriptor instead.
+func (*AttendanceSession) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttendanceSession) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttendanceSession) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AttendanceSession) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
+func (x *AttendanceSession) GetTeacherId() uint32 {
+	if x != nil {
+		return x.TeacherId
+	}
+	return 0
+}
+
+func (x *AttendanceSession) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *AttendanceSession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AttendanceSession) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SubmitRollCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	GroupLabel    string                 `protobuf:"bytes,2,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
+	Records       []*AttendanceRecord    `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRollCallRequest) Reset() {
+	*x = SubmitRollCallRequest{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRollCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRollCallRequest) ProtoMessage() {}
+
+func (x *SubmitRollCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRollCallRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRollCallRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitRollCallRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SubmitRollCallRequest) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
+func (x *SubmitRollCallRequest) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type SubmitRollCallReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *AttendanceSession     `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRollCallReply) Reset() {
+	*x = SubmitRollCallReply{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRollCallReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRollCallReply) ProtoMessage() {}
+
+func (x *SubmitRollCallReply) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRollCallReply.ProtoReflect.Descriptor instead.
+func (*SubmitRollCallReply) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitRollCallReply) GetSession() *AttendanceSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetAttendanceSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceSessionRequest) Reset() {
+	*x = GetAttendanceSessionRequest{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceSessionRequest) ProtoMessage() {}
+
+func (x *GetAttendanceSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceSessionRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAttendanceSessionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAttendanceSessionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *AttendanceSession     `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceSessionReply) Reset() {
+	*x = GetAttendanceSessionReply{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceSessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceSessionReply) ProtoMessage() {}
+
+func (x *GetAttendanceSessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceSessionReply.ProtoReflect.Descriptor instead.
+func (*GetAttendanceSessionReply) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAttendanceSessionReply) GetSession() *AttendanceSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type ListAttendanceSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	GroupLabel    string                 `protobuf:"bytes,5,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttendanceSessionsRequest) Reset() {
+	*x = ListAttendanceSessionsRequest{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttendanceSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttendanceSessionsRequest) ProtoMessage() {}
+
+func (x *ListAttendanceSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttendanceSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttendanceSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAttendanceSessionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAttendanceSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAttendanceSessionsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListAttendanceSessionsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListAttendanceSessionsRequest) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
+type ListAttendanceSessionsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 列表不返回出勤记录
+	Sessions      []*AttendanceSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Total         int32                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttendanceSessionsReply) Reset() {
+	*x = ListAttendanceSessionsReply{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttendanceSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttendanceSessionsReply) ProtoMessage() {}
+
+func (x *ListAttendanceSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttendanceSessionsReply.ProtoReflect.Descriptor instead.
+func (*ListAttendanceSessionsReply) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAttendanceSessionsReply) GetSessions() []*AttendanceSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListAttendanceSessionsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListAbsenceRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupLabel    string                 `protobuf:"bytes,3,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAbsenceRatesRequest) Reset() {
+	*x = ListAbsenceRatesRequest{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAbsenceRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAbsenceRatesRequest) ProtoMessage() {}
+
+func (x *ListAbsenceRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAbsenceRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListAbsenceRatesRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAbsenceRatesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListAbsenceRatesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListAbsenceRatesRequest) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
+type AbsenceRate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   uint32                 `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Total       int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Absent      int32                  `protobuf:"varint,4,opt,name=absent,proto3" json:"absent,omitempty"`
+	Late        int32                  `protobuf:"varint,5,opt,name=late,proto3" json:"late,omitempty"`
+	Excused     int32                  `protobuf:"varint,6,opt,name=excused,proto3" json:"excused,omitempty"`
+	// 缺勤次数 / (点名次数 - 请假次数)
+	AbsenceRate   float64 `protobuf:"fixed64,7,opt,name=absence_rate,json=absenceRate,proto3" json:"absence_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsenceRate) Reset() {
+	*x = AbsenceRate{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsenceRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsenceRate) ProtoMessage() {}
+
+func (x *AbsenceRate) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsenceRate.ProtoReflect.Descriptor instead.
+func (*AbsenceRate) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AbsenceRate) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *AbsenceRate) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsenceRate) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AbsenceRate) GetAbsent() int32 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
+}
+
+func (x *AbsenceRate) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *AbsenceRate) GetExcused() int32 {
+	if x != nil {
+		return x.Excused
+	}
+	return 0
+}
+
+func (x *AbsenceRate) GetAbsenceRate() float64 {
+	if x != nil {
+		return x.AbsenceRate
+	}
+	return 0
+}
+
+type ListAbsenceRatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*AbsenceRate         `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAbsenceRatesReply) Reset() {
+	*x = ListAbsenceRatesReply{}
+	mi := &file_attendance_v1_attendance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAbsenceRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAbsenceRatesReply) ProtoMessage() {}
+
+func (x *ListAbsenceRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_v1_attendance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAbsenceRatesReply.ProtoReflect.Descriptor instead.
+func (*ListAbsenceRatesReply) Descriptor() ([]byte, []int) {
+	return file_attendance_v1_attendance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAbsenceRatesReply) GetRates() []*AbsenceRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+var File_attendance_v1_attendance_proto protoreflect.FileDescriptor
+
+const file_attendance_v1_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x1eattendance/v1/attendance.proto\x12\rattendance.v1\x1a\x1cgoogle/api/annotations.proto\"]\n" +
+	"\x10AttendanceRecord\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\rR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xf0\x01\n" +
+	"\x11AttendanceSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1f\n" +
+	"\vgroup_label\x18\x03 \x01(\tR\n" +
+	"groupLabel\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\rR\tteacherId\x129\n" +
+	"\arecords\x18\x05 \x03(\v2\x1f.attendance.v1.AttendanceRecordR\arecords\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x87\x01\n" +
+	"\x15SubmitRollCallRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vgroup_label\x18\x02 \x01(\tR\n" +
+	"groupLabel\x129\n" +
+	"\arecords\x18\x03 \x03(\v2\x1f.attendance.v1.AttendanceRecordR\arecords\"Q\n" +
+	"\x13SubmitRollCallReply\x12:\n" +
+	"\asession\x18\x01 \x01(\v2 .attendance.v1.AttendanceSessionR\asession\"-\n" +
+	"\x1bGetAttendanceSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"W\n" +
+	"\x19GetAttendanceSessionReply\x12:\n" +
+	"\asession\x18\x01 \x01(\v2 .attendance.v1.AttendanceSessionR\asession\"\x95\x01\n" +
+	"\x1dListAttendanceSessionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1f\n" +
+	"\vgroup_label\x18\x05 \x01(\tR\n" +
+	"groupLabel\"q\n" +
+	"\x1bListAttendanceSessionsReply\x12<\n" +
+	"\bsessions\x18\x01 \x03(\v2 .attendance.v1.AttendanceSessionR\bsessions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"^\n" +
+	"\x17ListAbsenceRatesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1f\n" +
+	"\vgroup_label\x18\x03 \x01(\tR\n" +
+	"groupLabel\"\xce\x01\n" +
+	"\vAbsenceRate\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\rR\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06absent\x18\x04 \x01(\x05R\x06absent\x12\x12\n" +
+	"\x04late\x18\x05 \x01(\x05R\x04late\x12\x18\n" +
+	"\aexcused\x18\x06 \x01(\x05R\aexcused\x12!\n" +
+	"\fabsence_rate\x18\a \x01(\x01R\vabsenceRate\"I\n" +
+	"\x15ListAbsenceRatesReply\x120\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1a.attendance.v1.AbsenceRateR\x05rates2\xca\x04\n" +
+	"\x11AttendanceService\x12\x80\x01\n" +
+	"\x0eSubmitRollCall\x12$.attendance.v1.SubmitRollCallRequest\x1a\".attendance.v1.SubmitRollCallReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/attendance/roll-calls\x12\x92\x01\n" +
+	"\x14GetAttendanceSession\x12*.attendance.v1.GetAttendanceSessionRequest\x1a(.attendance.v1.GetAttendanceSessionReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/attendance/sessions/{id}\x12\x93\x01\n" +
+	"\x16ListAttendanceSessions\x12,.attendance.v1.ListAttendanceSessionsRequest\x1a*.attendance.v1.ListAttendanceSessionsReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/attendance/sessions\x12\x86\x01\n" +
+	"\x10ListAbsenceRates\x12&.attendance.v1.ListAbsenceRatesRequest\x1a$.attendance.v1.ListAbsenceRatesReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/attendance/absence-ratesB\x1eZ\x1cstudent/api/attendance/v1;v1b\x06proto3"
+
+var (
+	file_attendance_v1_attendance_proto_rawDescOnce sync.Once
+	file_attendance_v1_attendance_proto_rawDescData []byte
+)
+
+func file_attendance_v1_attendance_proto_rawDescGZIP() []byte {
+	file_attendance_v1_attendance_proto_rawDescOnce.Do(func() {
+		file_attendance_v1_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attendance_v1_attendance_proto_rawDesc), len(file_attendance_v1_attendance_proto_rawDesc)))
+	})
+	return file_attendance_v1_attendance_proto_rawDescData
+}
+
+var file_attendance_v1_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_attendance_v1_attendance_proto_goTypes = []any{
+	(*AttendanceRecord)(nil),              // 0: attendance.v1.AttendanceRecord
+	(*AttendanceSession)(nil),             // 1: attendance.v1.AttendanceSession
+	(*SubmitRollCallRequest)(nil),         // 2: attendance.v1.SubmitRollCallRequest
+	(*SubmitRollCallReply)(nil),           // 3: attendance.v1.SubmitRollCallReply
+	(*GetAttendanceSessionRequest)(nil),   // 4: attendance.v1.GetAttendanceSessionRequest
+	(*GetAttendanceSessionReply)(nil),     // 5: attendance.v1.GetAttendanceSessionReply
+	(*ListAttendanceSessionsRequest)(nil), // 6: attendance.v1.ListAttendanceSessionsRequest
+	(*ListAttendanceSessionsReply)(nil),   // 7: attendance.v1.ListAttendanceSessionsReply
+	(*ListAbsenceRatesRequest)(nil),       // 8: attendance.v1.ListAbsenceRatesRequest
+	(*AbsenceRate)(nil),                   // 9: attendance.v1.AbsenceRate
+	(*ListAbsenceRatesReply)(nil),         // 10: attendance.v1.ListAbsenceRatesReply
+}
+var file_attendance_v1_attendance_proto_depIdxs = []int32{
+	0,  // 0: attendance.v1.AttendanceSession.records:type_name -> attendance.v1.AttendanceRecord
+	0,  // 1: attendance.v1.SubmitRollCallRequest.records:type_name -> attendance.v1.AttendanceRecord
+	1,  // 2: attendance.v1.SubmitRollCallReply.session:type_name -> attendance.v1.AttendanceSession
+	1,  // 3: attendance.v1.GetAttendanceSessionReply.session:type_name -> attendance.v1.AttendanceSession
+	1,  // 4: attendance.v1.ListAttendanceSessionsReply.sessions:type_name -> attendance.v1.AttendanceSession
+	9,  // 5: attendance.v1.ListAbsenceRatesReply.rates:type_name -> attendance.v1.AbsenceRate
+	2,  // 6: attendance.v1.AttendanceService.SubmitRollCall:input_type -> attendance.v1.SubmitRollCallRequest
+	4,  // 7: attendance.v1.AttendanceService.GetAttendanceSession:input_type -> attendance.v1.GetAttendanceSessionRequest
+	6,  // 8: attendance.v1.AttendanceService.ListAttendanceSessions:input_type -> attendance.v1.ListAttendanceSessionsRequest
+	8,  // 9: attendance.v1.AttendanceService.ListAbsenceRates:input_type -> attendance.v1.ListAbsenceRatesRequest
+	3,  // 10: attendance.v1.AttendanceService.SubmitRollCall:output_type -> attendance.v1.SubmitRollCallReply
+	5,  // 11: attendance.v1.AttendanceService.GetAttendanceSession:output_type -> attendance.v1.GetAttendanceSessionReply
+	7,  // 12: attendance.v1.AttendanceService.ListAttendanceSessions:output_type -> attendance.v1.ListAttendanceSessionsReply
+	10, // 13: attendance.v1.AttendanceService.ListAbsenceRates:output_type -> attendance.v1.ListAbsenceRatesReply
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_attendance_v1_attendance_proto_init() }
+func file_attendance_v1_attendance_proto_init() {
+	if File_attendance_v1_attendance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attendance_v1_attendance_proto_rawDesc), len(file_attendance_v1_attendance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attendance_v1_attendance_proto_goTypes,
+		DependencyIndexes: file_attendance_v1_attendance_proto_depIdxs,
+		MessageInfos:      file_attendance_v1_attendance_proto_msgTypes,
+	}.Build()
+	File_attendance_v1_attendance_proto = out.File
+	file_attendance_v1_attendance_proto_goTypes = nil
+	file_attendance_v1_attendance_proto_depIdxs = nil
+}
@@ -0,0 +1,2507 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: course/v1/course.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 学期相关消息
+type AcademicTerm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 日期格式 2006-01-02
+	StartDate     string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedAt     string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicTerm) Reset() {
+	*x = AcademicTerm{}
+	mi := &file_course_v1_course_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicTerm) ProtoMessage() {}
+
+func (x *AcademicTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicTerm.ProtoReflect.Descriptor instead.
+func (*AcademicTerm) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcademicTerm) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcademicTerm) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AcademicTerm) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetAcademicTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcademicTermRequest) Reset() {
+	*x = GetAcademicTermRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcademicTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcademicTermRequest) ProtoMessage() {}
+
+func (x *GetAcademicTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcademicTermRequest.ProtoReflect.Descriptor instead.
+func (*GetAcademicTermRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAcademicTermRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAcademicTermReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *AcademicTerm          `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcademicTermReply) Reset() {
+	*x = GetAcademicTermReply{}
+	mi := &file_course_v1_course_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcademicTermReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcademicTermReply) ProtoMessage() {}
+
+func (x *GetAcademicTermReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcademicTermReply.ProtoReflect.Descriptor instead.
+func (*GetAcademicTermReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAcademicTermReply) GetTerm() *AcademicTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type CreateAcademicTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAcademicTermRequest) Reset() {
+	*x = CreateAcademicTermRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAcademicTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAcademicTermRequest) ProtoMessage() {}
+
+func (x *CreateAcademicTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAcademicTermRequest.ProtoReflect.Descriptor instead.
+func (*CreateAcademicTermRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAcademicTermRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateAcademicTermRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAcademicTermRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateAcademicTermRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CreateAcademicTermReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *AcademicTerm          `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAcademicTermReply) Reset() {
+	*x = CreateAcademicTermReply{}
+	mi := &file_course_v1_course_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAcademicTermReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAcademicTermReply) ProtoMessage() {}
+
+func (x *CreateAcademicTermReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAcademicTermReply.ProtoReflect.Descriptor instead.
+func (*CreateAcademicTermReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAcademicTermReply) GetTerm() *AcademicTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type UpdateAcademicTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAcademicTermRequest) Reset() {
+	*x = UpdateAcademicTermRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAcademicTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAcademicTermRequest) ProtoMessage() {}
+
+func (x *UpdateAcademicTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAcademicTermRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAcademicTermRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAcademicTermRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAcademicTermRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateAcademicTermRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAcademicTermRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateAcademicTermRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type UpdateAcademicTermReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *AcademicTerm          `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAcademicTermReply) Reset() {
+	*x = UpdateAcademicTermReply{}
+	mi := &file_course_v1_course_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAcademicTermReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAcademicTermReply) ProtoMessage() {}
+
+func (x *UpdateAcademicTermReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAcademicTermReply.ProtoReflect.Descriptor instead.
+func (*UpdateAcademicTermReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAcademicTermReply) GetTerm() *AcademicTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type ListAcademicTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAcademicTermsRequest) Reset() {
+	*x = ListAcademicTermsRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAcademicTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAcademicTermsRequest) ProtoMessage() {}
+
+func (x *ListAcademicTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAcademicTermsRequest.ProtoReflect.Descriptor instead.
+func (*ListAcademicTermsRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAcademicTermsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAcademicTermsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAcademicTermsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*AcademicTerm        `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAcademicTermsReply) Reset() {
+	*x = ListAcademicTermsReply{}
+	mi := &file_course_v1_course_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAcademicTermsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAcademicTermsReply) ProtoMessage() {}
+
+func (x *ListAcademicTermsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAcademicTermsReply.ProtoReflect.Descriptor instead.
+func (*ListAcademicTermsReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAcademicTermsReply) GetTerms() []*AcademicTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *ListAcademicTermsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 课程相关消息
+type Course struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Credits       float64                `protobuf:"fixed64,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_course_v1_course_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Course) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Course) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Course) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Course) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Course) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Course) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Course) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Course) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCourseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCourseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseReply) Reset() {
+	*x = GetCourseReply{}
+	mi := &file_course_v1_course_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseReply) ProtoMessage() {}
+
+func (x *GetCourseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseReply.ProtoReflect.Descriptor instead.
+func (*GetCourseReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCourseReply) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type CreateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Credits       float64                `protobuf:"fixed64,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseRequest) ProtoMessage() {}
+
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateCourseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type CreateCourseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCourseReply) Reset() {
+	*x = CreateCourseReply{}
+	mi := &file_course_v1_course_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseReply) ProtoMessage() {}
+
+func (x *CreateCourseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseReply.ProtoReflect.Descriptor instead.
+func (*CreateCourseReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateCourseReply) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Credits       float64                `protobuf:"fixed64,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateCourseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCourseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *UpdateCourseRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateCourseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Course        *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCourseReply) Reset() {
+	*x = UpdateCourseReply{}
+	mi := &file_course_v1_course_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCourseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseReply) ProtoMessage() {}
+
+func (x *UpdateCourseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseReply.ProtoReflect.Descriptor instead.
+func (*UpdateCourseReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateCourseReply) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+type DeleteCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseRequest) Reset() {
+	*x = DeleteCourseRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseRequest) ProtoMessage() {}
+
+func (x *DeleteCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteCourseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCourseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseReply) Reset() {
+	*x = DeleteCourseReply{}
+	mi := &file_course_v1_course_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseReply) ProtoMessage() {}
+
+func (x *DeleteCourseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseReply.ProtoReflect.Descriptor instead.
+func (*DeleteCourseReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteCourseReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCoursesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 按名称或课程编号模糊查询
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesRequest) Reset() {
+	*x = ListCoursesRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesRequest) ProtoMessage() {}
+
+func (x *ListCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCoursesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCoursesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCoursesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCoursesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoursesReply) Reset() {
+	*x = ListCoursesReply{}
+	mi := &file_course_v1_course_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoursesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoursesReply) ProtoMessage() {}
+
+func (x *ListCoursesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoursesReply.ProtoReflect.Descriptor instead.
+func (*ListCoursesReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCoursesReply) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *ListCoursesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 班级相关消息
+type Class struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// 学期编号，必须是已创建的学期
+	Term     string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Teacher  string `protobuf:"bytes,4,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	Capacity int32  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// 当前选课人数
+	Enrolled int32 `protobuf:"varint,6,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	// 0 关闭选课，1 开放选课
+	Status        int32  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	mi := &file_course_v1_course_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Class) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Class) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Class) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Class) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *Class) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Class) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *Class) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Class) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Class) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassRequest) Reset() {
+	*x = GetClassRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassRequest) ProtoMessage() {}
+
+func (x *GetClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassRequest.ProtoReflect.Descriptor instead.
+func (*GetClassRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetClassRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassReply) Reset() {
+	*x = GetClassReply{}
+	mi := &file_course_v1_course_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassReply) ProtoMessage() {}
+
+func (x *GetClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassReply.ProtoReflect.Descriptor instead.
+func (*GetClassReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetClassReply) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type CreateClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Teacher       string                 `protobuf:"bytes,3,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClassRequest) Reset() {
+	*x = CreateClassRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassRequest) ProtoMessage() {}
+
+func (x *CreateClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassRequest.ProtoReflect.Descriptor instead.
+func (*CreateClassRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateClassRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateClassRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *CreateClassRequest) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *CreateClassRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateClassRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type CreateClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClassReply) Reset() {
+	*x = CreateClassReply{}
+	mi := &file_course_v1_course_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassReply) ProtoMessage() {}
+
+func (x *CreateClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassReply.ProtoReflect.Descriptor instead.
+func (*CreateClassReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateClassReply) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type UpdateClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Teacher       string                 `protobuf:"bytes,3,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassRequest) Reset() {
+	*x = UpdateClassRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassRequest) ProtoMessage() {}
+
+func (x *UpdateClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClassRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateClassRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateClassRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *UpdateClassRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateClassRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassReply) Reset() {
+	*x = UpdateClassReply{}
+	mi := &file_course_v1_course_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassReply) ProtoMessage() {}
+
+func (x *UpdateClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassReply.ProtoReflect.Descriptor instead.
+func (*UpdateClassReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateClassReply) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type DeleteClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassRequest) Reset() {
+	*x = DeleteClassRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassRequest) ProtoMessage() {}
+
+func (x *DeleteClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClassRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteClassRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassReply) Reset() {
+	*x = DeleteClassReply{}
+	mi := &file_course_v1_course_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassReply) ProtoMessage() {}
+
+func (x *DeleteClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassReply.ProtoReflect.Descriptor instead.
+func (*DeleteClassReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteClassReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term          string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassesRequest) Reset() {
+	*x = ListClassesRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassesRequest) ProtoMessage() {}
+
+func (x *ListClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListClassesRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListClassesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListClassesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClassesRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListClassesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type ListClassesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassesReply) Reset() {
+	*x = ListClassesReply{}
+	mi := &file_course_v1_course_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassesReply) ProtoMessage() {}
+
+func (x *ListClassesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassesReply.ProtoReflect.Descriptor instead.
+func (*ListClassesReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListClassesReply) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *ListClassesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 选课相关消息
+type Enrollment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   uint32                 `protobuf:"varint,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	StudentId uint32                 `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 1 已选课，2 已退课
+	Status        int32  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	EnrolledAt    string `protobuf:"bytes,5,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	DroppedAt     string `protobuf:"bytes,6,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_course_v1_course_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Enrollment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Enrollment) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *Enrollment) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *Enrollment) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Enrollment) GetEnrolledAt() string {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return ""
+}
+
+func (x *Enrollment) GetDroppedAt() string {
+	if x != nil {
+		return x.DroppedAt
+	}
+	return ""
+}
+
+type EnrollStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       uint32                 `protobuf:"varint,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	StudentId     uint32                 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollStudentRequest) Reset() {
+	*x = EnrollStudentRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollStudentRequest) ProtoMessage() {}
+
+func (x *EnrollStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollStudentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollStudentRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EnrollStudentRequest) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *EnrollStudentRequest) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type EnrollStudentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollStudentReply) Reset() {
+	*x = EnrollStudentReply{}
+	mi := &file_course_v1_course_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollStudentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollStudentReply) ProtoMessage() {}
+
+func (x *EnrollStudentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollStudentReply.ProtoReflect.Descriptor instead.
+func (*EnrollStudentReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EnrollStudentReply) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type DropStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       uint32                 `protobuf:"varint,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	StudentId     uint32                 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropStudentRequest) Reset() {
+	*x = DropStudentRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropStudentRequest) ProtoMessage() {}
+
+func (x *DropStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropStudentRequest.ProtoReflect.Descriptor instead.
+func (*DropStudentRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DropStudentRequest) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *DropStudentRequest) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type DropStudentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollment    *Enrollment            `protobuf:"bytes,1,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropStudentReply) Reset() {
+	*x = DropStudentReply{}
+	mi := &file_course_v1_course_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropStudentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropStudentReply) ProtoMessage() {}
+
+func (x *DropStudentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropStudentReply.ProtoReflect.Descriptor instead.
+func (*DropStudentReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DropStudentReply) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+type ListEnrollmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       uint32                 `protobuf:"varint,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentsRequest) Reset() {
+	*x = ListEnrollmentsRequest{}
+	mi := &file_course_v1_course_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentsRequest) ProtoMessage() {}
+
+func (x *ListEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListEnrollmentsRequest) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+type ListEnrollmentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnrollmentsReply) Reset() {
+	*x = ListEnrollmentsReply{}
+	mi := &file_course_v1_course_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnrollmentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnrollmentsReply) ProtoMessage() {}
+
+func (x *ListEnrollmentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_course_v1_course_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnrollmentsReply.ProtoReflect.Descriptor instead.
+func (*ListEnrollmentsReply) Descriptor() ([]byte, []int) {
+	return file_course_v1_course_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEnrollmentsReply) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+var File_course_v1_course_proto protoreflect.FileDescriptor
+
+const file_course_v1_course_proto_rawDesc = "" +
+	"\n" +
+	"\x16course/v1/course.proto\x12\tcourse.v1\x1a\x1cgoogle/api/annotations.proto\"\xbe\x01\n" +
+	"\fAcademicTerm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"(\n" +
+	"\x16GetAcademicTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"C\n" +
+	"\x14GetAcademicTermReply\x12+\n" +
+	"\x04term\x18\x01 \x01(\v2\x17.course.v1.AcademicTermR\x04term\"}\n" +
+	"\x19CreateAcademicTermRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"F\n" +
+	"\x17CreateAcademicTermReply\x12+\n" +
+	"\x04term\x18\x01 \x01(\v2\x17.course.v1.AcademicTermR\x04term\"\x8d\x01\n" +
+	"\x19UpdateAcademicTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"F\n" +
+	"\x17UpdateAcademicTermReply\x12+\n" +
+	"\x04term\x18\x01 \x01(\v2\x17.course.v1.AcademicTermR\x04term\"K\n" +
+	"\x18ListAcademicTermsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"]\n" +
+	"\x16ListAcademicTermsReply\x12-\n" +
+	"\x05terms\x18\x01 \x03(\v2\x17.course.v1.AcademicTermR\x05terms\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xd2\x01\n" +
+	"\x06Course\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\acredits\x18\x05 \x01(\x01R\acredits\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\"\n" +
+	"\x10GetCourseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\";\n" +
+	"\x0eGetCourseReply\x12)\n" +
+	"\x06course\x18\x01 \x01(\v2\x11.course.v1.CourseR\x06course\"\x91\x01\n" +
+	"\x13CreateCourseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\acredits\x18\x04 \x01(\x01R\acredits\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\">\n" +
+	"\x11CreateCourseReply\x12)\n" +
+	"\x06course\x18\x01 \x01(\v2\x11.course.v1.CourseR\x06course\"\xa1\x01\n" +
+	"\x13UpdateCourseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\acredits\x18\x05 \x01(\x01R\acredits\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\">\n" +
+	"\x11UpdateCourseReply\x12)\n" +
+	"\x06course\x18\x01 \x01(\v2\x11.course.v1.CourseR\x06course\"%\n" +
+	"\x13DeleteCourseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"-\n" +
+	"\x11DeleteCourseReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Y\n" +
+	"\x12ListCoursesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"U\n" +
+	"\x10ListCoursesReply\x12+\n" +
+	"\acourses\x18\x01 \x03(\v2\x11.course.v1.CourseR\acourses\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf0\x01\n" +
+	"\x05Class\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12\x18\n" +
+	"\ateacher\x18\x04 \x01(\tR\ateacher\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\benrolled\x18\x06 \x01(\x05R\benrolled\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"!\n" +
+	"\x0fGetClassRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"7\n" +
+	"\rGetClassReply\x12&\n" +
+	"\x05class\x18\x01 \x01(\v2\x10.course.v1.ClassR\x05class\"\x93\x01\n" +
+	"\x12CreateClassRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12\x18\n" +
+	"\ateacher\x18\x03 \x01(\tR\ateacher\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\":\n" +
+	"\x10CreateClassReply\x12&\n" +
+	"\x05class\x18\x01 \x01(\v2\x10.course.v1.ClassR\x05class\"\x86\x01\n" +
+	"\x12UpdateClassRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12\x18\n" +
+	"\ateacher\x18\x03 \x01(\tR\ateacher\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\":\n" +
+	"\x10UpdateClassReply\x12&\n" +
+	"\x05class\x18\x01 \x01(\v2\x10.course.v1.ClassR\x05class\"$\n" +
+	"\x12DeleteClassRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\",\n" +
+	"\x10DeleteClassReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"v\n" +
+	"\x12ListClassesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\"T\n" +
+	"\x10ListClassesReply\x12*\n" +
+	"\aclasses\x18\x01 \x03(\v2\x10.course.v1.ClassR\aclasses\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xae\x01\n" +
+	"\n" +
+	"Enrollment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\rR\aclassId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\rR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1f\n" +
+	"\venrolled_at\x18\x05 \x01(\tR\n" +
+	"enrolledAt\x12\x1d\n" +
+	"\n" +
+	"dropped_at\x18\x06 \x01(\tR\tdroppedAt\"P\n" +
+	"\x14EnrollStudentRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\rR\aclassId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\rR\tstudentId\"K\n" +
+	"\x12EnrollStudentReply\x125\n" +
+	"\n" +
+	"enrollment\x18\x01 \x01(\v2\x15.course.v1.EnrollmentR\n" +
+	"enrollment\"N\n" +
+	"\x12DropStudentRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\rR\aclassId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\rR\tstudentId\"I\n" +
+	"\x10DropStudentReply\x125\n" +
+	"\n" +
+	"enrollment\x18\x01 \x01(\v2\x15.course.v1.EnrollmentR\n" +
+	"enrollment\"3\n" +
+	"\x16ListEnrollmentsRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\rR\aclassId\"O\n" +
+	"\x14ListEnrollmentsReply\x127\n" +
+	"\venrollments\x18\x01 \x03(\v2\x15.course.v1.EnrollmentR\venrollments2\xcc\x0e\n" +
+	"\rCourseService\x12m\n" +
+	"\x0fGetAcademicTerm\x12!.course.v1.GetAcademicTermRequest\x1a\x1f.course.v1.GetAcademicTermReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/terms/{id}\x12t\n" +
+	"\x12CreateAcademicTerm\x12$.course.v1.CreateAcademicTermRequest\x1a\".course.v1.CreateAcademicTermReply\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/terms\x12y\n" +
+	"\x12UpdateAcademicTerm\x12$.course.v1.UpdateAcademicTermRequest\x1a\".course.v1.UpdateAcademicTermReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/terms/{id}\x12n\n" +
+	"\x11ListAcademicTerms\x12#.course.v1.ListAcademicTermsRequest\x1a!.course.v1.ListAcademicTermsReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/terms\x12]\n" +
+	"\tGetCourse\x12\x1b.course.v1.GetCourseRequest\x1a\x19.course.v1.GetCourseReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/courses/{id}\x12d\n" +
+	"\fCreateCourse\x12\x1e.course.v1.CreateCourseRequest\x1a\x1c.course.v1.CreateCourseReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/courses\x12i\n" +
+	"\fUpdateCourse\x12\x1e.course.v1.UpdateCourseRequest\x1a\x1c.course.v1.UpdateCourseReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/courses/{id}\x12f\n" +
+	"\fDeleteCourse\x12\x1e.course.v1.DeleteCourseRequest\x1a\x1c.course.v1.DeleteCourseReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/courses/{id}\x12^\n" +
+	"\vListCourses\x12\x1d.course.v1.ListCoursesRequest\x1a\x1b.course.v1.ListCoursesReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/courses\x12Z\n" +
+	"\bGetClass\x12\x1a.course.v1.GetClassRequest\x1a\x18.course.v1.GetClassReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/classes/{id}\x12a\n" +
+	"\vCreateClass\x12\x1d.course.v1.CreateClassRequest\x1a\x1b.course.v1.CreateClassReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/classes\x12f\n" +
+	"\vUpdateClass\x12\x1d.course.v1.UpdateClassRequest\x1a\x1b.course.v1.UpdateClassReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/classes/{id}\x12c\n" +
+	"\vDeleteClass\x12\x1d.course.v1.DeleteClassRequest\x1a\x1b.course.v1.DeleteClassReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/classes/{id}\x12^\n" +
+	"\vListClasses\x12\x1d.course.v1.ListClassesRequest\x1a\x1b.course.v1.ListClassesReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/classes\x12~\n" +
+	"\rEnrollStudent\x12\x1f.course.v1.EnrollStudentRequest\x1a\x1d.course.v1.EnrollStudentReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/classes/{class_id}/enrollments\x12\x82\x01\n" +
+	"\vDropStudent\x12\x1d.course.v1.DropStudentRequest\x1a\x1b.course.v1.DropStudentReply\"7\x82\xd3\xe4\x93\x021*//v1/classes/{class_id}/enrollments/{student_id}\x12\x81\x01\n" +
+	"\x0fListEnrollments\x12!.course.v1.ListEnrollmentsRequest\x1a\x1f.course.v1.ListEnrollmentsReply\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/classes/{class_id}/enrollmentsB\x1aZ\x18student/api/course/v1;v1b\x06proto3"
+
+var (
+	file_course_v1_course_proto_rawDescOnce sync.Once
+	file_course_v1_course_proto_rawDescData []byte
+)
+
+func file_course_v1_course_proto_rawDescGZIP() []byte {
+	file_course_v1_course_proto_rawDescOnce.Do(func() {
+		file_course_v1_course_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_course_v1_course_proto_rawDesc), len(file_course_v1_course_proto_rawDesc)))
+	})
+	return file_course_v1_course_proto_rawDescData
+}
+
+var file_course_v1_course_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_course_v1_course_proto_goTypes = []any{
+	(*AcademicTerm)(nil),              // 0: course.v1.AcademicTerm
+	(*GetAcademicTermRequest)(nil),    // 1: course.v1.GetAcademicTermRequest
+	(*GetAcademicTermReply)(nil),      // 2: course.v1.GetAcademicTermReply
+	(*CreateAcademicTermRequest)(nil), // 3: course.v1.CreateAcademicTermRequest
+	(*CreateAcademicTermReply)(nil),   // 4: course.v1.CreateAcademicTermReply
+	(*UpdateAcademicTermRequest)(nil), // 5: course.v1.UpdateAcademicTermRequest
+	(*UpdateAcademicTermReply)(nil),   // 6: course.v1.UpdateAcademicTermReply
+	(*ListAcademicTermsRequest)(nil),  // 7: course.v1.ListAcademicTermsRequest
+	(*ListAcademicTermsReply)(nil),    // 8: course.v1.ListAcademicTermsReply
+	(*Course)(nil),                    // 9: course.v1.Course
+	(*GetCourseRequest)(nil),          // 10: course.v1.GetCourseRequest
+	(*GetCourseReply)(nil),            // 11: course.v1.GetCourseReply
+	(*CreateCourseRequest)(nil),       // 12: course.v1.CreateCourseRequest
+	(*CreateCourseReply)(nil),         // 13: course.v1.CreateCourseReply
+	(*UpdateCourseRequest)(nil),       // 14: course.v1.UpdateCourseRequest
+	(*UpdateCourseReply)(nil),         // 15: course.v1.UpdateCourseReply
+	(*DeleteCourseRequest)(nil),       // 16: course.v1.DeleteCourseRequest
+	(*DeleteCourseReply)(nil),         // 17: course.v1.DeleteCourseReply
+	(*ListCoursesRequest)(nil),        // 18: course.v1.ListCoursesRequest
+	(*ListCoursesReply)(nil),          // 19: course.v1.ListCoursesReply
+	(*Class)(nil),                     // 20: course.v1.Class
+	(*GetClassRequest)(nil),           // 21: course.v1.GetClassRequest
+	(*GetClassReply)(nil),             // 22: course.v1.GetClassReply
+	(*CreateClassRequest)(nil),        // 23: course.v1.CreateClassRequest
+	(*CreateClassReply)(nil),          // 24: course.v1.CreateClassReply
+	(*UpdateClassRequest)(nil),        // 25: course.v1.UpdateClassRequest
+	(*UpdateClassReply)(nil),          // 26: course.v1.UpdateClassReply
+	(*DeleteClassRequest)(nil),        // 27: course.v1.DeleteClassRequest
+	(*DeleteClassReply)(nil),          // 28: course.v1.DeleteClassReply
+	(*ListClassesRequest)(nil),        // 29: course.v1.ListClassesRequest
+	(*ListClassesReply)(nil),          // 30: course.v1.ListClassesReply
+	(*Enrollment)(nil),                // 31: course.v1.Enrollment
+	(*EnrollStudentRequest)(nil),      // 32: course.v1.EnrollStudentRequest
+	(*EnrollStudentReply)(nil),        // 33: course.v1.EnrollStudentReply
+	(*DropStudentRequest)(nil),        // 34: course.v1.DropStudentRequest
+	(*DropStudentReply)(nil),          // 35: course.v1.DropStudentReply
+	(*ListEnrollmentsRequest)(nil),    // 36: course.v1.ListEnrollmentsRequest
+	(*ListEnrollmentsReply)(nil),      // 37: course.v1.ListEnrollmentsReply
+}
+var file_course_v1_course_proto_depIdxs = []int32{
+	0,  // 0: course.v1.GetAcademicTermReply.term:type_name -> course.v1.AcademicTerm
+	0,  // 1: course.v1.CreateAcademicTermReply.term:type_name -> course.v1.AcademicTerm
+	0,  // 2: course.v1.UpdateAcademicTermReply.term:type_name -> course.v1.AcademicTerm
+	0,  // 3: course.v1.ListAcademicTermsReply.terms:type_name -> course.v1.AcademicTerm
+	9,  // 4: course.v1.GetCourseReply.course:type_name -> course.v1.Course
+	9,  // 5: course.v1.CreateCourseReply.course:type_name -> course.v1.Course
+	9,  // 6: course.v1.UpdateCourseReply.course:type_name -> course.v1.Course
+	9,  // 7: course.v1.ListCoursesReply.courses:type_name -> course.v1.Course
+	20, // 8: course.v1.GetClassReply.class:type_name -> course.v1.Class
+	20, // 9: course.v1.CreateClassReply.class:type_name -> course.v1.Class
+	20, // 10: course.v1.UpdateClassReply.class:type_name -> course.v1.Class
+	20, // 11: course.v1.ListClassesReply.classes:type_name -> course.v1.Class
+	31, // 12: course.v1.EnrollStudentReply.enrollment:type_name -> course.v1.Enrollment
+	31, // 13: course.v1.DropStudentReply.enrollment:type_name -> course.v1.Enrollment
+	31, // 14: course.v1.ListEnrollmentsReply.enrollments:type_name -> course.v1.Enrollment
+	1,  // 15: course.v1.CourseService.GetAcademicTerm:input_type -> course.v1.GetAcademicTermRequest
+	3,  // 16: course.v1.CourseService.CreateAcademicTerm:input_type -> course.v1.CreateAcademicTermRequest
+	5,  // 17: course.v1.CourseService.UpdateAcademicTerm:input_type -> course.v1.UpdateAcademicTermRequest
+	7,  // 18: course.v1.CourseService.ListAcademicTerms:input_type -> course.v1.ListAcademicTermsRequest
+	10, // 19: course.v1.CourseService.GetCourse:input_type -> course.v1.GetCourseRequest
+	12, // 20: course.v1.CourseService.CreateCourse:input_type -> course.v1.CreateCourseRequest
+	14, // 21: course.v1.CourseService.UpdateCourse:input_type -> course.v1.UpdateCourseRequest
+	16, // 22: course.v1.CourseService.DeleteCourse:input_type -> course.v1.DeleteCourseRequest
+	18, // 23: course.v1.CourseService.ListCourses:input_type -> course.v1.ListCoursesRequest
+	21, // 24: course.v1.CourseService.GetClass:input_type -> course.v1.GetClassRequest
+	23, // 25: course.v1.CourseService.CreateClass:input_type -> course.v1.CreateClassRequest
+	25, // 26: course.v1.CourseService.UpdateClass:input_type -> course.v1.UpdateClassRequest
+	27, // 27: course.v1.CourseService.DeleteClass:input_type -> course.v1.DeleteClassRequest
+	29, // 28: course.v1.CourseService.ListClasses:input_type -> course.v1.ListClassesRequest
+	32, // 29: course.v1.CourseService.EnrollStudent:input_type -> course.v1.EnrollStudentRequest
+	34, // 30: course.v1.CourseService.DropStudent:input_type -> course.v1.DropStudentRequest
+	36, // 31: course.v1.CourseService.ListEnrollments:input_type -> course.v1.ListEnrollmentsRequest
+	2,  // 32: course.v1.CourseService.GetAcademicTerm:output_type -> course.v1.GetAcademicTermReply
+	4,  // 33: course.v1.CourseService.CreateAcademicTerm:output_type -> course.v1.CreateAcademicTermReply
+	6,  // 34: course.v1.CourseService.UpdateAcademicTerm:output_type -> course.v1.UpdateAcademicTermReply
+	8,  // 35: course.v1.CourseService.ListAcademicTerms:output_type -> course.v1.ListAcademicTermsReply
+	11, // 36: course.v1.CourseService.GetCourse:output_type -> course.v1.GetCourseReply
+	13, // 37: course.v1.CourseService.CreateCourse:output_type -> course.v1.CreateCourseReply
+	15, // 38: course.v1.CourseService.UpdateCourse:output_type -> course.v1.UpdateCourseReply
+	17, // 39: course.v1.CourseService.DeleteCourse:output_type -> course.v1.DeleteCourseReply
+	19, // 40: course.v1.CourseService.ListCourses:output_type -> course.v1.ListCoursesReply
+	22, // 41: course.v1.CourseService.GetClass:output_type -> course.v1.GetClassReply
+	24, // 42: course.v1.CourseService.CreateClass:output_type -> course.v1.CreateClassReply
+	26, // 43: course.v1.CourseService.UpdateClass:output_type -> course.v1.UpdateClassReply
+	28, // 44: course.v1.CourseService.DeleteClass:output_type -> course.v1.DeleteClassReply
+	30, // 45: course.v1.CourseService.ListClasses:output_type -> course.v1.ListClassesReply
+	33, // 46: course.v1.CourseService.EnrollStudent:output_type -> course.v1.EnrollStudentReply
+	35, // 47: course.v1.CourseService.DropStudent:output_type -> course.v1.DropStudentReply
+	37, // 48: course.v1.CourseService.ListEnrollments:output_type -> course.v1.ListEnrollmentsReply
+	32, // [32:49] is the sub-list for method output_type
+	15, // [15:32] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_course_v1_course_proto_init() }
+func file_course_v1_course_proto_init() {
+	if File_course_v1_course_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_course_v1_course_proto_rawDesc), len(file_course_v1_course_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_course_v1_course_proto_goTypes,
+		DependencyIndexes: file_course_v1_course_proto_depIdxs,
+		MessageInfos:      file_course_v1_course_proto_msgTypes,
+	}.Build()
+	File_course_v1_course_proto = out.File
+	file_course_v1_course_proto_goTypes = nil
+	file_course_v1_course_proto_depIdxs = nil
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,13 +25,17 @@ const (
 
 // 角色相关消息
 type Role struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 版本号，每次修改加一，HTTP 响应头 ETag 为 "版本号"
+	Version uint32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// 删除时间，只在回收站中返回
+	DeletedAt     string `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +112,20 @@ func (x *Role) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Role) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Role) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
 type GetRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -300,11 +319,15 @@ func (x *CreateRoleResponse) GetRole() *Role {
 }
 
 type UpdateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	// 需要更新的字段，如 "name,info"；为空时更新所有字段
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -367,6 +390,20 @@ func (x *UpdateRoleRequest) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdateRoleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -412,8 +449,10 @@ func (x *UpdateRoleResponse) GetRole() *Role {
 }
 
 type DeleteRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -455,6 +494,13 @@ func (x *DeleteRoleRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeleteRoleRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -500,10 +546,16 @@ func (x *DeleteRoleResponse) GetMessage() string {
 }
 
 type ListRolesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 过滤表达式，如 status in (1, 2) AND age >= 18 AND name = "张*"
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 排序，如 age desc, id
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// 上一次响应中的 next_page_token，为空时从第一页开始
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -559,10 +611,33 @@ func (x *ListRolesRequest) GetName() string {
 	return ""
 }
 
+func (x *ListRolesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListRolesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Roles []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Total int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// 下一页的令牌，为空时表示没有下一页
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -611,17 +686,28 @@ func (x *ListRolesResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListRolesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // 权限相关消息
 type Permission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Resource    string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action      string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status      int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 版本号，每次修改加一，HTTP 响应头 ETag 为 "版本号"
+	Version uint32 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// 删除时间，只在回收站中返回
+	DeletedAt     string `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -712,6 +798,20 @@ func (x *Permission) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Permission) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Permission) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
 type GetPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -921,13 +1021,17 @@ func (x *CreatePermissionResponse) GetPermission() *Permission {
 }
 
 type UpdatePermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Resource    string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action      string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status      int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	// 需要更新的字段，如 "name,info"；为空时更新所有字段
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1004,6 +1108,20 @@ func (x *UpdatePermissionRequest) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdatePermissionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePermissionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdatePermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -1049,8 +1167,10 @@ func (x *UpdatePermissionResponse) GetPermission() *Permission {
 }
 
 type DeletePermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1092,6 +1212,13 @@ func (x *DeletePermissionRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeletePermissionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeletePermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -1137,11 +1264,17 @@ func (x *DeletePermissionResponse) GetMessage() string {
 }
 
 type ListPermissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Resource      string                 `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Resource string                 `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	// 过滤表达式，如 status in (1, 2) AND age >= 18 AND name = "张*"
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 排序，如 age desc, id
+	OrderBy string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// 上一次响应中的 next_page_token，为空时从第一页开始
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1204,10 +1337,33 @@ func (x *ListPermissionsRequest) GetResource() string {
 	return ""
 }
 
+func (x *ListPermissionsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListPermissionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPermissionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListPermissionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Permissions []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Total       int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// 下一页的令牌，为空时表示没有下一页
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1256,6 +1412,13 @@ func (x *ListPermissionsResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListPermissionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // 用户角色相关消息
 type UserRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2091,166 +2254,782 @@ func (x *CheckPermissionResponse) GetHasPermission() bool {
 	return false
 }
 
-var File_rbac_v1_rbac_proto protoreflect.FileDescriptor
+// 回收站相关消息
+type ListDeletedRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_rbac_v1_rbac_proto_rawDesc = "" +
-	"\n" +
-	"\x12rbac/v1/rbac.proto\x12\vapi.rbac.v1\x1a\x1cgoogle/api/annotations.proto\"\xa2\x01\n" +
-	"\x04Role\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\" \n" +
-	"\x0eGetRoleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"8\n" +
-	"\x0fGetRoleResponse\x12%\n" +
-	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"a\n" +
-	"\x11CreateRoleRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\x05R\x06status\";\n" +
-	"\x12CreateRoleResponse\x12%\n" +
-	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"q\n" +
-	"\x11UpdateRoleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\";\n" +
-	"\x12UpdateRoleResponse\x12%\n" +
-	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"#\n" +
-	"\x11DeleteRoleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
-	"\x12DeleteRoleResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"W\n" +
-	"\x10ListRolesRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"R\n" +
-	"\x11ListRolesResponse\x12'\n" +
-	"\x05roles\x18\x01 \x03(\v2\x11.api.rbac.v1.RoleR\x05roles\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xdc\x01\n" +
-	"\n" +
-	"Permission\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\bresource\x18\x03 \x01(\tR\bresource\x12\x16\n" +
-	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"&\n" +
-	"\x14GetPermissionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"P\n" +
-	"\x15GetPermissionResponse\x127\n" +
-	"\n" +
-	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
-	"permission\"\x9b\x01\n" +
-	"\x17CreatePermissionRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\bresource\x18\x02 \x01(\tR\bresource\x12\x16\n" +
-	"\x06action\x18\x03 \x01(\tR\x06action\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\x05R\x06status\"S\n" +
-	"\x18CreatePermissionResponse\x127\n" +
-	"\n" +
-	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
-	"permission\"\xab\x01\n" +
-	"\x17UpdatePermissionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\bresource\x18\x03 \x01(\tR\bresource\x12\x16\n" +
-	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\x05R\x06status\"S\n" +
-	"\x18UpdatePermissionResponse\x127\n" +
-	"\n" +
-	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
-	"permission\")\n" +
-	"\x17DeletePermissionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"4\n" +
-	"\x18DeletePermissionResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"y\n" +
-	"\x16ListPermissionsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
-	"\bresource\x18\x04 \x01(\tR\bresource\"j\n" +
-	"\x17ListPermissionsResponse\x129\n" +
-	"\vpermissions\x18\x01 \x03(\v2\x17.api.rbac.v1.PermissionR\vpermissions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb1\x01\n" +
-	"\bUserRole\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x17\n" +
-	"\arole_id\x18\x03 \x01(\rR\x06roleId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12%\n" +
-	"\x04role\x18\x06 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\".\n" +
-	"\x13GetUserRolesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"L\n" +
-	"\x14GetUserRolesResponse\x124\n" +
-	"\n" +
-	"user_roles\x18\x01 \x03(\v2\x15.api.rbac.v1.UserRoleR\tuserRoles\"I\n" +
-	"\x15AssignUserRoleRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"2\n" +
-	"\x16AssignUserRoleResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"I\n" +
-	"\x15RemoveUserRoleRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"2\n" +
-	"\x16RemoveUserRoleResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xd5\x01\n" +
-	"\x0eRolePermission\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\rR\x06roleId\x12#\n" +
-	"\rpermission_id\x18\x03 \x01(\rR\fpermissionId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x127\n" +
-	"\n" +
-	"permission\x18\x06 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
-	"permission\"4\n" +
-	"\x19GetRolePermissionsRequest\x12\x17\n" +
-	"\arole_id\x18\x01 \x01(\x05R\x06roleId\"d\n" +
-	"\x1aGetRolePermissionsResponse\x12F\n" +
-	"\x10role_permissions\x18\x01 \x03(\v2\x1b.api.rbac.v1.RolePermissionR\x0frolePermissions\"[\n" +
-	"\x1bAssignRolePermissionRequest\x12\x17\n" +
-	"\arole_id\x18\x01 \x01(\x05R\x06roleId\x12#\n" +
-	"\rpermission_id\x18\x02 \x01(\x05R\fpermissionId\"8\n" +
-	"\x1cAssignRolePermissionResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"[\n" +
-	"\x1bRemoveRolePermissionRequest\x12\x17\n" +
-	"\arole_id\x18\x01 \x01(\x05R\x06roleId\x12#\n" +
-	"\rpermission_id\x18\x02 \x01(\x05R\fpermissionId\"8\n" +
-	"\x1cRemoveRolePermissionResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"`\n" +
-	"\x16CheckPermissionRequest\x12\x12\n" +
-	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1a\n" +
-	"\bresource\x18\x02 \x01(\tR\bresource\x12\x16\n" +
-	"\x06action\x18\x03 \x01(\tR\x06action\"@\n" +
-	"\x17CheckPermissionResponse\x12%\n" +
-	"\x0ehas_permission\x18\x01 \x01(\bR\rhasPermission2\xc0\x10\n" +
+func (x *ListDeletedRolesRequest) Reset() {
+	*x = ListDeletedRolesRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRolesRequest) ProtoMessage() {}
+
+func (x *ListDeletedRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedRolesRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListDeletedRolesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedRolesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedRolesResponse) Reset() {
+	*x = ListDeletedRolesResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRolesResponse) ProtoMessage() {}
+
+func (x *ListDeletedRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedRolesResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListDeletedRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListDeletedRolesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestoreRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoleRequest) Reset() {
+	*x = RestoreRoleRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleRequest) ProtoMessage() {}
+
+func (x *RestoreRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRoleRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreRoleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoleResponse) Reset() {
+	*x = RestoreRoleResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoleResponse) ProtoMessage() {}
+
+func (x *RestoreRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoleResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRoleResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RestoreRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type PurgeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRoleRequest) Reset() {
+	*x = PurgeRoleRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRoleRequest) ProtoMessage() {}
+
+func (x *PurgeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRoleRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PurgeRoleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRoleResponse) Reset() {
+	*x = PurgeRoleResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRoleResponse) ProtoMessage() {}
+
+func (x *PurgeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRoleResponse.ProtoReflect.Descriptor instead.
+func (*PurgeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PurgeRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListDeletedPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPermissionsRequest) Reset() {
+	*x = ListDeletedPermissionsRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPermissionsRequest) ProtoMessage() {}
+
+func (x *ListDeletedPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListDeletedPermissionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedPermissionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPermissionsResponse) Reset() {
+	*x = ListDeletedPermissionsResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPermissionsResponse) ProtoMessage() {}
+
+func (x *ListDeletedPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListDeletedPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ListDeletedPermissionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestorePermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePermissionRequest) Reset() {
+	*x = RestorePermissionRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePermissionRequest) ProtoMessage() {}
+
+func (x *RestorePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RestorePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RestorePermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestorePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePermissionResponse) Reset() {
+	*x = RestorePermissionResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePermissionResponse) ProtoMessage() {}
+
+func (x *RestorePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePermissionResponse.ProtoReflect.Descriptor instead.
+func (*RestorePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RestorePermissionResponse) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+type PurgePermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePermissionRequest) Reset() {
+	*x = PurgePermissionRequest{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePermissionRequest) ProtoMessage() {}
+
+func (x *PurgePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePermissionRequest.ProtoReflect.Descriptor instead.
+func (*PurgePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PurgePermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePermissionResponse) Reset() {
+	*x = PurgePermissionResponse{}
+	mi := &file_rbac_v1_rbac_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePermissionResponse) ProtoMessage() {}
+
+func (x *PurgePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbac_v1_rbac_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePermissionResponse.ProtoReflect.Descriptor instead.
+func (*PurgePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_rbac_v1_rbac_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PurgePermissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_rbac_v1_rbac_proto protoreflect.FileDescriptor
+
+const file_rbac_v1_rbac_proto_rawDesc = "" +
+	"\n" +
+	"\x12rbac/v1/rbac.proto\x12\vapi.rbac.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xdb\x01\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\rR\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\tR\tdeletedAt\" \n" +
+	"\x0eGetRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"8\n" +
+	"\x0fGetRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"a\n" +
+	"\x11CreateRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\";\n" +
+	"\x12CreateRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"\xc8\x01\n" +
+	"\x11UpdateRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\rR\aversion\";\n" +
+	"\x12UpdateRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"=\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\".\n" +
+	"\x12DeleteRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa9\x01\n" +
+	"\x10ListRolesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"z\n" +
+	"\x11ListRolesResponse\x12'\n" +
+	"\x05roles\x18\x01 \x03(\v2\x11.api.rbac.v1.RoleR\x05roles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x95\x02\n" +
+	"\n" +
+	"Permission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\t \x01(\rR\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\tR\tdeletedAt\"&\n" +
+	"\x14GetPermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"P\n" +
+	"\x15GetPermissionResponse\x127\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
+	"permission\"\x9b\x01\n" +
+	"\x17CreatePermissionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\"S\n" +
+	"\x18CreatePermissionResponse\x127\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
+	"permission\"\x82\x02\n" +
+	"\x17UpdatePermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\b \x01(\rR\aversion\"S\n" +
+	"\x18UpdatePermissionResponse\x127\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
+	"permission\"C\n" +
+	"\x17DeletePermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\"4\n" +
+	"\x18DeletePermissionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xcb\x01\n" +
+	"\x16ListPermissionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bresource\x18\x04 \x01(\tR\bresource\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x92\x01\n" +
+	"\x17ListPermissionsResponse\x129\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x17.api.rbac.v1.PermissionR\vpermissions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb1\x01\n" +
+	"\bUserRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\rR\x06roleId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12%\n" +
+	"\x04role\x18\x06 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\".\n" +
+	"\x13GetUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"L\n" +
+	"\x14GetUserRolesResponse\x124\n" +
+	"\n" +
+	"user_roles\x18\x01 \x03(\v2\x15.api.rbac.v1.UserRoleR\tuserRoles\"I\n" +
+	"\x15AssignUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"2\n" +
+	"\x16AssignUserRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"I\n" +
+	"\x15RemoveUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"2\n" +
+	"\x16RemoveUserRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xd5\x01\n" +
+	"\x0eRolePermission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\rR\x06roleId\x12#\n" +
+	"\rpermission_id\x18\x03 \x01(\rR\fpermissionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x127\n" +
+	"\n" +
+	"permission\x18\x06 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
+	"permission\"4\n" +
+	"\x19GetRolePermissionsRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x05R\x06roleId\"d\n" +
+	"\x1aGetRolePermissionsResponse\x12F\n" +
+	"\x10role_permissions\x18\x01 \x03(\v2\x1b.api.rbac.v1.RolePermissionR\x0frolePermissions\"[\n" +
+	"\x1bAssignRolePermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x05R\x06roleId\x12#\n" +
+	"\rpermission_id\x18\x02 \x01(\x05R\fpermissionId\"8\n" +
+	"\x1cAssignRolePermissionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"[\n" +
+	"\x1bRemoveRolePermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x05R\x06roleId\x12#\n" +
+	"\rpermission_id\x18\x02 \x01(\x05R\fpermissionId\"8\n" +
+	"\x1cRemoveRolePermissionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"`\n" +
+	"\x16CheckPermissionRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"@\n" +
+	"\x17CheckPermissionResponse\x12%\n" +
+	"\x0ehas_permission\x18\x01 \x01(\bR\rhasPermission\"J\n" +
+	"\x17ListDeletedRolesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Y\n" +
+	"\x18ListDeletedRolesResponse\x12'\n" +
+	"\x05roles\x18\x01 \x03(\v2\x11.api.rbac.v1.RoleR\x05roles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"$\n" +
+	"\x12RestoreRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"<\n" +
+	"\x13RestoreRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.api.rbac.v1.RoleR\x04role\"\"\n" +
+	"\x10PurgeRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x11PurgeRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"P\n" +
+	"\x1dListDeletedPermissionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"q\n" +
+	"\x1eListDeletedPermissionsResponse\x129\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x17.api.rbac.v1.PermissionR\vpermissions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"*\n" +
+	"\x18RestorePermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"T\n" +
+	"\x19RestorePermissionResponse\x127\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x17.api.rbac.v1.PermissionR\n" +
+	"permission\"(\n" +
+	"\x16PurgePermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"3\n" +
+	"\x17PurgePermissionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\x9d\x17\n" +
 	"\vRBACService\x12\\\n" +
 	"\aGetRole\x12\x1b.api.rbac.v1.GetRoleRequest\x1a\x1c.api.rbac.v1.GetRoleResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/roles/{id}\x12c\n" +
 	"\n" +
-	"CreateRole\x12\x1e.api.rbac.v1.CreateRoleRequest\x1a\x1f.api.rbac.v1.CreateRoleResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/roles\x12h\n" +
+	"CreateRole\x12\x1e.api.rbac.v1.CreateRoleRequest\x1a\x1f.api.rbac.v1.CreateRoleResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/roles\x12}\n" +
 	"\n" +
-	"UpdateRole\x12\x1e.api.rbac.v1.UpdateRoleRequest\x1a\x1f.api.rbac.v1.UpdateRoleResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/roles/{id}\x12e\n" +
+	"UpdateRole\x12\x1e.api.rbac.v1.UpdateRoleRequest\x1a\x1f.api.rbac.v1.UpdateRoleResponse\".\x82\xd3\xe4\x93\x02(:\x01*Z\x13:\x01*2\x0e/v1/roles/{id}\x1a\x0e/v1/roles/{id}\x12e\n" +
 	"\n" +
 	"DeleteRole\x12\x1e.api.rbac.v1.DeleteRoleRequest\x1a\x1f.api.rbac.v1.DeleteRoleResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/roles/{id}\x12]\n" +
 	"\tListRoles\x12\x1d.api.rbac.v1.ListRolesRequest\x1a\x1e.api.rbac.v1.ListRolesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/roles\x12t\n" +
 	"\rGetPermission\x12!.api.rbac.v1.GetPermissionRequest\x1a\".api.rbac.v1.GetPermissionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/permissions/{id}\x12{\n" +
-	"\x10CreatePermission\x12$.api.rbac.v1.CreatePermissionRequest\x1a%.api.rbac.v1.CreatePermissionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/permissions\x12\x80\x01\n" +
-	"\x10UpdatePermission\x12$.api.rbac.v1.UpdatePermissionRequest\x1a%.api.rbac.v1.UpdatePermissionResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/permissions/{id}\x12}\n" +
+	"\x10CreatePermission\x12$.api.rbac.v1.CreatePermissionRequest\x1a%.api.rbac.v1.CreatePermissionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/permissions\x12\x9b\x01\n" +
+	"\x10UpdatePermission\x12$.api.rbac.v1.UpdatePermissionRequest\x1a%.api.rbac.v1.UpdatePermissionResponse\":\x82\xd3\xe4\x93\x024:\x01*Z\x19:\x01*2\x14/v1/permissions/{id}\x1a\x14/v1/permissions/{id}\x12}\n" +
 	"\x10DeletePermission\x12$.api.rbac.v1.DeletePermissionRequest\x1a%.api.rbac.v1.DeletePermissionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/permissions/{id}\x12u\n" +
 	"\x0fListPermissions\x12#.api.rbac.v1.ListPermissionsRequest\x1a$.api.rbac.v1.ListPermissionsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/permissions\x12v\n" +
 	"\fGetUserRoles\x12 .api.rbac.v1.GetUserRolesRequest\x1a!.api.rbac.v1.GetUserRolesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/{user_id}/roles\x12\x7f\n" +
@@ -2259,7 +3038,13 @@ const file_rbac_v1_rbac_proto_rawDesc = "" +
 	"\x12GetRolePermissions\x12&.api.rbac.v1.GetRolePermissionsRequest\x1a'.api.rbac.v1.GetRolePermissionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/roles/{role_id}/permissions\x12\x97\x01\n" +
 	"\x14AssignRolePermission\x12(.api.rbac.v1.AssignRolePermissionRequest\x1a).api.rbac.v1.AssignRolePermissionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/roles/{role_id}/permissions\x12\xa4\x01\n" +
 	"\x14RemoveRolePermission\x12(.api.rbac.v1.RemoveRolePermissionRequest\x1a).api.rbac.v1.RemoveRolePermissionResponse\"7\x82\xd3\xe4\x93\x021*//v1/roles/{role_id}/permissions/{permission_id}\x12~\n" +
-	"\x0fCheckPermission\x12#.api.rbac.v1.CheckPermissionRequest\x1a$.api.rbac.v1.CheckPermissionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/permissions/checkB\x18Z\x16student/api/rbac/v1;v1b\x06proto3"
+	"\x0fCheckPermission\x12#.api.rbac.v1.CheckPermissionRequest\x1a$.api.rbac.v1.CheckPermissionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/permissions/check\x12~\n" +
+	"\x10ListDeletedRoles\x12$.api.rbac.v1.ListDeletedRolesRequest\x1a%.api.rbac.v1.ListDeletedRolesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/recycle-bin/roles\x12\x7f\n" +
+	"\vRestoreRole\x12\x1f.api.rbac.v1.RestoreRoleRequest\x1a .api.rbac.v1.RestoreRoleResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/recycle-bin/roles/{id}/restore\x12n\n" +
+	"\tPurgeRole\x12\x1d.api.rbac.v1.PurgeRoleRequest\x1a\x1e.api.rbac.v1.PurgeRoleResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/recycle-bin/roles/{id}\x12\x96\x01\n" +
+	"\x16ListDeletedPermissions\x12*.api.rbac.v1.ListDeletedPermissionsRequest\x1a+.api.rbac.v1.ListDeletedPermissionsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/recycle-bin/permissions\x12\x97\x01\n" +
+	"\x11RestorePermission\x12%.api.rbac.v1.RestorePermissionRequest\x1a&.api.rbac.v1.RestorePermissionResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/recycle-bin/permissions/{id}/restore\x12\x86\x01\n" +
+	"\x0fPurgePermission\x12#.api.rbac.v1.PurgePermissionRequest\x1a$.api.rbac.v1.PurgePermissionResponse\"(\x82\xd3\xe4\x93\x02\"* /v1/recycle-bin/permissions/{id}B\x18Z\x16student/api/rbac/v1;v1b\x06proto3"
 
 var (
 	file_rbac_v1_rbac_proto_rawDescOnce sync.Once
@@ -2273,99 +3058,130 @@ func file_rbac_v1_rbac_proto_rawDescGZIP() []byte {
 	return file_rbac_v1_rbac_proto_rawDescData
 }
 
-var file_rbac_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_rbac_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_rbac_v1_rbac_proto_goTypes = []any{
-	(*Role)(nil),                         // 0: api.rbac.v1.Role
-	(*GetRoleRequest)(nil),               // 1: api.rbac.v1.GetRoleRequest
-	(*GetRoleResponse)(nil),              // 2: api.rbac.v1.GetRoleResponse
-	(*CreateRoleRequest)(nil),            // 3: api.rbac.v1.CreateRoleRequest
-	(*CreateRoleResponse)(nil),           // 4: api.rbac.v1.CreateRoleResponse
-	(*UpdateRoleRequest)(nil),            // 5: api.rbac.v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),           // 6: api.rbac.v1.UpdateRoleResponse
-	(*DeleteRoleRequest)(nil),            // 7: api.rbac.v1.DeleteRoleRequest
-	(*DeleteRoleResponse)(nil),           // 8: api.rbac.v1.DeleteRoleResponse
-	(*ListRolesRequest)(nil),             // 9: api.rbac.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),            // 10: api.rbac.v1.ListRolesResponse
-	(*Permission)(nil),                   // 11: api.rbac.v1.Permission
-	(*GetPermissionRequest)(nil),         // 12: api.rbac.v1.GetPermissionRequest
-	(*GetPermissionResponse)(nil),        // 13: api.rbac.v1.GetPermissionResponse
-	(*CreatePermissionRequest)(nil),      // 14: api.rbac.v1.CreatePermissionRequest
-	(*CreatePermissionResponse)(nil),     // 15: api.rbac.v1.CreatePermissionResponse
-	(*UpdatePermissionRequest)(nil),      // 16: api.rbac.v1.UpdatePermissionRequest
-	(*UpdatePermissionResponse)(nil),     // 17: api.rbac.v1.UpdatePermissionResponse
-	(*DeletePermissionRequest)(nil),      // 18: api.rbac.v1.DeletePermissionRequest
-	(*DeletePermissionResponse)(nil),     // 19: api.rbac.v1.DeletePermissionResponse
-	(*ListPermissionsRequest)(nil),       // 20: api.rbac.v1.ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),      // 21: api.rbac.v1.ListPermissionsResponse
-	(*UserRole)(nil),                     // 22: api.rbac.v1.UserRole
-	(*GetUserRolesRequest)(nil),          // 23: api.rbac.v1.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),         // 24: api.rbac.v1.GetUserRolesResponse
-	(*AssignUserRoleRequest)(nil),        // 25: api.rbac.v1.AssignUserRoleRequest
-	(*AssignUserRoleResponse)(nil),       // 26: api.rbac.v1.AssignUserRoleResponse
-	(*RemoveUserRoleRequest)(nil),        // 27: api.rbac.v1.RemoveUserRoleRequest
-	(*RemoveUserRoleResponse)(nil),       // 28: api.rbac.v1.RemoveUserRoleResponse
-	(*RolePermission)(nil),               // 29: api.rbac.v1.RolePermission
-	(*GetRolePermissionsRequest)(nil),    // 30: api.rbac.v1.GetRolePermissionsRequest
-	(*GetRolePermissionsResponse)(nil),   // 31: api.rbac.v1.GetRolePermissionsResponse
-	(*AssignRolePermissionRequest)(nil),  // 32: api.rbac.v1.AssignRolePermissionRequest
-	(*AssignRolePermissionResponse)(nil), // 33: api.rbac.v1.AssignRolePermissionResponse
-	(*RemoveRolePermissionRequest)(nil),  // 34: api.rbac.v1.RemoveRolePermissionRequest
-	(*RemoveRolePermissionResponse)(nil), // 35: api.rbac.v1.RemoveRolePermissionResponse
-	(*CheckPermissionRequest)(nil),       // 36: api.rbac.v1.CheckPermissionRequest
-	(*CheckPermissionResponse)(nil),      // 37: api.rbac.v1.CheckPermissionResponse
+	(*Role)(nil),                           // 0: api.rbac.v1.Role
+	(*GetRoleRequest)(nil),                 // 1: api.rbac.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),                // 2: api.rbac.v1.GetRoleResponse
+	(*CreateRoleRequest)(nil),              // 3: api.rbac.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),             // 4: api.rbac.v1.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),              // 5: api.rbac.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),             // 6: api.rbac.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),              // 7: api.rbac.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),             // 8: api.rbac.v1.DeleteRoleResponse
+	(*ListRolesRequest)(nil),               // 9: api.rbac.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),              // 10: api.rbac.v1.ListRolesResponse
+	(*Permission)(nil),                     // 11: api.rbac.v1.Permission
+	(*GetPermissionRequest)(nil),           // 12: api.rbac.v1.GetPermissionRequest
+	(*GetPermissionResponse)(nil),          // 13: api.rbac.v1.GetPermissionResponse
+	(*CreatePermissionRequest)(nil),        // 14: api.rbac.v1.CreatePermissionRequest
+	(*CreatePermissionResponse)(nil),       // 15: api.rbac.v1.CreatePermissionResponse
+	(*UpdatePermissionRequest)(nil),        // 16: api.rbac.v1.UpdatePermissionRequest
+	(*UpdatePermissionResponse)(nil),       // 17: api.rbac.v1.UpdatePermissionResponse
+	(*DeletePermissionRequest)(nil),        // 18: api.rbac.v1.DeletePermissionRequest
+	(*DeletePermissionResponse)(nil),       // 19: api.rbac.v1.DeletePermissionResponse
+	(*ListPermissionsRequest)(nil),         // 20: api.rbac.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),        // 21: api.rbac.v1.ListPermissionsResponse
+	(*UserRole)(nil),                       // 22: api.rbac.v1.UserRole
+	(*GetUserRolesRequest)(nil),            // 23: api.rbac.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),           // 24: api.rbac.v1.GetUserRolesResponse
+	(*AssignUserRoleRequest)(nil),          // 25: api.rbac.v1.AssignUserRoleRequest
+	(*AssignUserRoleResponse)(nil),         // 26: api.rbac.v1.AssignUserRoleResponse
+	(*RemoveUserRoleRequest)(nil),          // 27: api.rbac.v1.RemoveUserRoleRequest
+	(*RemoveUserRoleResponse)(nil),         // 28: api.rbac.v1.RemoveUserRoleResponse
+	(*RolePermission)(nil),                 // 29: api.rbac.v1.RolePermission
+	(*GetRolePermissionsRequest)(nil),      // 30: api.rbac.v1.GetRolePermissionsRequest
+	(*GetRolePermissionsResponse)(nil),     // 31: api.rbac.v1.GetRolePermissionsResponse
+	(*AssignRolePermissionRequest)(nil),    // 32: api.rbac.v1.AssignRolePermissionRequest
+	(*AssignRolePermissionResponse)(nil),   // 33: api.rbac.v1.AssignRolePermissionResponse
+	(*RemoveRolePermissionRequest)(nil),    // 34: api.rbac.v1.RemoveRolePermissionRequest
+	(*RemoveRolePermissionResponse)(nil),   // 35: api.rbac.v1.RemoveRolePermissionResponse
+	(*CheckPermissionRequest)(nil),         // 36: api.rbac.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),        // 37: api.rbac.v1.CheckPermissionResponse
+	(*ListDeletedRolesRequest)(nil),        // 38: api.rbac.v1.ListDeletedRolesRequest
+	(*ListDeletedRolesResponse)(nil),       // 39: api.rbac.v1.ListDeletedRolesResponse
+	(*RestoreRoleRequest)(nil),             // 40: api.rbac.v1.RestoreRoleRequest
+	(*RestoreRoleResponse)(nil),            // 41: api.rbac.v1.RestoreRoleResponse
+	(*PurgeRoleRequest)(nil),               // 42: api.rbac.v1.PurgeRoleRequest
+	(*PurgeRoleResponse)(nil),              // 43: api.rbac.v1.PurgeRoleResponse
+	(*ListDeletedPermissionsRequest)(nil),  // 44: api.rbac.v1.ListDeletedPermissionsRequest
+	(*ListDeletedPermissionsResponse)(nil), // 45: api.rbac.v1.ListDeletedPermissionsResponse
+	(*RestorePermissionRequest)(nil),       // 46: api.rbac.v1.RestorePermissionRequest
+	(*RestorePermissionResponse)(nil),      // 47: api.rbac.v1.RestorePermissionResponse
+	(*PurgePermissionRequest)(nil),         // 48: api.rbac.v1.PurgePermissionRequest
+	(*PurgePermissionResponse)(nil),        // 49: api.rbac.v1.PurgePermissionResponse
+	(*fieldmaskpb.FieldMask)(nil),          // 50: google.protobuf.FieldMask
 }
 var file_rbac_v1_rbac_proto_depIdxs = []int32{
 	0,  // 0: api.rbac.v1.GetRoleResponse.role:type_name -> api.rbac.v1.Role
 	0,  // 1: api.rbac.v1.CreateRoleResponse.role:type_name -> api.rbac.v1.Role
-	0,  // 2: api.rbac.v1.UpdateRoleResponse.role:type_name -> api.rbac.v1.Role
-	0,  // 3: api.rbac.v1.ListRolesResponse.roles:type_name -> api.rbac.v1.Role
-	11, // 4: api.rbac.v1.GetPermissionResponse.permission:type_name -> api.rbac.v1.Permission
-	11, // 5: api.rbac.v1.CreatePermissionResponse.permission:type_name -> api.rbac.v1.Permission
-	11, // 6: api.rbac.v1.UpdatePermissionResponse.permission:type_name -> api.rbac.v1.Permission
-	11, // 7: api.rbac.v1.ListPermissionsResponse.permissions:type_name -> api.rbac.v1.Permission
-	0,  // 8: api.rbac.v1.UserRole.role:type_name -> api.rbac.v1.Role
-	22, // 9: api.rbac.v1.GetUserRolesResponse.user_roles:type_name -> api.rbac.v1.UserRole
-	11, // 10: api.rbac.v1.RolePermission.permission:type_name -> api.rbac.v1.Permission
-	29, // 11: api.rbac.v1.GetRolePermissionsResponse.role_permissions:type_name -> api.rbac.v1.RolePermission
-	1,  // 12: api.rbac.v1.RBACService.GetRole:input_type -> api.rbac.v1.GetRoleRequest
-	3,  // 13: api.rbac.v1.RBACService.CreateRole:input_type -> api.rbac.v1.CreateRoleRequest
-	5,  // 14: api.rbac.v1.RBACService.UpdateRole:input_type -> api.rbac.v1.UpdateRoleRequest
-	7,  // 15: api.rbac.v1.RBACService.DeleteRole:input_type -> api.rbac.v1.DeleteRoleRequest
-	9,  // 16: api.rbac.v1.RBACService.ListRoles:input_type -> api.rbac.v1.ListRolesRequest
-	12, // 17: api.rbac.v1.RBACService.GetPermission:input_type -> api.rbac.v1.GetPermissionRequest
-	14, // 18: api.rbac.v1.RBACService.CreatePermission:input_type -> api.rbac.v1.CreatePermissionRequest
-	16, // 19: api.rbac.v1.RBACService.UpdatePermission:input_type -> api.rbac.v1.UpdatePermissionRequest
-	18, // 20: api.rbac.v1.RBACService.DeletePermission:input_type -> api.rbac.v1.DeletePermissionRequest
-	20, // 21: api.rbac.v1.RBACService.ListPermissions:input_type -> api.rbac.v1.ListPermissionsRequest
-	23, // 22: api.rbac.v1.RBACService.GetUserRoles:input_type -> api.rbac.v1.GetUserRolesRequest
-	25, // 23: api.rbac.v1.RBACService.AssignUserRole:input_type -> api.rbac.v1.AssignUserRoleRequest
-	27, // 24: api.rbac.v1.RBACService.RemoveUserRole:input_type -> api.rbac.v1.RemoveUserRoleRequest
-	30, // 25: api.rbac.v1.RBACService.GetRolePermissions:input_type -> api.rbac.v1.GetRolePermissionsRequest
-	32, // 26: api.rbac.v1.RBACService.AssignRolePermission:input_type -> api.rbac.v1.AssignRolePermissionRequest
-	34, // 27: api.rbac.v1.RBACService.RemoveRolePermission:input_type -> api.rbac.v1.RemoveRolePermissionRequest
-	36, // 28: api.rbac.v1.RBACService.CheckPermission:input_type -> api.rbac.v1.CheckPermissionRequest
-	2,  // 29: api.rbac.v1.RBACService.GetRole:output_type -> api.rbac.v1.GetRoleResponse
-	4,  // 30: api.rbac.v1.RBACService.CreateRole:output_type -> api.rbac.v1.CreateRoleResponse
-	6,  // 31: api.rbac.v1.RBACService.UpdateRole:output_type -> api.rbac.v1.UpdateRoleResponse
-	8,  // 32: api.rbac.v1.RBACService.DeleteRole:output_type -> api.rbac.v1.DeleteRoleResponse
-	10, // 33: api.rbac.v1.RBACService.ListRoles:output_type -> api.rbac.v1.ListRolesResponse
-	13, // 34: api.rbac.v1.RBACService.GetPermission:output_type -> api.rbac.v1.GetPermissionResponse
-	15, // 35: api.rbac.v1.RBACService.CreatePermission:output_type -> api.rbac.v1.CreatePermissionResponse
-	17, // 36: api.rbac.v1.RBACService.UpdatePermission:output_type -> api.rbac.v1.UpdatePermissionResponse
-	19, // 37: api.rbac.v1.RBACService.DeletePermission:output_type -> api.rbac.v1.DeletePermissionResponse
-	21, // 38: api.rbac.v1.RBACService.ListPermissions:output_type -> api.rbac.v1.ListPermissionsResponse
-	24, // 39: api.rbac.v1.RBACService.GetUserRoles:output_type -> api.rbac.v1.GetUserRolesResponse
-	26, // 40: api.rbac.v1.RBACService.AssignUserRole:output_type -> api.rbac.v1.AssignUserRoleResponse
-	28, // 41: api.rbac.v1.RBACService.RemoveUserRole:output_type -> api.rbac.v1.RemoveUserRoleResponse
-	31, // 42: api.rbac.v1.RBACService.GetRolePermissions:output_type -> api.rbac.v1.GetRolePermissionsResponse
-	33, // 43: api.rbac.v1.RBACService.AssignRolePermission:output_type -> api.rbac.v1.AssignRolePermissionResponse
-	35, // 44: api.rbac.v1.RBACService.RemoveRolePermission:output_type -> api.rbac.v1.RemoveRolePermissionResponse
-	37, // 45: api.rbac.v1.RBACService.CheckPermission:output_type -> api.rbac.v1.CheckPermissionResponse
-	29, // [29:46] is the sub-list for method output_type
-	12, // [12:29] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	50, // 2: api.rbac.v1.UpdateRoleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 3: api.rbac.v1.UpdateRoleResponse.role:type_name -> api.rbac.v1.Role
+	0,  // 4: api.rbac.v1.ListRolesResponse.roles:type_name -> api.rbac.v1.Role
+	11, // 5: api.rbac.v1.GetPermissionResponse.permission:type_name -> api.rbac.v1.Permission
+	11, // 6: api.rbac.v1.CreatePermissionResponse.permission:type_name -> api.rbac.v1.Permission
+	50, // 7: api.rbac.v1.UpdatePermissionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 8: api.rbac.v1.UpdatePermissionResponse.permission:type_name -> api.rbac.v1.Permission
+	11, // 9: api.rbac.v1.ListPermissionsResponse.permissions:type_name -> api.rbac.v1.Permission
+	0,  // 10: api.rbac.v1.UserRole.role:type_name -> api.rbac.v1.Role
+	22, // 11: api.rbac.v1.GetUserRolesResponse.user_roles:type_name -> api.rbac.v1.UserRole
+	11, // 12: api.rbac.v1.RolePermission.permission:type_name -> api.rbac.v1.Permission
+	29, // 13: api.rbac.v1.GetRolePermissionsResponse.role_permissions:type_name -> api.rbac.v1.RolePermission
+	0,  // 14: api.rbac.v1.ListDeletedRolesResponse.roles:type_name -> api.rbac.v1.Role
+	0,  // 15: api.rbac.v1.RestoreRoleResponse.role:type_name -> api.rbac.v1.Role
+	11, // 16: api.rbac.v1.ListDeletedPermissionsResponse.permissions:type_name -> api.rbac.v1.Permission
+	11, // 17: api.rbac.v1.RestorePermissionResponse.permission:type_name -> api.rbac.v1.Permission
+	1,  // 18: api.rbac.v1.RBACService.GetRole:input_type -> api.rbac.v1.GetRoleRequest
+	3,  // 19: api.rbac.v1.RBACService.CreateRole:input_type -> api.rbac.v1.CreateRoleRequest
+	5,  // 20: api.rbac.v1.RBACService.UpdateRole:input_type -> api.rbac.v1.UpdateRoleRequest
+	7,  // 21: api.rbac.v1.RBACService.DeleteRole:input_type -> api.rbac.v1.DeleteRoleRequest
+	9,  // 22: api.rbac.v1.RBACService.ListRoles:input_type -> api.rbac.v1.ListRolesRequest
+	12, // 23: api.rbac.v1.RBACService.GetPermission:input_type -> api.rbac.v1.GetPermissionRequest
+	14, // 24: api.rbac.v1.RBACService.CreatePermission:input_type -> api.rbac.v1.CreatePermissionRequest
+	16, // 25: api.rbac.v1.RBACService.UpdatePermission:input_type -> api.rbac.v1.UpdatePermissionRequest
+	18, // 26: api.rbac.v1.RBACService.DeletePermission:input_type -> api.rbac.v1.DeletePermissionRequest
+	20, // 27: api.rbac.v1.RBACService.ListPermissions:input_type -> api.rbac.v1.ListPermissionsRequest
+	23, // 28: api.rbac.v1.RBACService.GetUserRoles:input_type -> api.rbac.v1.GetUserRolesRequest
+	25, // 29: api.rbac.v1.RBACService.AssignUserRole:input_type -> api.rbac.v1.AssignUserRoleRequest
+	27, // 30: api.rbac.v1.RBACService.RemoveUserRole:input_type -> api.rbac.v1.RemoveUserRoleRequest
+	30, // 31: api.rbac.v1.RBACService.GetRolePermissions:input_type -> api.rbac.v1.GetRolePermissionsRequest
+	32, // 32: api.rbac.v1.RBACService.AssignRolePermission:input_type -> api.rbac.v1.AssignRolePermissionRequest
+	34, // 33: api.rbac.v1.RBACService.RemoveRolePermission:input_type -> api.rbac.v1.RemoveRolePermissionRequest
+	36, // 34: api.rbac.v1.RBACService.CheckPermission:input_type -> api.rbac.v1.CheckPermissionRequest
+	38, // 35: api.rbac.v1.RBACService.ListDeletedRoles:input_type -> api.rbac.v1.ListDeletedRolesRequest
+	40, // 36: api.rbac.v1.RBACService.RestoreRole:input_type -> api.rbac.v1.RestoreRoleRequest
+	42, // 37: api.rbac.v1.RBACService.PurgeRole:input_type -> api.rbac.v1.PurgeRoleRequest
+	44, // 38: api.rbac.v1.RBACService.ListDeletedPermissions:input_type -> api.rbac.v1.ListDeletedPermissionsRequest
+	46, // 39: api.rbac.v1.RBACService.RestorePermission:input_type -> api.rbac.v1.RestorePermissionRequest
+	48, // 40: api.rbac.v1.RBACService.PurgePermission:input_type -> api.rbac.v1.PurgePermissionRequest
+	2,  // 41: api.rbac.v1.RBACService.GetRole:output_type -> api.rbac.v1.GetRoleResponse
+	4,  // 42: api.rbac.v1.RBACService.CreateRole:output_type -> api.rbac.v1.CreateRoleResponse
+	6,  // 43: api.rbac.v1.RBACService.UpdateRole:output_type -> api.rbac.v1.UpdateRoleResponse
+	8,  // 44: api.rbac.v1.RBACService.DeleteRole:output_type -> api.rbac.v1.DeleteRoleResponse
+	10, // 45: api.rbac.v1.RBACService.ListRoles:output_type -> api.rbac.v1.ListRolesResponse
+	13, // 46: api.rbac.v1.RBACService.GetPermission:output_type -> api.rbac.v1.GetPermissionResponse
+	15, // 47: api.rbac.v1.RBACService.CreatePermission:output_type -> api.rbac.v1.CreatePermissionResponse
+	17, // 48: api.rbac.v1.RBACService.UpdatePermission:output_type -> api.rbac.v1.UpdatePermissionResponse
+	19, // 49: api.rbac.v1.RBACService.DeletePermission:output_type -> api.rbac.v1.DeletePermissionResponse
+	21, // 50: api.rbac.v1.RBACService.ListPermissions:output_type -> api.rbac.v1.ListPermissionsResponse
+	24, // 51: api.rbac.v1.RBACService.GetUserRoles:output_type -> api.rbac.v1.GetUserRolesResponse
+	26, // 52: api.rbac.v1.RBACService.AssignUserRole:output_type -> api.rbac.v1.AssignUserRoleResponse
+	28, // 53: api.rbac.v1.RBACService.RemoveUserRole:output_type -> api.rbac.v1.RemoveUserRoleResponse
+	31, // 54: api.rbac.v1.RBACService.GetRolePermissions:output_type -> api.rbac.v1.GetRolePermissionsResponse
+	33, // 55: api.rbac.v1.RBACService.AssignRolePermission:output_type -> api.rbac.v1.AssignRolePermissionResponse
+	35, // 56: api.rbac.v1.RBACService.RemoveRolePermission:output_type -> api.rbac.v1.RemoveRolePermissionResponse
+	37, // 57: api.rbac.v1.RBACService.CheckPermission:output_type -> api.rbac.v1.CheckPermissionResponse
+	39, // 58: api.rbac.v1.RBACService.ListDeletedRoles:output_type -> api.rbac.v1.ListDeletedRolesResponse
+	41, // 59: api.rbac.v1.RBACService.RestoreRole:output_type -> api.rbac.v1.RestoreRoleResponse
+	43, // 60: api.rbac.v1.RBACService.PurgeRole:output_type -> api.rbac.v1.PurgeRoleResponse
+	45, // 61: api.rbac.v1.RBACService.ListDeletedPermissions:output_type -> api.rbac.v1.ListDeletedPermissionsResponse
+	47, // 62: api.rbac.v1.RBACService.RestorePermission:output_type -> api.rbac.v1.RestorePermissionResponse
+	49, // 63: api.rbac.v1.RBACService.PurgePermission:output_type -> api.rbac.v1.PurgePermissionResponse
+	41, // [41:64] is the sub-list for method output_type
+	18, // [18:41] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_rbac_v1_rbac_proto_init() }
@@ -2379,7 +3195,7 @@ func file_rbac_v1_rbac_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rbac_v1_rbac_proto_rawDesc), len(file_rbac_v1_rbac_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,23 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RBACService_GetRole_FullMethodName              = "/api.rbac.v1.RBACService/GetRole"
-	RBACService_CreateRole_FullMethodName           = "/api.rbac.v1.RBACService/CreateRole"
-	RBACService_UpdateRole_FullMethodName           = "/api.rbac.v1.RBACService/UpdateRole"
-	RBACService_DeleteRole_FullMethodName           = "/api.rbac.v1.RBACService/DeleteRole"
-	RBACService_ListRoles_FullMethodName            = "/api.rbac.v1.RBACService/ListRoles"
-	RBACService_GetPermission_FullMethodName        = "/api.rbac.v1.RBACService/GetPermission"
-	RBACService_CreatePermission_FullMethodName     = "/api.rbac.v1.RBACService/CreatePermission"
-	RBACService_UpdatePermission_FullMethodName     = "/api.rbac.v1.RBACService/UpdatePermission"
-	RBACService_DeletePermission_FullMethodName     = "/api.rbac.v1.RBACService/DeletePermission"
-	RBACService_ListPermissions_FullMethodName      = "/api.rbac.v1.RBACService/ListPermissions"
-	RBACService_GetUserRoles_FullMethodName         = "/api.rbac.v1.RBACService/GetUserRoles"
-	RBACService_AssignUserRole_FullMethodName       = "/api.rbac.v1.RBACService/AssignUserRole"
-	RBACService_RemoveUserRole_FullMethodName       = "/api.rbac.v1.RBACService/RemoveUserRole"
-	RBACService_GetRolePermissions_FullMethodName   = "/api.rbac.v1.RBACService/GetRolePermissions"
-	RBACService_AssignRolePermission_FullMethodName = "/api.rbac.v1.RBACService/AssignRolePermission"
-	RBACService_RemoveRolePermission_FullMethodName = "/api.rbac.v1.RBACService/RemoveRolePermission"
-	RBACService_CheckPermission_FullMethodName      = "/api.rbac.v1.RBACService/CheckPermission"
+	RBACService_GetRole_FullMethodName                = "/api.rbac.v1.RBACService/GetRole"
+	RBACService_CreateRole_FullMethodName             = "/api.rbac.v1.RBACService/CreateRole"
+	RBACService_UpdateRole_FullMethodName             = "/api.rbac.v1.RBACService/UpdateRole"
+	RBACService_DeleteRole_FullMethodName             = "/api.rbac.v1.RBACService/DeleteRole"
+	RBACService_ListRoles_FullMethodName              = "/api.rbac.v1.RBACService/ListRoles"
+	RBACService_GetPermission_FullMethodName          = "/api.rbac.v1.RBACService/GetPermission"
+	RBACService_CreatePermission_FullMethodName       = "/api.rbac.v1.RBACService/CreatePermission"
+	RBACService_UpdatePermission_FullMethodName       = "/api.rbac.v1.RBACService/UpdatePermission"
+	RBACService_DeletePermission_FullMethodName       = "/api.rbac.v1.RBACService/DeletePermission"
+	RBACService_ListPermissions_FullMethodName        = "/api.rbac.v1.RBACService/ListPermissions"
+	RBACService_GetUserRoles_FullMethodName           = "/api.rbac.v1.RBACService/GetUserRoles"
+	RBACService_AssignUserRole_FullMethodName         = "/api.rbac.v1.RBACService/AssignUserRole"
+	RBACService_RemoveUserRole_FullMethodName         = "/api.rbac.v1.RBACService/RemoveUserRole"
+	RBACService_GetRolePermissions_FullMethodName     = "/api.rbac.v1.RBACService/GetRolePermissions"
+	RBACService_AssignRolePermission_FullMethodName   = "/api.rbac.v1.RBACService/AssignRolePermission"
+	RBACService_RemoveRolePermission_FullMethodName   = "/api.rbac.v1.RBACService/RemoveRolePermission"
+	RBACService_CheckPermission_FullMethodName        = "/api.rbac.v1.RBACService/CheckPermission"
+	RBACService_ListDeletedRoles_FullMethodName       = "/api.rbac.v1.RBACService/ListDeletedRoles"
+	RBACService_RestoreRole_FullMethodName            = "/api.rbac.v1.RBACService/RestoreRole"
+	RBACService_PurgeRole_FullMethodName              = "/api.rbac.v1.RBACService/PurgeRole"
+	RBACService_ListDeletedPermissions_FullMethodName = "/api.rbac.v1.RBACService/ListDeletedPermissions"
+	RBACService_RestorePermission_FullMethodName      = "/api.rbac.v1.RBACService/RestorePermission"
+	RBACService_PurgePermission_FullMethodName        = "/api.rbac.v1.RBACService/PurgePermission"
 )
 
 // RBACServiceClient is the client API for RBACService service.
@@ -66,6 +72,13 @@ type RBACServiceClient interface {
 	RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...grpc.CallOption) (*RemoveRolePermissionResponse, error)
 	// 权限检查
 	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// 回收站：已删除的角色和权限，恢复或彻底删除
+	ListDeletedRoles(ctx context.Context, in *ListDeletedRolesRequest, opts ...grpc.CallOption) (*ListDeletedRolesResponse, error)
+	RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error)
+	PurgeRole(ctx context.Context, in *PurgeRoleRequest, opts ...grpc.CallOption) (*PurgeRoleResponse, error)
+	ListDeletedPermissions(ctx context.Context, in *ListDeletedPermissionsRequest, opts ...grpc.CallOption) (*ListDeletedPermissionsResponse, error)
+	RestorePermission(ctx context.Context, in *RestorePermissionRequest, opts ...grpc.CallOption) (*RestorePermissionResponse, error)
+	PurgePermission(ctx context.Context, in *PurgePermissionRequest, opts ...grpc.CallOption) (*PurgePermissionResponse, error)
 }
 
 type rBACServiceClient struct {
@@ -246,6 +259,66 @@ func (c *rBACServiceClient) CheckPermission(ctx context.Context, in *CheckPermis
 	return out, nil
 }
 
+func (c *rBACServiceClient) ListDeletedRoles(ctx context.Context, in *ListDeletedRolesRequest, opts ...grpc.CallOption) (*ListDeletedRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedRolesResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListDeletedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRoleResponse)
+	err := c.cc.Invoke(ctx, RBACService_RestoreRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) PurgeRole(ctx context.Context, in *PurgeRoleRequest, opts ...grpc.CallOption) (*PurgeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeRoleResponse)
+	err := c.cc.Invoke(ctx, RBACService_PurgeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) ListDeletedPermissions(ctx context.Context, in *ListDeletedPermissionsRequest, opts ...grpc.CallOption) (*ListDeletedPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedPermissionsResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListDeletedPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) RestorePermission(ctx context.Context, in *RestorePermissionRequest, opts ...grpc.CallOption) (*RestorePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePermissionResponse)
+	err := c.cc.Invoke(ctx, RBACService_RestorePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) PurgePermission(ctx context.Context, in *PurgePermissionRequest, opts ...grpc.CallOption) (*PurgePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgePermissionResponse)
+	err := c.cc.Invoke(ctx, RBACService_PurgePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RBACServiceServer is the server API for RBACService service.
 // All implementations must embed UnimplementedRBACServiceServer
 // for forward compatibility.
@@ -274,6 +347,13 @@ type RBACServiceServer interface {
 	RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error)
 	// 权限检查
 	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// 回收站：已删除的角色和权限，恢复或彻底删除
+	ListDeletedRoles(context.Context, *ListDeletedRolesRequest) (*ListDeletedRolesResponse, error)
+	RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error)
+	PurgeRole(context.Context, *PurgeRoleRequest) (*PurgeRoleResponse, error)
+	ListDeletedPermissions(context.Context, *ListDeletedPermissionsRequest) (*ListDeletedPermissionsResponse, error)
+	RestorePermission(context.Context, *RestorePermissionRequest) (*RestorePermissionResponse, error)
+	PurgePermission(context.Context, *PurgePermissionRequest) (*PurgePermissionResponse, error)
 	mustEmbedUnimplementedRBACServiceServer()
 }
 
@@ -335,6 +415,24 @@ func (UnimplementedRBACServiceServer) RemoveRolePermission(context.Context, *Rem
 func (UnimplementedRBACServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
 }
+func (UnimplementedRBACServiceServer) ListDeletedRoles(context.Context, *ListDeletedRolesRequest) (*ListDeletedRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedRoles not implemented")
+}
+func (UnimplementedRBACServiceServer) RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRole not implemented")
+}
+func (UnimplementedRBACServiceServer) PurgeRole(context.Context, *PurgeRoleRequest) (*PurgeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeRole not implemented")
+}
+func (UnimplementedRBACServiceServer) ListDeletedPermissions(context.Context, *ListDeletedPermissionsRequest) (*ListDeletedPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPermissions not implemented")
+}
+func (UnimplementedRBACServiceServer) RestorePermission(context.Context, *RestorePermissionRequest) (*RestorePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePermission not implemented")
+}
+func (UnimplementedRBACServiceServer) PurgePermission(context.Context, *PurgePermissionRequest) (*PurgePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePermission not implemented")
+}
 func (UnimplementedRBACServiceServer) mustEmbedUnimplementedRBACServiceServer() {}
 func (UnimplementedRBACServiceServer) testEmbeddedByValue()                     {}
 
@@ -662,6 +760,114 @@ func _RBACService_CheckPermission_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RBACService_ListDeletedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListDeletedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListDeletedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListDeletedRoles(ctx, req.(*ListDeletedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_RestoreRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).RestoreRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_RestoreRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).RestoreRole(ctx, req.(*RestoreRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_PurgeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).PurgeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_PurgeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).PurgeRole(ctx, req.(*PurgeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_ListDeletedPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListDeletedPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListDeletedPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListDeletedPermissions(ctx, req.(*ListDeletedPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_RestorePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).RestorePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_RestorePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).RestorePermission(ctx, req.(*RestorePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_PurgePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).PurgePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_PurgePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).PurgePermission(ctx, req.(*PurgePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RBACService_ServiceDesc is the grpc.ServiceDesc for RBACService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -737,6 +943,30 @@ var RBACService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckPermission",
 			Handler:    _RBACService_CheckPermission_Handler,
 		},
+		{
+			MethodName: "ListDeletedRoles",
+			Handler:    _RBACService_ListDeletedRoles_Handler,
+		},
+		{
+			MethodName: "RestoreRole",
+			Handler:    _RBACService_RestoreRole_Handler,
+		},
+		{
+			MethodName: "PurgeRole",
+			Handler:    _RBACService_PurgeRole_Handler,
+		},
+		{
+			MethodName: "ListDeletedPermissions",
+			Handler:    _RBACService_ListDeletedPermissions_Handler,
+		},
+		{
+			MethodName: "RestorePermission",
+			Handler:    _RBACService_RestorePermission_Handler,
+		},
+		{
+			MethodName: "PurgePermission",
+			Handler:    _RBACService_PurgePermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rbac/v1/rbac.proto",
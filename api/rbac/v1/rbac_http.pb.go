@@ -30,10 +30,16 @@ const OperationRBACServiceGetPermission = "/api.rbac.v1.RBACService/GetPermissio
 const OperationRBACServiceGetRole = "/api.rbac.v1.RBACService/GetRole"
 const OperationRBACServiceGetRolePermissions = "/api.rbac.v1.RBACService/GetRolePermissions"
 const OperationRBACServiceGetUserRoles = "/api.rbac.v1.RBACService/GetUserRoles"
+const OperationRBACServiceListDeletedPermissions = "/api.rbac.v1.RBACService/ListDeletedPermissions"
+const OperationRBACServiceListDeletedRoles = "/api.rbac.v1.RBACService/ListDeletedRoles"
 const OperationRBACServiceListPermissions = "/api.rbac.v1.RBACService/ListPermissions"
 const OperationRBACServiceListRoles = "/api.rbac.v1.RBACService/ListRoles"
+const OperationRBACServicePurgePermission = "/api.rbac.v1.RBACService/PurgePermission"
+const OperationRBACServicePurgeRole = "/api.rbac.v1.RBACService/PurgeRole"
 const OperationRBACServiceRemoveRolePermission = "/api.rbac.v1.RBACService/RemoveRolePermission"
 const OperationRBACServiceRemoveUserRole = "/api.rbac.v1.RBACService/RemoveUserRole"
+const OperationRBACServiceRestorePermission = "/api.rbac.v1.RBACService/RestorePermission"
+const OperationRBACServiceRestoreRole = "/api.rbac.v1.RBACService/RestoreRole"
 const OperationRBACServiceUpdatePermission = "/api.rbac.v1.RBACService/UpdatePermission"
 const OperationRBACServiceUpdateRole = "/api.rbac.v1.RBACService/UpdateRole"
 
@@ -54,10 +60,17 @@ type RBACServiceHTTPServer interface {
 	GetRolePermissions(context.Context, *GetRolePermissionsRequest) (*GetRolePermissionsResponse, error)
 	// GetUserRoles 用户角色管理
 	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
+	ListDeletedPermissions(context.Context, *ListDeletedPermissionsRequest) (*ListDeletedPermissionsResponse, error)
+	// ListDeletedRoles 回收站：已删除的角色和权限，恢复或彻底删除
+	ListDeletedRoles(context.Context, *ListDeletedRolesRequest) (*ListDeletedRolesResponse, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	PurgePermission(context.Context, *PurgePermissionRequest) (*PurgePermissionResponse, error)
+	PurgeRole(context.Context, *PurgeRoleRequest) (*PurgeRoleResponse, error)
 	RemoveRolePermission(context.Context, *RemoveRolePermissionRequest) (*RemoveRolePermissionResponse, error)
 	RemoveUserRole(context.Context, *RemoveUserRoleRequest) (*RemoveUserRoleResponse, error)
+	RestorePermission(context.Context, *RestorePermissionRequest) (*RestorePermissionResponse, error)
+	RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error)
 	UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 }
@@ -66,12 +79,14 @@ func RegisterRBACServiceHTTPServer(s *http.Server, srv RBACServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/roles/{id}", _RBACService_GetRole0_HTTP_Handler(srv))
 	r.POST("/v1/roles", _RBACService_CreateRole0_HTTP_Handler(srv))
-	r.PUT("/v1/roles/{id}", _RBACService_UpdateRole0_HTTP_Handler(srv))
+	r.PATCH("/v1/roles/{id}", _RBACService_UpdateRole0_HTTP_Handler(srv))
+	r.PUT("/v1/roles/{id}", _RBACService_UpdateRole1_HTTP_Handler(srv))
 	r.DELETE("/v1/roles/{id}", _RBACService_DeleteRole0_HTTP_Handler(srv))
 	r.GET("/v1/roles", _RBACService_ListRoles0_HTTP_Handler(srv))
 	r.GET("/v1/permissions/{id}", _RBACService_GetPermission0_HTTP_Handler(srv))
 	r.POST("/v1/permissions", _RBACService_CreatePermission0_HTTP_Handler(srv))
-	r.PUT("/v1/permissions/{id}", _RBACService_UpdatePermission0_HTTP_Handler(srv))
+	r.PATCH("/v1/permissions/{id}", _RBACService_UpdatePermission0_HTTP_Handler(srv))
+	r.PUT("/v1/permissions/{id}", _RBACService_UpdatePermission1_HTTP_Handler(srv))
 	r.DELETE("/v1/permissions/{id}", _RBACService_DeletePermission0_HTTP_Handler(srv))
 	r.GET("/v1/permissions", _RBACService_ListPermissions0_HTTP_Handler(srv))
 	r.GET("/v1/users/{user_id}/roles", _RBACService_GetUserRoles0_HTTP_Handler(srv))
@@ -81,6 +96,12 @@ func RegisterRBACServiceHTTPServer(s *http.Server, srv RBACServiceHTTPServer) {
 	r.POST("/v1/roles/{role_id}/permissions", _RBACService_AssignRolePermission0_HTTP_Handler(srv))
 	r.DELETE("/v1/roles/{role_id}/permissions/{permission_id}", _RBACService_RemoveRolePermission0_HTTP_Handler(srv))
 	r.POST("/v1/permissions/check", _RBACService_CheckPermission0_HTTP_Handler(srv))
+	r.GET("/v1/recycle-bin/roles", _RBACService_ListDeletedRoles0_HTTP_Handler(srv))
+	r.POST("/v1/recycle-bin/roles/{id}/restore", _RBACService_RestoreRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/recycle-bin/roles/{id}", _RBACService_PurgeRole0_HTTP_Handler(srv))
+	r.GET("/v1/recycle-bin/permissions", _RBACService_ListDeletedPermissions0_HTTP_Handler(srv))
+	r.POST("/v1/recycle-bin/permissions/{id}/restore", _RBACService_RestorePermission0_HTTP_Handler(srv))
+	r.DELETE("/v1/recycle-bin/permissions/{id}", _RBACService_PurgePermission0_HTTP_Handler(srv))
 }
 
 func _RBACService_GetRole0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
@@ -152,6 +173,31 @@ func _RBACService_UpdateRole0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx h
 	}
 }
 
+func _RBACService_UpdateRole1_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RBACService_DeleteRole0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteRoleRequest
@@ -262,6 +308,31 @@ func _RBACService_UpdatePermission0_HTTP_Handler(srv RBACServiceHTTPServer) func
 	}
 }
 
+func _RBACService_UpdatePermission1_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePermissionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceUpdatePermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePermission(ctx, req.(*UpdatePermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePermissionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RBACService_DeletePermission0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeletePermissionRequest
@@ -463,6 +534,138 @@ func _RBACService_CheckPermission0_HTTP_Handler(srv RBACServiceHTTPServer) func(
 	}
 }
 
+func _RBACService_ListDeletedRoles0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceListDeletedRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedRoles(ctx, req.(*ListDeletedRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedRolesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBACService_RestoreRole0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceRestoreRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreRole(ctx, req.(*RestoreRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBACService_PurgeRole0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServicePurgeRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeRole(ctx, req.(*PurgeRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBACService_ListDeletedPermissions0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedPermissionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceListDeletedPermissions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedPermissions(ctx, req.(*ListDeletedPermissionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedPermissionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBACService_RestorePermission0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestorePermissionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServiceRestorePermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestorePermission(ctx, req.(*RestorePermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestorePermissionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBACService_PurgePermission0_HTTP_Handler(srv RBACServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgePermissionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACServicePurgePermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgePermission(ctx, req.(*PurgePermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgePermissionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type RBACServiceHTTPClient interface {
 	AssignRolePermission(ctx context.Context, req *AssignRolePermissionRequest, opts ...http.CallOption) (rsp *AssignRolePermissionResponse, err error)
 	AssignUserRole(ctx context.Context, req *AssignUserRoleRequest, opts ...http.CallOption) (rsp *AssignUserRoleResponse, err error)
@@ -475,10 +678,16 @@ type RBACServiceHTTPClient interface {
 	GetRole(ctx context.Context, req *GetRoleRequest, opts ...http.CallOption) (rsp *GetRoleResponse, err error)
 	GetRolePermissions(ctx context.Context, req *GetRolePermissionsRequest, opts ...http.CallOption) (rsp *GetRolePermissionsResponse, err error)
 	GetUserRoles(ctx context.Context, req *GetUserRolesRequest, opts ...http.CallOption) (rsp *GetUserRolesResponse, err error)
+	ListDeletedPermissions(ctx context.Context, req *ListDeletedPermissionsRequest, opts ...http.CallOption) (rsp *ListDeletedPermissionsResponse, err error)
+	ListDeletedRoles(ctx context.Context, req *ListDeletedRolesRequest, opts ...http.CallOption) (rsp *ListDeletedRolesResponse, err error)
 	ListPermissions(ctx context.Context, req *ListPermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
 	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesResponse, err error)
+	PurgePermission(ctx context.Context, req *PurgePermissionRequest, opts ...http.CallOption) (rsp *PurgePermissionResponse, err error)
+	PurgeRole(ctx context.Context, req *PurgeRoleRequest, opts ...http.CallOption) (rsp *PurgeRoleResponse, err error)
 	RemoveRolePermission(ctx context.Context, req *RemoveRolePermissionRequest, opts ...http.CallOption) (rsp *RemoveRolePermissionResponse, err error)
 	RemoveUserRole(ctx context.Context, req *RemoveUserRoleRequest, opts ...http.CallOption) (rsp *RemoveUserRoleResponse, err error)
+	RestorePermission(ctx context.Context, req *RestorePermissionRequest, opts ...http.CallOption) (rsp *RestorePermissionResponse, err error)
+	RestoreRole(ctx context.Context, req *RestoreRoleRequest, opts ...http.CallOption) (rsp *RestoreRoleResponse, err error)
 	UpdatePermission(ctx context.Context, req *UpdatePermissionRequest, opts ...http.CallOption) (rsp *UpdatePermissionResponse, err error)
 	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *UpdateRoleResponse, err error)
 }
@@ -634,6 +843,32 @@ func (c *RBACServiceHTTPClientImpl) GetUserRoles(ctx context.Context, in *GetUse
 	return &out, nil
 }
 
+func (c *RBACServiceHTTPClientImpl) ListDeletedPermissions(ctx context.Context, in *ListDeletedPermissionsRequest, opts ...http.CallOption) (*ListDeletedPermissionsResponse, error) {
+	var out ListDeletedPermissionsResponse
+	pattern := "/v1/recycle-bin/permissions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACServiceListDeletedPermissions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACServiceHTTPClientImpl) ListDeletedRoles(ctx context.Context, in *ListDeletedRolesRequest, opts ...http.CallOption) (*ListDeletedRolesResponse, error) {
+	var out ListDeletedRolesResponse
+	pattern := "/v1/recycle-bin/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACServiceListDeletedRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RBACServiceHTTPClientImpl) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...http.CallOption) (*ListPermissionsResponse, error) {
 	var out ListPermissionsResponse
 	pattern := "/v1/permissions"
@@ -660,6 +895,32 @@ func (c *RBACServiceHTTPClientImpl) ListRoles(ctx context.Context, in *ListRoles
 	return &out, nil
 }
 
+func (c *RBACServiceHTTPClientImpl) PurgePermission(ctx context.Context, in *PurgePermissionRequest, opts ...http.CallOption) (*PurgePermissionResponse, error) {
+	var out PurgePermissionResponse
+	pattern := "/v1/recycle-bin/permissions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACServicePurgePermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACServiceHTTPClientImpl) PurgeRole(ctx context.Context, in *PurgeRoleRequest, opts ...http.CallOption) (*PurgeRoleResponse, error) {
+	var out PurgeRoleResponse
+	pattern := "/v1/recycle-bin/roles/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACServicePurgeRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RBACServiceHTTPClientImpl) RemoveRolePermission(ctx context.Context, in *RemoveRolePermissionRequest, opts ...http.CallOption) (*RemoveRolePermissionResponse, error) {
 	var out RemoveRolePermissionResponse
 	pattern := "/v1/roles/{role_id}/permissions/{permission_id}"
@@ -686,6 +947,32 @@ func (c *RBACServiceHTTPClientImpl) RemoveUserRole(ctx context.Context, in *Remo
 	return &out, nil
 }
 
+func (c *RBACServiceHTTPClientImpl) RestorePermission(ctx context.Context, in *RestorePermissionRequest, opts ...http.CallOption) (*RestorePermissionResponse, error) {
+	var out RestorePermissionResponse
+	pattern := "/v1/recycle-bin/permissions/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACServiceRestorePermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACServiceHTTPClientImpl) RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...http.CallOption) (*RestoreRoleResponse, error) {
+	var out RestoreRoleResponse
+	pattern := "/v1/recycle-bin/roles/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACServiceRestoreRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RBACServiceHTTPClientImpl) UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...http.CallOption) (*UpdatePermissionResponse, error) {
 	var out UpdatePermissionResponse
 	pattern := "/v1/permissions/{id}"
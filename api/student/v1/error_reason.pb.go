@@ -7,12 +7,12 @@
 package v1
 
 import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
-
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
 
 const (
@@ -27,6 +27,8 @@ type ErrorReason int32
 const (
 	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
 	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	// 数据已被其他请求修改，版本号与 If-Match 不一致
+	ErrorReason_VERSION_CONFLICT ErrorReason = 2
 )
 
 // Enum value maps for ErrorReason.
@@ -34,10 +36,12 @@ var (
 	ErrorReason_name = map[int32]string{
 		0: "GREETER_UNSPECIFIED",
 		1: "USER_NOT_FOUND",
+		2: "VERSION_CONFLICT",
 	}
 	ErrorReason_value = map[string]int32{
 		"GREETER_UNSPECIFIED": 0,
 		"USER_NOT_FOUND":      1,
+		"VERSION_CONFLICT":    2,
 	}
 )
 
@@ -73,10 +77,11 @@ var File_student_v1_error_reason_proto protoreflect.FileDescriptor
 const file_student_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"\x1dstudent/v1/error_reason.proto\x12\n" +
-	"student.v1*:\n" +
+	"student.v1\x1a\x13errors/errors.proto*b\n" +
 	"\vErrorReason\x12\x17\n" +
-	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_NOT_FOUND\x10\x01B\x1bZ\x19student/api/student/v1;v1b\x06proto3"
+	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\x01\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10VERSION_CONFLICT\x10\x02\x1a\x04\xa8E\x99\x03\x1a\x04\xa0E\xf4\x03B\x1bZ\x19student/api/student/v1;v1b\x06proto3"
 
 var (
 	file_student_v1_error_reason_proto_rawDescOnce sync.Once
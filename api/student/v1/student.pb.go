@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -166,14 +167,16 @@ func (x *GetStudentRequest) GetId() int32 {
 
 // The response message containing the greetings
 type GetStudentReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	Info          string                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status    int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id        int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Info      string                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Age       int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt string                 `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// 版本号，每次修改加一，HTTP 响应头 ETag 为 "版本号"
+	Version       uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -257,12 +260,20 @@ func (x *GetStudentReply) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *GetStudentReply) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Age           int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	Info          string                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Age   int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	// 学籍状态：0 申请中，1 在读，2 休学，3 毕业，4 退学；新学生只能是 0 或 1
+	Status        int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Info          string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,12 +381,17 @@ func (x *CreateStudentReply) GetMessage() string {
 }
 
 type UpdateStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Age           int32                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
-	Info          string                 `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Age   int32                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// 必须与当前学籍状态一致，修改状态请使用 ChangeStudentStatus
+	Status int32  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Info   string `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	// 需要更新的字段，如 "name,info"；为空时更新所有字段
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -445,9 +461,25 @@ func (x *UpdateStudentRequest) GetInfo() string {
 	return ""
 }
 
+func (x *UpdateStudentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateStudentRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateStudentReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// 更新后的版本号
+	Version       uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -489,9 +521,18 @@ func (x *UpdateStudentReply) GetMessage() string {
 	return ""
 }
 
+func (x *UpdateStudentReply) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 读取时的版本号，也可以通过 If-Match 请求头传入，与当前版本不一致时返回 VERSION_CONFLICT
+	Version       uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -533,6 +574,13 @@ func (x *DeleteStudentRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeleteStudentRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type DeleteStudentReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -578,14 +626,17 @@ func (x *DeleteStudentReply) GetMessage() string {
 }
 
 type Students struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Age           int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	Info          string                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Id            int32                  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Age       int32                  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Status    int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Info      string                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Id        int32                  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version   uint32                 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// 删除时间，只在回收站中返回
+	DeletedAt     string `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -669,11 +720,31 @@ func (x *Students) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Students) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Students) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
 type ListStudentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      string                 `protobuf:"bytes,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Page          string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize string                 `protobuf:"bytes,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Page     string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 过滤表达式，如 status in (1, 2) AND age >= 18 AND name = "张*"
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 排序，如 age desc, id
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// 上一次响应中的 next_page_token，为空时从第一页开始
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -729,10 +800,33 @@ func (x *ListStudentsRequest) GetName() string {
 	return ""
 }
 
+func (x *ListStudentsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListStudentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListStudentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListStudentsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Students            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []*Students            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// 下一页的令牌，为空时表示没有下一页
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -781,75 +875,2860 @@ func (x *ListStudentsReply) GetTotal() int32 {
 	return 0
 }
 
-var File_student_v1_student_proto protoreflect.FileDescriptor
+func (x *ListStudentsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_student_v1_student_proto_rawDesc = "" +
-	"\n" +
-	"\x18student/v1/student.proto\x12\n" +
-	"student.v1\x1a\x1cgoogle/api/annotations.proto\"\x14\n" +
-	"\x12HealthCheckRequest\"b\n" +
-	"\x10HealthCheckReply\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp\"#\n" +
-	"\x11GetStudentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xb3\x01\n" +
-	"\x0fGetStudentReply\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04info\x18\x04 \x01(\tR\x04info\x12\x10\n" +
-	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x1e\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\n" +
-	"created_at\x12\x1e\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\tR\n" +
-	"updated_at\"h\n" +
-	"\x14CreateStudentRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
-	"\x04info\x18\x04 \x01(\tR\x04info\".\n" +
-	"\x12CreateStudentReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"x\n" +
-	"\x14UpdateStudentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
-	"\x03age\x18\x03 \x01(\x05R\x03age\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x12\n" +
-	"\x04info\x18\x05 \x01(\tR\x04info\".\n" +
-	"\x12UpdateStudentReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"&\n" +
-	"\x14DeleteStudentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
-	"\x12DeleteStudentReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xaa\x01\n" +
-	"\bStudents\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
-	"\x04info\x18\x04 \x01(\tR\x04info\x12\x0e\n" +
-	"\x02id\x18\x05 \x01(\x05R\x02id\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"Z\n" +
-	"\x13ListStudentsRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\tR\bpageSize\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\tR\x04page\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"S\n" +
-	"\x11ListStudentsReply\x12(\n" +
-	"\x04data\x18\x01 \x03(\v2\x14.student.v1.StudentsR\x04data\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\x85\x05\n" +
-	"\aStudent\x12h\n" +
-	"\vHealthCheck\x12\x1e.student.v1.HealthCheckRequest\x1a\x1c.student.v1.HealthCheckReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/students/health\x12b\n" +
-	"\n" +
-	"GetStudent\x12\x1d.student.v1.GetStudentRequest\x1a\x1b.student.v1.GetStudentReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/student/{id}\x12i\n" +
-	"\rCreateStudent\x12 .student.v1.CreateStudentRequest\x1a\x1e.student.v1.CreateStudentReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/student\x12n\n" +
-	"\rUpdateStudent\x12 .student.v1.UpdateStudentRequest\x1a\x1e.student.v1.UpdateStudentReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/student/{id}\x12k\n" +
-	"\rDeleteStudent\x12 .student.v1.DeleteStudentRequest\x1a\x1e.student.v1.DeleteStudentReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/student/{id}\x12d\n" +
-	"\fListStudents\x12\x1f.student.v1.ListStudentsRequest\x1a\x1d.student.v1.ListStudentsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/studentsB\x1bZ\x19student/api/student/v1;v1b\x06proto3"
+// 学生导入相关消息
+type ImportStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件内容，JSON 中为 base64 编码
+	File []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// csv 或 xlsx，为空时根据 filename 的扩展名判断
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	DryRun        bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStudentsRequest) Reset() {
+	*x = ImportStudentsRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStudentsRequest) ProtoMessage() {}
+
+func (x *ImportStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ImportStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportStudentsRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportStudentsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportStudentsRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportStudentsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type StudentImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件中的行号，从 1 开始
+	Row           int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentImportError) Reset() {
+	*x = StudentImportError{}
+	mi := &file_student_v1_student_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentImportError) ProtoMessage() {}
+
+func (x *StudentImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentImportError.ProtoReflect.Descriptor instead.
+func (*StudentImportError) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StudentImportError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *StudentImportError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *StudentImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportStudentsReply struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DryRun bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 数据行数，不含表头和空行
+	Total         int32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Valid         int32                 `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Imported      int32                 `protobuf:"varint,4,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors        []*StudentImportError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStudentsReply) Reset() {
+	*x = ImportStudentsReply{}
+	mi := &file_student_v1_student_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStudentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStudentsReply) ProtoMessage() {}
+
+func (x *ImportStudentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStudentsReply.ProtoReflect.Descriptor instead.
+func (*ImportStudentsReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportStudentsReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportStudentsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportStudentsReply) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportStudentsReply) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportStudentsReply) GetErrors() []*StudentImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// 学生搜索相关消息
+type SearchStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentsRequest) Reset() {
+	*x = SearchStudentsRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentsRequest) ProtoMessage() {}
+
+func (x *SearchStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchStudentsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchStudentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchStudentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchHighlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 字段名：name 或 info
+	Field         string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Fragments     []string `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_student_v1_student_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetFragments() []string {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+type StudentSearchHit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Student *Students              `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	// 相关度得分，只用于同一次搜索的结果之间比较
+	Score         float64            `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights    []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentSearchHit) Reset() {
+	*x = StudentSearchHit{}
+	mi := &file_student_v1_student_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSearchHit) ProtoMessage() {}
+
+func (x *StudentSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSearchHit.ProtoReflect.Descriptor instead.
+func (*StudentSearchHit) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StudentSearchHit) GetStudent() *Students {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *StudentSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StudentSearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchStudentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*StudentSearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentsReply) Reset() {
+	*x = SearchStudentsReply{}
+	mi := &file_student_v1_student_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentsReply) ProtoMessage() {}
+
+func (x *SearchStudentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentsReply.ProtoReflect.Descriptor instead.
+func (*SearchStudentsReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchStudentsReply) GetHits() []*StudentSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchStudentsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 回收站相关消息
+type ListDeletedStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedStudentsRequest) Reset() {
+	*x = ListDeletedStudentsRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedStudentsRequest) ProtoMessage() {}
+
+func (x *ListDeletedStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeletedStudentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedStudentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedStudentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Students            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedStudentsReply) Reset() {
+	*x = ListDeletedStudentsReply{}
+	mi := &file_student_v1_student_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedStudentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedStudentsReply) ProtoMessage() {}
+
+func (x *ListDeletedStudentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedStudentsReply.ProtoReflect.Descriptor instead.
+func (*ListDeletedStudentsReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDeletedStudentsReply) GetData() []*Students {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListDeletedStudentsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestoreStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStudentRequest) Reset() {
+	*x = RestoreStudentRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentRequest) ProtoMessage() {}
+
+func (x *RestoreStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStudentRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreStudentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreStudentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Student       *Students              `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStudentReply) Reset() {
+	*x = RestoreStudentReply{}
+	mi := &file_student_v1_student_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentReply) ProtoMessage() {}
+
+func (x *RestoreStudentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentReply.ProtoReflect.Descriptor instead.
+func (*RestoreStudentReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreStudentReply) GetStudent() *Students {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+type PurgeStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeStudentRequest) Reset() {
+	*x = PurgeStudentRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStudentRequest) ProtoMessage() {}
+
+func (x *PurgeStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStudentRequest.ProtoReflect.Descriptor instead.
+func (*PurgeStudentRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PurgeStudentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeStudentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeStudentReply) Reset() {
+	*x = PurgeStudentReply{}
+	mi := &file_student_v1_student_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeStudentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStudentReply) ProtoMessage() {}
+
+func (x *PurgeStudentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStudentReply.ProtoReflect.Descriptor instead.
+func (*PurgeStudentReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PurgeStudentReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 学生导出相关消息
+type ExportStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// csv、jsonl 或 xlsx，默认为 csv
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStudentsRequest) Reset() {
+	*x = ExportStudentsRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStudentsRequest) ProtoMessage() {}
+
+func (x *ExportStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportStudentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportStudentsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 导出文件的分块，按顺序拼接即为完整文件
+type ExportChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// 只在第一个分块中返回
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_student_v1_student_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// 成绩相关消息
+type Grade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     uint32                 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseCode    string                 `protobuf:"bytes,4,opt,name=course_code,json=courseCode,proto3" json:"course_code,omitempty"`
+	CourseName    string                 `protobuf:"bytes,5,opt,name=course_name,json=courseName,proto3" json:"course_name,omitempty"`
+	Credits       float64                `protobuf:"fixed64,6,opt,name=credits,proto3" json:"credits,omitempty"`
+	Term          string                 `protobuf:"bytes,7,opt,name=term,proto3" json:"term,omitempty"`
+	Score         float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	Letter        string                 `protobuf:"bytes,9,opt,name=letter,proto3" json:"letter,omitempty"`
+	Points        float64                `protobuf:"fixed64,10,opt,name=points,proto3" json:"points,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	mi := &file_student_v1_student_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Grade) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Grade) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *Grade) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Grade) GetCourseCode() string {
+	if x != nil {
+		return x.CourseCode
+	}
+	return ""
+}
+
+func (x *Grade) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *Grade) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Grade) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Grade) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Grade) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *Grade) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Grade) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Grade) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RecordGradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     uint32                 `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordGradeRequest) Reset() {
+	*x = RecordGradeRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordGradeRequest) ProtoMessage() {}
+
+func (x *RecordGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordGradeRequest.ProtoReflect.Descriptor instead.
+func (*RecordGradeRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecordGradeRequest) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *RecordGradeRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *RecordGradeRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *RecordGradeRequest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RecordGradeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grade         *Grade                 `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordGradeReply) Reset() {
+	*x = RecordGradeReply{}
+	mi := &file_student_v1_student_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordGradeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordGradeReply) ProtoMessage() {}
+
+func (x *RecordGradeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordGradeReply.ProtoReflect.Descriptor instead.
+func (*RecordGradeReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RecordGradeReply) GetGrade() *Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+type DeleteGradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeRequest) Reset() {
+	*x = DeleteGradeRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeRequest) ProtoMessage() {}
+
+func (x *DeleteGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGradeRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteGradeRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteGradeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGradeReply) Reset() {
+	*x = DeleteGradeReply{}
+	mi := &file_student_v1_student_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGradeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGradeReply) ProtoMessage() {}
+
+func (x *DeleteGradeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGradeReply.ProtoReflect.Descriptor instead.
+func (*DeleteGradeReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteGradeReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	StudentId     uint32                 `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term          string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradesRequest) Reset() {
+	*x = ListGradesRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradesRequest) ProtoMessage() {}
+
+func (x *ListGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradesRequest.ProtoReflect.Descriptor instead.
+func (*ListGradesRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListGradesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGradesRequest) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *ListGradesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type ListGradesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGradesReply) Reset() {
+	*x = ListGradesReply{}
+	mi := &file_student_v1_student_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGradesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradesReply) ProtoMessage() {}
+
+func (x *ListGradesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradesReply.ProtoReflect.Descriptor instead.
+func (*ListGradesReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListGradesReply) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *ListGradesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTranscriptRequest) Reset() {
+	*x = GetTranscriptRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptRequest) ProtoMessage() {}
+
+func (x *GetTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*GetTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTranscriptRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TermTranscript struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Term   string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Grades []*Grade               `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	// 已取得的学分
+	Credits       float64 `protobuf:"fixed64,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	Gpa           float64 `protobuf:"fixed64,4,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermTranscript) Reset() {
+	*x = TermTranscript{}
+	mi := &file_student_v1_student_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermTranscript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermTranscript) ProtoMessage() {}
+
+func (x *TermTranscript) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermTranscript.ProtoReflect.Descriptor instead.
+func (*TermTranscript) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TermTranscript) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermTranscript) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *TermTranscript) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TermTranscript) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+type GetTranscriptReply struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Terms       []*TermTranscript      `protobuf:"bytes,3,rep,name=terms,proto3" json:"terms,omitempty"`
+	// 修读的学分，含不及格课程
+	AttemptedCredits float64 `protobuf:"fixed64,4,opt,name=attempted_credits,json=attemptedCredits,proto3" json:"attempted_credits,omitempty"`
+	EarnedCredits    float64 `protobuf:"fixed64,5,opt,name=earned_credits,json=earnedCredits,proto3" json:"earned_credits,omitempty"`
+	Gpa              float64 `protobuf:"fixed64,6,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetTranscriptReply) Reset() {
+	*x = GetTranscriptReply{}
+	mi := &file_student_v1_student_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTranscriptReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptReply) ProtoMessage() {}
+
+func (x *GetTranscriptReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptReply.ProtoReflect.Descriptor instead.
+func (*GetTranscriptReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTranscriptReply) GetStudentId() int32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *GetTranscriptReply) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *GetTranscriptReply) GetTerms() []*TermTranscript {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *GetTranscriptReply) GetAttemptedCredits() float64 {
+	if x != nil {
+		return x.AttemptedCredits
+	}
+	return 0
+}
+
+func (x *GetTranscriptReply) GetEarnedCredits() float64 {
+	if x != nil {
+		return x.EarnedCredits
+	}
+	return 0
+}
+
+func (x *GetTranscriptReply) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+// 学籍变动相关消息
+type StudentStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromStatus    int32                  `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      int32                  `protobuf:"varint,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Term          string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId    uint32                 `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentStatusChange) Reset() {
+	*x = StudentStatusChange{}
+	mi := &file_student_v1_student_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentStatusChange) ProtoMessage() {}
+
+func (x *StudentStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentStatusChange.ProtoReflect.Descriptor instead.
+func (*StudentStatusChange) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StudentStatusChange) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StudentStatusChange) GetFromStatus() int32 {
+	if x != nil {
+		return x.FromStatus
+	}
+	return 0
+}
+
+func (x *StudentStatusChange) GetToStatus() int32 {
+	if x != nil {
+		return x.ToStatus
+	}
+	return 0
+}
+
+func (x *StudentStatusChange) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *StudentStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StudentStatusChange) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *StudentStatusChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ChangeStudentStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 学期编号，为空时使用当前日期所在的学期
+	Term          string `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStudentStatusRequest) Reset() {
+	*x = ChangeStudentStatusRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStudentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStudentStatusRequest) ProtoMessage() {}
+
+func (x *ChangeStudentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStudentStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeStudentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ChangeStudentStatusRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeStudentStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ChangeStudentStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChangeStudentStatusRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type ChangeStudentStatusReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *StudentStatusChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStudentStatusReply) Reset() {
+	*x = ChangeStudentStatusReply{}
+	mi := &file_student_v1_student_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStudentStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStudentStatusReply) ProtoMessage() {}
+
+func (x *ChangeStudentStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStudentStatusReply.ProtoReflect.Descriptor instead.
+func (*ChangeStudentStatusReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeStudentStatusReply) GetChange() *StudentStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type ListStudentStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudentStatusChangesRequest) Reset() {
+	*x = ListStudentStatusChangesRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentStatusChangesRequest) ProtoMessage() {}
+
+func (x *ListStudentStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListStudentStatusChangesRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListStudentStatusChangesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*StudentStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudentStatusChangesReply) Reset() {
+	*x = ListStudentStatusChangesReply{}
+	mi := &file_student_v1_student_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentStatusChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentStatusChangesReply) ProtoMessage() {}
+
+func (x *ListStudentStatusChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentStatusChangesReply.ProtoReflect.Descriptor instead.
+func (*ListStudentStatusChangesReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListStudentStatusChangesReply) GetChanges() []*StudentStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// 监护人相关消息
+type Guardian struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// 关联的登录账号，0 表示未关联
+	UserId        uint32 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guardian) Reset() {
+	*x = Guardian{}
+	mi := &file_student_v1_student_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardian) ProtoMessage() {}
+
+func (x *Guardian) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardian.ProtoReflect.Descriptor instead.
+func (*Guardian) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Guardian) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Guardian) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guardian) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guardian) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guardian) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Guardian) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Guardian) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type StudentGuardian struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Guardian *Guardian              `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// 监护关系：father、mother、grandparent、guardian、other
+	Relationship string `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	// 是否主要联系人，每个学生最多一个
+	IsPrimary     bool `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGuardian) Reset() {
+	*x = StudentGuardian{}
+	mi := &file_student_v1_student_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGuardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGuardian) ProtoMessage() {}
+
+func (x *StudentGuardian) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGuardian.ProtoReflect.Descriptor instead.
+func (*StudentGuardian) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StudentGuardian) GetGuardian() *Guardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *StudentGuardian) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type ListStudentGuardiansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudentGuardiansRequest) Reset() {
+	*x = ListStudentGuardiansRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentGuardiansRequest) ProtoMessage() {}
+
+func (x *ListStudentGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListStudentGuardiansRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListStudentGuardiansReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardians     []*StudentGuardian     `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudentGuardiansReply) Reset() {
+	*x = ListStudentGuardiansReply{}
+	mi := &file_student_v1_student_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentGuardiansReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentGuardiansReply) ProtoMessage() {}
+
+func (x *ListStudentGuardiansReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentGuardiansReply.ProtoReflect.Descriptor instead.
+func (*ListStudentGuardiansReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListStudentGuardiansReply) GetGuardians() []*StudentGuardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+type AddStudentGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuardianId    uint32                 `protobuf:"varint,2,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Relationship  string                 `protobuf:"bytes,6,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStudentGuardianRequest) Reset() {
+	*x = AddStudentGuardianRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStudentGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStudentGuardianRequest) ProtoMessage() {}
+
+func (x *AddStudentGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStudentGuardianRequest.ProtoReflect.Descriptor instead.
+func (*AddStudentGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddStudentGuardianRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddStudentGuardianRequest) GetGuardianId() uint32 {
+	if x != nil {
+		return x.GuardianId
+	}
+	return 0
+}
+
+func (x *AddStudentGuardianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddStudentGuardianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AddStudentGuardianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddStudentGuardianRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *AddStudentGuardianRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type AddStudentGuardianReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardian      *StudentGuardian       `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStudentGuardianReply) Reset() {
+	*x = AddStudentGuardianReply{}
+	mi := &file_student_v1_student_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStudentGuardianReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStudentGuardianReply) ProtoMessage() {}
+
+func (x *AddStudentGuardianReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStudentGuardianReply.ProtoReflect.Descriptor instead.
+func (*AddStudentGuardianReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddStudentGuardianReply) GetGuardian() *StudentGuardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+type UpdateStudentGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuardianId    uint32                 `protobuf:"varint,2,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Relationship  string                 `protobuf:"bytes,6,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,7,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStudentGuardianRequest) Reset() {
+	*x = UpdateStudentGuardianRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStudentGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStudentGuardianRequest) ProtoMessage() {}
+
+func (x *UpdateStudentGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStudentGuardianRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStudentGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateStudentGuardianRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStudentGuardianRequest) GetGuardianId() uint32 {
+	if x != nil {
+		return x.GuardianId
+	}
+	return 0
+}
+
+func (x *UpdateStudentGuardianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStudentGuardianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateStudentGuardianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateStudentGuardianRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *UpdateStudentGuardianRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type UpdateStudentGuardianReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardian      *StudentGuardian       `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStudentGuardianReply) Reset() {
+	*x = UpdateStudentGuardianReply{}
+	mi := &file_student_v1_student_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStudentGuardianReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStudentGuardianReply) ProtoMessage() {}
+
+func (x *UpdateStudentGuardianReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStudentGuardianReply.ProtoReflect.Descriptor instead.
+func (*UpdateStudentGuardianReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateStudentGuardianReply) GetGuardian() *StudentGuardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+type RemoveStudentGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuardianId    uint32                 `protobuf:"varint,2,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStudentGuardianRequest) Reset() {
+	*x = RemoveStudentGuardianRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStudentGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStudentGuardianRequest) ProtoMessage() {}
+
+func (x *RemoveStudentGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStudentGuardianRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStudentGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveStudentGuardianRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveStudentGuardianRequest) GetGuardianId() uint32 {
+	if x != nil {
+		return x.GuardianId
+	}
+	return 0
+}
+
+type RemoveStudentGuardianReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStudentGuardianReply) Reset() {
+	*x = RemoveStudentGuardianReply{}
+	mi := &file_student_v1_student_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStudentGuardianReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStudentGuardianReply) ProtoMessage() {}
+
+func (x *RemoveStudentGuardianReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStudentGuardianReply.ProtoReflect.Descriptor instead.
+func (*RemoveStudentGuardianReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RemoveStudentGuardianReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LinkGuardianUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGuardianUserRequest) Reset() {
+	*x = LinkGuardianUserRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGuardianUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGuardianUserRequest) ProtoMessage() {}
+
+func (x *LinkGuardianUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGuardianUserRequest.ProtoReflect.Descriptor instead.
+func (*LinkGuardianUserRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LinkGuardianUserRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LinkGuardianUserRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type LinkGuardianUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardian      *Guardian              `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGuardianUserReply) Reset() {
+	*x = LinkGuardianUserReply{}
+	mi := &file_student_v1_student_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGuardianUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGuardianUserReply) ProtoMessage() {}
+
+func (x *LinkGuardianUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGuardianUserReply.ProtoReflect.Descriptor instead.
+func (*LinkGuardianUserReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LinkGuardianUserReply) GetGuardian() *Guardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+type ListMyChildrenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyChildrenRequest) Reset() {
+	*x = ListMyChildrenRequest{}
+	mi := &file_student_v1_student_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyChildrenRequest) ProtoMessage() {}
+
+func (x *ListMyChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyChildrenRequest.ProtoReflect.Descriptor instead.
+func (*ListMyChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{55}
+}
+
+type ListMyChildrenReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*Students            `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyChildrenReply) Reset() {
+	*x = ListMyChildrenReply{}
+	mi := &file_student_v1_student_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyChildrenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyChildrenReply) ProtoMessage() {}
+
+func (x *ListMyChildrenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_student_v1_student_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyChildrenReply.ProtoReflect.Descriptor instead.
+func (*ListMyChildrenReply) Descriptor() ([]byte, []int) {
+	return file_student_v1_student_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMyChildrenReply) GetStudents() []*Students {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+var File_student_v1_student_proto protoreflect.FileDescriptor
+
+const file_student_v1_student_proto_rawDesc = "" +
+	"\n" +
+	"\x18student/v1/student.proto\x12\n" +
+	"student.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\x14\n" +
+	"\x12HealthCheckRequest\"b\n" +
+	"\x10HealthCheckReply\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp\"#\n" +
+	"\x11GetStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xcd\x01\n" +
+	"\x0fGetStudentReply\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04info\x18\x04 \x01(\tR\x04info\x12\x10\n" +
+	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\n" +
+	"created_at\x12\x1e\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\n" +
+	"updated_at\x12\x18\n" +
+	"\aversion\x18\b \x01(\rR\aversion\"h\n" +
+	"\x14CreateStudentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04info\x18\x04 \x01(\tR\x04info\".\n" +
+	"\x12CreateStudentReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xcf\x01\n" +
+	"\x14UpdateStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x03 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04info\x18\x05 \x01(\tR\x04info\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\a \x01(\rR\aversion\"H\n" +
+	"\x12UpdateStudentReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\"@\n" +
+	"\x14DeleteStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\".\n" +
+	"\x12DeleteStudentReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xe3\x01\n" +
+	"\bStudents\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04info\x18\x04 \x01(\tR\x04info\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\rR\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\tR\tdeletedAt\"\xac\x01\n" +
+	"\x13ListStudentsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\tR\bpageSize\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\tR\x04page\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"{\n" +
+	"\x11ListStudentsReply\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.student.v1.StudentsR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"x\n" +
+	"\x15ImportStudentsRequest\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\fR\x04file\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"X\n" +
+	"\x12StudentImportError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xae\x01\n" +
+	"\x13ImportStudentsReply\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\x05R\x05valid\x12\x1a\n" +
+	"\bimported\x18\x04 \x01(\x05R\bimported\x126\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1e.student.v1.StudentImportErrorR\x06errors\"V\n" +
+	"\x15SearchStudentsRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"E\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1c\n" +
+	"\tfragments\x18\x02 \x03(\tR\tfragments\"\x95\x01\n" +
+	"\x10StudentSearchHit\x12.\n" +
+	"\astudent\x18\x01 \x01(\v2\x14.student.v1.StudentsR\astudent\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12;\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x1b.student.v1.SearchHighlightR\n" +
+	"highlights\"]\n" +
+	"\x13SearchStudentsReply\x120\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1c.student.v1.StudentSearchHitR\x04hits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
+	"\x1aListDeletedStudentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Z\n" +
+	"\x18ListDeletedStudentsReply\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.student.v1.StudentsR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"'\n" +
+	"\x15RestoreStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"E\n" +
+	"\x13RestoreStudentReply\x12.\n" +
+	"\astudent\x18\x01 \x01(\v2\x14.student.v1.StudentsR\astudent\"%\n" +
+	"\x13PurgeStudentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x11PurgeStudentReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"C\n" +
+	"\x15ExportStudentsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"D\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xc7\x02\n" +
+	"\x05Grade\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\rR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\rR\bcourseId\x12\x1f\n" +
+	"\vcourse_code\x18\x04 \x01(\tR\n" +
+	"courseCode\x12\x1f\n" +
+	"\vcourse_name\x18\x05 \x01(\tR\n" +
+	"courseName\x12\x18\n" +
+	"\acredits\x18\x06 \x01(\x01R\acredits\x12\x12\n" +
+	"\x04term\x18\a \x01(\tR\x04term\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12\x16\n" +
+	"\x06letter\x18\t \x01(\tR\x06letter\x12\x16\n" +
+	"\x06points\x18\n" +
+	" \x01(\x01R\x06points\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"z\n" +
+	"\x12RecordGradeRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\rR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\";\n" +
+	"\x10RecordGradeReply\x12'\n" +
+	"\x05grade\x18\x01 \x01(\v2\x11.student.v1.GradeR\x05grade\"$\n" +
+	"\x12DeleteGradeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\",\n" +
+	"\x10DeleteGradeReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"w\n" +
+	"\x11ListGradesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\rR\tstudentId\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\"R\n" +
+	"\x0fListGradesReply\x12)\n" +
+	"\x06grades\x18\x01 \x03(\v2\x11.student.v1.GradeR\x06grades\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"&\n" +
+	"\x14GetTranscriptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"{\n" +
+	"\x0eTermTranscript\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12)\n" +
+	"\x06grades\x18\x02 \x03(\v2\x11.student.v1.GradeR\x06grades\x12\x18\n" +
+	"\acredits\x18\x03 \x01(\x01R\acredits\x12\x10\n" +
+	"\x03gpa\x18\x04 \x01(\x01R\x03gpa\"\xee\x01\n" +
+	"\x12GetTranscriptReply\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\x05R\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x120\n" +
+	"\x05terms\x18\x03 \x03(\v2\x1a.student.v1.TermTranscriptR\x05terms\x12+\n" +
+	"\x11attempted_credits\x18\x04 \x01(\x01R\x10attemptedCredits\x12%\n" +
+	"\x0eearned_credits\x18\x05 \x01(\x01R\rearnedCredits\x12\x10\n" +
+	"\x03gpa\x18\x06 \x01(\x01R\x03gpa\"\xcf\x01\n" +
+	"\x13StudentStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\x05R\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\x05R\btoStatus\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x06 \x01(\rR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"p\n" +
+	"\x1aChangeStudentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\"S\n" +
+	"\x18ChangeStudentStatusReply\x127\n" +
+	"\x06change\x18\x01 \x01(\v2\x1f.student.v1.StudentStatusChangeR\x06change\"1\n" +
+	"\x1fListStudentStatusChangesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Z\n" +
+	"\x1dListStudentStatusChangesReply\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.student.v1.StudentStatusChangeR\achanges\"\xb1\x01\n" +
+	"\bGuardian\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x86\x01\n" +
+	"\x0fStudentGuardian\x120\n" +
+	"\bguardian\x18\x01 \x01(\v2\x14.student.v1.GuardianR\bguardian\x12\"\n" +
+	"\frelationship\x18\x02 \x01(\tR\frelationship\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\"-\n" +
+	"\x1bListStudentGuardiansRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"V\n" +
+	"\x19ListStudentGuardiansReply\x129\n" +
+	"\tguardians\x18\x01 \x03(\v2\x1b.student.v1.StudentGuardianR\tguardians\"\xcf\x01\n" +
+	"\x19AddStudentGuardianRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vguardian_id\x18\x02 \x01(\rR\n" +
+	"guardianId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\"\n" +
+	"\frelationship\x18\x06 \x01(\tR\frelationship\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\a \x01(\bR\tisPrimary\"R\n" +
+	"\x17AddStudentGuardianReply\x127\n" +
+	"\bguardian\x18\x01 \x01(\v2\x1b.student.v1.StudentGuardianR\bguardian\"\xd2\x01\n" +
+	"\x1cUpdateStudentGuardianRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vguardian_id\x18\x02 \x01(\rR\n" +
+	"guardianId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\"\n" +
+	"\frelationship\x18\x06 \x01(\tR\frelationship\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\a \x01(\bR\tisPrimary\"U\n" +
+	"\x1aUpdateStudentGuardianReply\x127\n" +
+	"\bguardian\x18\x01 \x01(\v2\x1b.student.v1.StudentGuardianR\bguardian\"O\n" +
+	"\x1cRemoveStudentGuardianRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vguardian_id\x18\x02 \x01(\rR\n" +
+	"guardianId\"6\n" +
+	"\x1aRemoveStudentGuardianReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"B\n" +
+	"\x17LinkGuardianUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"I\n" +
+	"\x15LinkGuardianUserReply\x120\n" +
+	"\bguardian\x18\x01 \x01(\v2\x14.student.v1.GuardianR\bguardian\"\x17\n" +
+	"\x15ListMyChildrenRequest\"G\n" +
+	"\x13ListMyChildrenReply\x120\n" +
+	"\bstudents\x18\x01 \x03(\v2\x14.student.v1.StudentsR\bstudents2\xf0\x16\n" +
+	"\aStudent\x12h\n" +
+	"\vHealthCheck\x12\x1e.student.v1.HealthCheckRequest\x1a\x1c.student.v1.HealthCheckReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/students/health\x12b\n" +
+	"\n" +
+	"GetStudent\x12\x1d.student.v1.GetStudentRequest\x1a\x1b.student.v1.GetStudentReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/student/{id}\x12i\n" +
+	"\rCreateStudent\x12 .student.v1.CreateStudentRequest\x1a\x1e.student.v1.CreateStudentReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/student\x12\x85\x01\n" +
+	"\rUpdateStudent\x12 .student.v1.UpdateStudentRequest\x1a\x1e.student.v1.UpdateStudentReply\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x15:\x01*2\x10/v1/student/{id}\x1a\x10/v1/student/{id}\x12k\n" +
+	"\rDeleteStudent\x12 .student.v1.DeleteStudentRequest\x1a\x1e.student.v1.DeleteStudentReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/student/{id}\x12d\n" +
+	"\fListStudents\x12\x1f.student.v1.ListStudentsRequest\x1a\x1d.student.v1.ListStudentsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/students\x12q\n" +
+	"\x0eSearchStudents\x12!.student.v1.SearchStudentsRequest\x1a\x1f.student.v1.SearchStudentsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/students/search\x12t\n" +
+	"\x0eImportStudents\x12!.student.v1.ImportStudentsRequest\x1a\x1f.student.v1.ImportStudentsReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/students/import\x12N\n" +
+	"\x0eExportStudents\x12!.student.v1.ExportStudentsRequest\x1a\x17.student.v1.ExportChunk0\x01\x12\x85\x01\n" +
+	"\x13ListDeletedStudents\x12&.student.v1.ListDeletedStudentsRequest\x1a$.student.v1.ListDeletedStudentsReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/recycle-bin/students\x12\x86\x01\n" +
+	"\x0eRestoreStudent\x12!.student.v1.RestoreStudentRequest\x1a\x1f.student.v1.RestoreStudentReply\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/recycle-bin/students/{id}/restore\x12u\n" +
+	"\fPurgeStudent\x12\x1f.student.v1.PurgeStudentRequest\x1a\x1d.student.v1.PurgeStudentReply\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/recycle-bin/students/{id}\x12b\n" +
+	"\vRecordGrade\x12\x1e.student.v1.RecordGradeRequest\x1a\x1c.student.v1.RecordGradeReply\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/grades\x12d\n" +
+	"\vDeleteGrade\x12\x1e.student.v1.DeleteGradeRequest\x1a\x1c.student.v1.DeleteGradeReply\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/grades/{id}\x12\\\n" +
+	"\n" +
+	"ListGrades\x12\x1d.student.v1.ListGradesRequest\x1a\x1b.student.v1.ListGradesReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/grades\x12v\n" +
+	"\rGetTranscript\x12 .student.v1.GetTranscriptRequest\x1a\x1e.student.v1.GetTranscriptReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/student/{id}/transcript\x12\x87\x01\n" +
+	"\x13ChangeStudentStatus\x12&.student.v1.ChangeStudentStatusRequest\x1a$.student.v1.ChangeStudentStatusReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/student/{id}/status\x12\x9b\x01\n" +
+	"\x18ListStudentStatusChanges\x12+.student.v1.ListStudentStatusChangesRequest\x1a).student.v1.ListStudentStatusChangesReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/student/{id}/status-changes\x12\x8a\x01\n" +
+	"\x14ListStudentGuardians\x12'.student.v1.ListStudentGuardiansRequest\x1a%.student.v1.ListStudentGuardiansReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/student/{id}/guardians\x12\x87\x01\n" +
+	"\x12AddStudentGuardian\x12%.student.v1.AddStudentGuardianRequest\x1a#.student.v1.AddStudentGuardianReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/student/{id}/guardians\x12\x9e\x01\n" +
+	"\x15UpdateStudentGuardian\x12(.student.v1.UpdateStudentGuardianRequest\x1a&.student.v1.UpdateStudentGuardianReply\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/v1/student/{id}/guardians/{guardian_id}\x12\x9b\x01\n" +
+	"\x15RemoveStudentGuardian\x12(.student.v1.RemoveStudentGuardianRequest\x1a&.student.v1.RemoveStudentGuardianReply\"0\x82\xd3\xe4\x93\x02**(/v1/student/{id}/guardians/{guardian_id}\x12~\n" +
+	"\x10LinkGuardianUser\x12#.student.v1.LinkGuardianUserRequest\x1a!.student.v1.LinkGuardianUserReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/guardians/{id}/user\x12r\n" +
+	"\x0eListMyChildren\x12!.student.v1.ListMyChildrenRequest\x1a\x1f.student.v1.ListMyChildrenReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/account/childrenB\x1bZ\x19student/api/student/v1;v1b\x06proto3"
 
 var (
 	file_student_v1_student_proto_rawDescOnce sync.Once
@@ -863,41 +3742,141 @@ func file_student_v1_student_proto_rawDescGZIP() []byte {
 	return file_student_v1_student_proto_rawDescData
 }
 
-var file_student_v1_student_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_student_v1_student_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_student_v1_student_proto_goTypes = []any{
-	(*HealthCheckRequest)(nil),   // 0: student.v1.HealthCheckRequest
-	(*HealthCheckReply)(nil),     // 1: student.v1.HealthCheckReply
-	(*GetStudentRequest)(nil),    // 2: student.v1.GetStudentRequest
-	(*GetStudentReply)(nil),      // 3: student.v1.GetStudentReply
-	(*CreateStudentRequest)(nil), // 4: student.v1.CreateStudentRequest
-	(*CreateStudentReply)(nil),   // 5: student.v1.CreateStudentReply
-	(*UpdateStudentRequest)(nil), // 6: student.v1.UpdateStudentRequest
-	(*UpdateStudentReply)(nil),   // 7: student.v1.UpdateStudentReply
-	(*DeleteStudentRequest)(nil), // 8: student.v1.DeleteStudentRequest
-	(*DeleteStudentReply)(nil),   // 9: student.v1.DeleteStudentReply
-	(*Students)(nil),             // 10: student.v1.Students
-	(*ListStudentsRequest)(nil),  // 11: student.v1.ListStudentsRequest
-	(*ListStudentsReply)(nil),    // 12: student.v1.ListStudentsReply
+	(*HealthCheckRequest)(nil),              // 0: student.v1.HealthCheckRequest
+	(*HealthCheckReply)(nil),                // 1: student.v1.HealthCheckReply
+	(*GetStudentRequest)(nil),               // 2: student.v1.GetStudentRequest
+	(*GetStudentReply)(nil),                 // 3: student.v1.GetStudentReply
+	(*CreateStudentRequest)(nil),            // 4: student.v1.CreateStudentRequest
+	(*CreateStudentReply)(nil),              // 5: student.v1.CreateStudentReply
+	(*UpdateStudentRequest)(nil),            // 6: student.v1.UpdateStudentRequest
+	(*UpdateStudentReply)(nil),              // 7: student.v1.UpdateStudentReply
+	(*DeleteStudentRequest)(nil),            // 8: student.v1.DeleteStudentRequest
+	(*DeleteStudentReply)(nil),              // 9: student.v1.DeleteStudentReply
+	(*Students)(nil),                        // 10: student.v1.Students
+	(*ListStudentsRequest)(nil),             // 11: student.v1.ListStudentsRequest
+	(*ListStudentsReply)(nil),               // 12: student.v1.ListStudentsReply
+	(*ImportStudentsRequest)(nil),           // 13: student.v1.ImportStudentsRequest
+	(*StudentImportError)(nil),              // 14: student.v1.StudentImportError
+	(*ImportStudentsReply)(nil),             // 15: student.v1.ImportStudentsReply
+	(*SearchStudentsRequest)(nil),           // 16: student.v1.SearchStudentsRequest
+	(*SearchHighlight)(nil),                 // 17: student.v1.SearchHighlight
+	(*StudentSearchHit)(nil),                // 18: student.v1.StudentSearchHit
+	(*SearchStudentsReply)(nil),             // 19: student.v1.SearchStudentsReply
+	(*ListDeletedStudentsRequest)(nil),      // 20: student.v1.ListDeletedStudentsRequest
+	(*ListDeletedStudentsReply)(nil),        // 21: student.v1.ListDeletedStudentsReply
+	(*RestoreStudentRequest)(nil),           // 22: student.v1.RestoreStudentRequest
+	(*RestoreStudentReply)(nil),             // 23: student.v1.RestoreStudentReply
+	(*PurgeStudentRequest)(nil),             // 24: student.v1.PurgeStudentRequest
+	(*PurgeStudentReply)(nil),               // 25: student.v1.PurgeStudentReply
+	(*ExportStudentsRequest)(nil),           // 26: student.v1.ExportStudentsRequest
+	(*ExportChunk)(nil),                     // 27: student.v1.ExportChunk
+	(*Grade)(nil),                           // 28: student.v1.Grade
+	(*RecordGradeRequest)(nil),              // 29: student.v1.RecordGradeRequest
+	(*RecordGradeReply)(nil),                // 30: student.v1.RecordGradeReply
+	(*DeleteGradeRequest)(nil),              // 31: student.v1.DeleteGradeRequest
+	(*DeleteGradeReply)(nil),                // 32: student.v1.DeleteGradeReply
+	(*ListGradesRequest)(nil),               // 33: student.v1.ListGradesRequest
+	(*ListGradesReply)(nil),                 // 34: student.v1.ListGradesReply
+	(*GetTranscriptRequest)(nil),            // 35: student.v1.GetTranscriptRequest
+	(*TermTranscript)(nil),                  // 36: student.v1.TermTranscript
+	(*GetTranscriptReply)(nil),              // 37: student.v1.GetTranscriptReply
+	(*StudentStatusChange)(nil),             // 38: student.v1.StudentStatusChange
+	(*ChangeStudentStatusRequest)(nil),      // 39: student.v1.ChangeStudentStatusRequest
+	(*ChangeStudentStatusReply)(nil),        // 40: student.v1.ChangeStudentStatusReply
+	(*ListStudentStatusChangesRequest)(nil), // 41: student.v1.ListStudentStatusChangesRequest
+	(*ListStudentStatusChangesReply)(nil),   // 42: student.v1.ListStudentStatusChangesReply
+	(*Guardian)(nil),                        // 43: student.v1.Guardian
+	(*StudentGuardian)(nil),                 // 44: student.v1.StudentGuardian
+	(*ListStudentGuardiansRequest)(nil),     // 45: student.v1.ListStudentGuardiansRequest
+	(*ListStudentGuardiansReply)(nil),       // 46: student.v1.ListStudentGuardiansReply
+	(*AddStudentGuardianRequest)(nil),       // 47: student.v1.AddStudentGuardianRequest
+	(*AddStudentGuardianReply)(nil),         // 48: student.v1.AddStudentGuardianReply
+	(*UpdateStudentGuardianRequest)(nil),    // 49: student.v1.UpdateStudentGuardianRequest
+	(*UpdateStudentGuardianReply)(nil),      // 50: student.v1.UpdateStudentGuardianReply
+	(*RemoveStudentGuardianRequest)(nil),    // 51: student.v1.RemoveStudentGuardianRequest
+	(*RemoveStudentGuardianReply)(nil),      // 52: student.v1.RemoveStudentGuardianReply
+	(*LinkGuardianUserRequest)(nil),         // 53: student.v1.LinkGuardianUserRequest
+	(*LinkGuardianUserReply)(nil),           // 54: student.v1.LinkGuardianUserReply
+	(*ListMyChildrenRequest)(nil),           // 55: student.v1.ListMyChildrenRequest
+	(*ListMyChildrenReply)(nil),             // 56: student.v1.ListMyChildrenReply
+	(*fieldmaskpb.FieldMask)(nil),           // 57: google.protobuf.FieldMask
 }
 var file_student_v1_student_proto_depIdxs = []int32{
-	10, // 0: student.v1.ListStudentsReply.data:type_name -> student.v1.Students
-	0,  // 1: student.v1.Student.HealthCheck:input_type -> student.v1.HealthCheckRequest
-	2,  // 2: student.v1.Student.GetStudent:input_type -> student.v1.GetStudentRequest
-	4,  // 3: student.v1.Student.CreateStudent:input_type -> student.v1.CreateStudentRequest
-	6,  // 4: student.v1.Student.UpdateStudent:input_type -> student.v1.UpdateStudentRequest
-	8,  // 5: student.v1.Student.DeleteStudent:input_type -> student.v1.DeleteStudentRequest
-	11, // 6: student.v1.Student.ListStudents:input_type -> student.v1.ListStudentsRequest
-	1,  // 7: student.v1.Student.HealthCheck:output_type -> student.v1.HealthCheckReply
-	3,  // 8: student.v1.Student.GetStudent:output_type -> student.v1.GetStudentReply
-	5,  // 9: student.v1.Student.CreateStudent:output_type -> student.v1.CreateStudentReply
-	7,  // 10: student.v1.Student.UpdateStudent:output_type -> student.v1.UpdateStudentReply
-	9,  // 11: student.v1.Student.DeleteStudent:output_type -> student.v1.DeleteStudentReply
-	12, // 12: student.v1.Student.ListStudents:output_type -> student.v1.ListStudentsReply
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	57, // 0: student.v1.UpdateStudentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 1: student.v1.ListStudentsReply.data:type_name -> student.v1.Students
+	14, // 2: student.v1.ImportStudentsReply.errors:type_name -> student.v1.StudentImportError
+	10, // 3: student.v1.StudentSearchHit.student:type_name -> student.v1.Students
+	17, // 4: student.v1.StudentSearchHit.highlights:type_name -> student.v1.SearchHighlight
+	18, // 5: student.v1.SearchStudentsReply.hits:type_name -> student.v1.StudentSearchHit
+	10, // 6: student.v1.ListDeletedStudentsReply.data:type_name -> student.v1.Students
+	10, // 7: student.v1.RestoreStudentReply.student:type_name -> student.v1.Students
+	28, // 8: student.v1.RecordGradeReply.grade:type_name -> student.v1.Grade
+	28, // 9: student.v1.ListGradesReply.grades:type_name -> student.v1.Grade
+	28, // 10: student.v1.TermTranscript.grades:type_name -> student.v1.Grade
+	36, // 11: student.v1.GetTranscriptReply.terms:type_name -> student.v1.TermTranscript
+	38, // 12: student.v1.ChangeStudentStatusReply.change:type_name -> student.v1.StudentStatusChange
+	38, // 13: student.v1.ListStudentStatusChangesReply.changes:type_name -> student.v1.StudentStatusChange
+	43, // 14: student.v1.StudentGuardian.guardian:type_name -> student.v1.Guardian
+	44, // 15: student.v1.ListStudentGuardiansReply.guardians:type_name -> student.v1.StudentGuardian
+	44, // 16: student.v1.AddStudentGuardianReply.guardian:type_name -> student.v1.StudentGuardian
+	44, // 17: student.v1.UpdateStudentGuardianReply.guardian:type_name -> student.v1.StudentGuardian
+	43, // 18: student.v1.LinkGuardianUserReply.guardian:type_name -> student.v1.Guardian
+	10, // 19: student.v1.ListMyChildrenReply.students:type_name -> student.v1.Students
+	0,  // 20: student.v1.Student.HealthCheck:input_type -> student.v1.HealthCheckRequest
+	2,  // 21: student.v1.Student.GetStudent:input_type -> student.v1.GetStudentRequest
+	4,  // 22: student.v1.Student.CreateStudent:input_type -> student.v1.CreateStudentRequest
+	6,  // 23: student.v1.Student.UpdateStudent:input_type -> student.v1.UpdateStudentRequest
+	8,  // 24: student.v1.Student.DeleteStudent:input_type -> student.v1.DeleteStudentRequest
+	11, // 25: student.v1.Student.ListStudents:input_type -> student.v1.ListStudentsRequest
+	16, // 26: student.v1.Student.SearchStudents:input_type -> student.v1.SearchStudentsRequest
+	13, // 27: student.v1.Student.ImportStudents:input_type -> student.v1.ImportStudentsRequest
+	26, // 28: student.v1.Student.ExportStudents:input_type -> student.v1.ExportStudentsRequest
+	20, // 29: student.v1.Student.ListDeletedStudents:input_type -> student.v1.ListDeletedStudentsRequest
+	22, // 30: student.v1.Student.RestoreStudent:input_type -> student.v1.RestoreStudentRequest
+	24, // 31: student.v1.Student.PurgeStudent:input_type -> student.v1.PurgeStudentRequest
+	29, // 32: student.v1.Student.RecordGrade:input_type -> student.v1.RecordGradeRequest
+	31, // 33: student.v1.Student.DeleteGrade:input_type -> student.v1.DeleteGradeRequest
+	33, // 34: student.v1.Student.ListGrades:input_type -> student.v1.ListGradesRequest
+	35, // 35: student.v1.Student.GetTranscript:input_type -> student.v1.GetTranscriptRequest
+	39, // 36: student.v1.Student.ChangeStudentStatus:input_type -> student.v1.ChangeStudentStatusRequest
+	41, // 37: student.v1.Student.ListStudentStatusChanges:input_type -> student.v1.ListStudentStatusChangesRequest
+	45, // 38: student.v1.Student.ListStudentGuardians:input_type -> student.v1.ListStudentGuardiansRequest
+	47, // 39: student.v1.Student.AddStudentGuardian:input_type -> student.v1.AddStudentGuardianRequest
+	49, // 40: student.v1.Student.UpdateStudentGuardian:input_type -> student.v1.UpdateStudentGuardianRequest
+	51, // 41: student.v1.Student.RemoveStudentGuardian:input_type -> student.v1.RemoveStudentGuardianRequest
+	53, // 42: student.v1.Student.LinkGuardianUser:input_type -> student.v1.LinkGuardianUserRequest
+	55, // 43: student.v1.Student.ListMyChildren:input_type -> student.v1.ListMyChildrenRequest
+	1,  // 44: student.v1.Student.HealthCheck:output_type -> student.v1.HealthCheckReply
+	3,  // 45: student.v1.Student.GetStudent:output_type -> student.v1.GetStudentReply
+	5,  // 46: student.v1.Student.CreateStudent:output_type -> student.v1.CreateStudentReply
+	7,  // 47: student.v1.Student.UpdateStudent:output_type -> student.v1.UpdateStudentReply
+	9,  // 48: student.v1.Student.DeleteStudent:output_type -> student.v1.DeleteStudentReply
+	12, // 49: student.v1.Student.ListStudents:output_type -> student.v1.ListStudentsReply
+	19, // 50: student.v1.Student.SearchStudents:output_type -> student.v1.SearchStudentsReply
+	15, // 51: student.v1.Student.ImportStudents:output_type -> student.v1.ImportStudentsReply
+	27, // 52: student.v1.Student.ExportStudents:output_type -> student.v1.ExportChunk
+	21, // 53: student.v1.Student.ListDeletedStudents:output_type -> student.v1.ListDeletedStudentsReply
+	23, // 54: student.v1.Student.RestoreStudent:output_type -> student.v1.RestoreStudentReply
+	25, // 55: student.v1.Student.PurgeStudent:output_type -> student.v1.PurgeStudentReply
+	30, // 56: student.v1.Student.RecordGrade:output_type -> student.v1.RecordGradeReply
+	32, // 57: student.v1.Student.DeleteGrade:output_type -> student.v1.DeleteGradeReply
+	34, // 58: student.v1.Student.ListGrades:output_type -> student.v1.ListGradesReply
+	37, // 59: student.v1.Student.GetTranscript:output_type -> student.v1.GetTranscriptReply
+	40, // 60: student.v1.Student.ChangeStudentStatus:output_type -> student.v1.ChangeStudentStatusReply
+	42, // 61: student.v1.Student.ListStudentStatusChanges:output_type -> student.v1.ListStudentStatusChangesReply
+	46, // 62: student.v1.Student.ListStudentGuardians:output_type -> student.v1.ListStudentGuardiansReply
+	48, // 63: student.v1.Student.AddStudentGuardian:output_type -> student.v1.AddStudentGuardianReply
+	50, // 64: student.v1.Student.UpdateStudentGuardian:output_type -> student.v1.UpdateStudentGuardianReply
+	52, // 65: student.v1.Student.RemoveStudentGuardian:output_type -> student.v1.RemoveStudentGuardianReply
+	54, // 66: student.v1.Student.LinkGuardianUser:output_type -> student.v1.LinkGuardianUserReply
+	56, // 67: student.v1.Student.ListMyChildren:output_type -> student.v1.ListMyChildrenReply
+	44, // [44:68] is the sub-list for method output_type
+	20, // [20:44] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_student_v1_student_proto_init() }
@@ -911,7 +3890,7 @@ func file_student_v1_student_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_student_v1_student_proto_rawDesc), len(file_student_v1_student_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Student_HealthCheck_FullMethodName   = "/student.v1.Student/HealthCheck"
-	Student_GetStudent_FullMethodName    = "/student.v1.Student/GetStudent"
-	Student_CreateStudent_FullMethodName = "/student.v1.Student/CreateStudent"
-	Student_UpdateStudent_FullMethodName = "/student.v1.Student/UpdateStudent"
-	Student_DeleteStudent_FullMethodName = "/student.v1.Student/DeleteStudent"
-	Student_ListStudents_FullMethodName  = "/student.v1.Student/ListStudents"
+	Student_HealthCheck_FullMethodName              = "/student.v1.Student/HealthCheck"
+	Student_GetStudent_FullMethodName               = "/student.v1.Student/GetStudent"
+	Student_CreateStudent_FullMethodName            = "/student.v1.Student/CreateStudent"
+	Student_UpdateStudent_FullMethodName            = "/student.v1.Student/UpdateStudent"
+	Student_DeleteStudent_FullMethodName            = "/student.v1.Student/DeleteStudent"
+	Student_ListStudents_FullMethodName             = "/student.v1.Student/ListStudents"
+	Student_SearchStudents_FullMethodName           = "/student.v1.Student/SearchStudents"
+	Student_ImportStudents_FullMethodName           = "/student.v1.Student/ImportStudents"
+	Student_ExportStudents_FullMethodName           = "/student.v1.Student/ExportStudents"
+	Student_ListDeletedStudents_FullMethodName      = "/student.v1.Student/ListDeletedStudents"
+	Student_RestoreStudent_FullMethodName           = "/student.v1.Student/RestoreStudent"
+	Student_PurgeStudent_FullMethodName             = "/student.v1.Student/PurgeStudent"
+	Student_RecordGrade_FullMethodName              = "/student.v1.Student/RecordGrade"
+	Student_DeleteGrade_FullMethodName              = "/student.v1.Student/DeleteGrade"
+	Student_ListGrades_FullMethodName               = "/student.v1.Student/ListGrades"
+	Student_GetTranscript_FullMethodName            = "/student.v1.Student/GetTranscript"
+	Student_ChangeStudentStatus_FullMethodName      = "/student.v1.Student/ChangeStudentStatus"
+	Student_ListStudentStatusChanges_FullMethodName = "/student.v1.Student/ListStudentStatusChanges"
+	Student_ListStudentGuardians_FullMethodName     = "/student.v1.Student/ListStudentGuardians"
+	Student_AddStudentGuardian_FullMethodName       = "/student.v1.Student/AddStudentGuardian"
+	Student_UpdateStudentGuardian_FullMethodName    = "/student.v1.Student/UpdateStudentGuardian"
+	Student_RemoveStudentGuardian_FullMethodName    = "/student.v1.Student/RemoveStudentGuardian"
+	Student_LinkGuardianUser_FullMethodName         = "/student.v1.Student/LinkGuardianUser"
+	Student_ListMyChildren_FullMethodName           = "/student.v1.Student/ListMyChildren"
 )
 
 // StudentClient is the client API for Student service.
@@ -41,6 +59,40 @@ type StudentClient interface {
 	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*UpdateStudentReply, error)
 	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*DeleteStudentReply, error)
 	ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsReply, error)
+	// 按姓名和备注全文搜索学生，结果按相关度排序，返回带 <mark> 标记的高亮片段
+	SearchStudents(ctx context.Context, in *SearchStudentsRequest, opts ...grpc.CallOption) (*SearchStudentsReply, error)
+	// 批量导入学生，支持 CSV 和 XLSX，第一行为表头；dry_run 时只校验并返回每行的错误
+	// 也可以通过 multipart 上传：POST /v1/students/import/upload，文件字段为 file
+	ImportStudents(ctx context.Context, in *ImportStudentsRequest, opts ...grpc.CallOption) (*ImportStudentsReply, error)
+	// 导出学生，按 format 返回 CSV、JSON Lines 或 XLSX 文件的分块，name 过滤与 ListStudents 相同
+	// HTTP 下载地址为 GET /v1/students/export
+	ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// 回收站中已删除的学生，按删除时间倒序
+	ListDeletedStudents(ctx context.Context, in *ListDeletedStudentsRequest, opts ...grpc.CallOption) (*ListDeletedStudentsReply, error)
+	// 恢复已删除的学生
+	RestoreStudent(ctx context.Context, in *RestoreStudentRequest, opts ...grpc.CallOption) (*RestoreStudentReply, error)
+	// 彻底删除回收站中的学生，连同选课、成绩、考勤、监护关系和学籍变动记录，不可恢复
+	PurgeStudent(ctx context.Context, in *PurgeStudentRequest, opts ...grpc.CallOption) (*PurgeStudentReply, error)
+	// 录入成绩，同一学生、课程和学期的成绩已存在时覆盖
+	RecordGrade(ctx context.Context, in *RecordGradeRequest, opts ...grpc.CallOption) (*RecordGradeReply, error)
+	DeleteGrade(ctx context.Context, in *DeleteGradeRequest, opts ...grpc.CallOption) (*DeleteGradeReply, error)
+	ListGrades(ctx context.Context, in *ListGradesRequest, opts ...grpc.CallOption) (*ListGradesReply, error)
+	// 成绩单，按学期汇总学分和绩点
+	GetTranscript(ctx context.Context, in *GetTranscriptRequest, opts ...grpc.CallOption) (*GetTranscriptReply, error)
+	// 变更学籍状态，必须填写原因，只允许状态机中定义的变动
+	ChangeStudentStatus(ctx context.Context, in *ChangeStudentStatusRequest, opts ...grpc.CallOption) (*ChangeStudentStatusReply, error)
+	ListStudentStatusChanges(ctx context.Context, in *ListStudentStatusChangesRequest, opts ...grpc.CallOption) (*ListStudentStatusChangesReply, error)
+	// 学生的监护人和紧急联系人，主要联系人排在最前
+	ListStudentGuardians(ctx context.Context, in *ListStudentGuardiansRequest, opts ...grpc.CallOption) (*ListStudentGuardiansReply, error)
+	// 添加监护人，guardian_id 不为 0 时关联已有监护人，否则新建监护人
+	AddStudentGuardian(ctx context.Context, in *AddStudentGuardianRequest, opts ...grpc.CallOption) (*AddStudentGuardianReply, error)
+	UpdateStudentGuardian(ctx context.Context, in *UpdateStudentGuardianRequest, opts ...grpc.CallOption) (*UpdateStudentGuardianReply, error)
+	// 移除监护人，监护人不再关联任何学生时一并删除
+	RemoveStudentGuardian(ctx context.Context, in *RemoveStudentGuardianRequest, opts ...grpc.CallOption) (*RemoveStudentGuardianReply, error)
+	// 关联监护人的登录账号，关联后该账号可以查看监护人的学生，user_id 为 0 时取消关联
+	LinkGuardianUser(ctx context.Context, in *LinkGuardianUserRequest, opts ...grpc.CallOption) (*LinkGuardianUserReply, error)
+	// 当前登录账号作为监护人关联的学生
+	ListMyChildren(ctx context.Context, in *ListMyChildrenRequest, opts ...grpc.CallOption) (*ListMyChildrenReply, error)
 }
 
 type studentClient struct {
@@ -111,6 +163,195 @@ func (c *studentClient) ListStudents(ctx context.Context, in *ListStudentsReques
 	return out, nil
 }
 
+func (c *studentClient) SearchStudents(ctx context.Context, in *SearchStudentsRequest, opts ...grpc.CallOption) (*SearchStudentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStudentsReply)
+	err := c.cc.Invoke(ctx, Student_SearchStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ImportStudents(ctx context.Context, in *ImportStudentsRequest, opts ...grpc.CallOption) (*ImportStudentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportStudentsReply)
+	err := c.cc.Invoke(ctx, Student_ImportStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Student_ServiceDesc.Streams[0], Student_ExportStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportStudentsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Student_ExportStudentsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *studentClient) ListDeletedStudents(ctx context.Context, in *ListDeletedStudentsRequest, opts ...grpc.CallOption) (*ListDeletedStudentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedStudentsReply)
+	err := c.cc.Invoke(ctx, Student_ListDeletedStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) RestoreStudent(ctx context.Context, in *RestoreStudentRequest, opts ...grpc.CallOption) (*RestoreStudentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreStudentReply)
+	err := c.cc.Invoke(ctx, Student_RestoreStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) PurgeStudent(ctx context.Context, in *PurgeStudentRequest, opts ...grpc.CallOption) (*PurgeStudentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeStudentReply)
+	err := c.cc.Invoke(ctx, Student_PurgeStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) RecordGrade(ctx context.Context, in *RecordGradeRequest, opts ...grpc.CallOption) (*RecordGradeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordGradeReply)
+	err := c.cc.Invoke(ctx, Student_RecordGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) DeleteGrade(ctx context.Context, in *DeleteGradeRequest, opts ...grpc.CallOption) (*DeleteGradeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGradeReply)
+	err := c.cc.Invoke(ctx, Student_DeleteGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ListGrades(ctx context.Context, in *ListGradesRequest, opts ...grpc.CallOption) (*ListGradesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGradesReply)
+	err := c.cc.Invoke(ctx, Student_ListGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) GetTranscript(ctx context.Context, in *GetTranscriptRequest, opts ...grpc.CallOption) (*GetTranscriptReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTranscriptReply)
+	err := c.cc.Invoke(ctx, Student_GetTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ChangeStudentStatus(ctx context.Context, in *ChangeStudentStatusRequest, opts ...grpc.CallOption) (*ChangeStudentStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeStudentStatusReply)
+	err := c.cc.Invoke(ctx, Student_ChangeStudentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ListStudentStatusChanges(ctx context.Context, in *ListStudentStatusChangesRequest, opts ...grpc.CallOption) (*ListStudentStatusChangesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentStatusChangesReply)
+	err := c.cc.Invoke(ctx, Student_ListStudentStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ListStudentGuardians(ctx context.Context, in *ListStudentGuardiansRequest, opts ...grpc.CallOption) (*ListStudentGuardiansReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentGuardiansReply)
+	err := c.cc.Invoke(ctx, Student_ListStudentGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) AddStudentGuardian(ctx context.Context, in *AddStudentGuardianRequest, opts ...grpc.CallOption) (*AddStudentGuardianReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddStudentGuardianReply)
+	err := c.cc.Invoke(ctx, Student_AddStudentGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) UpdateStudentGuardian(ctx context.Context, in *UpdateStudentGuardianRequest, opts ...grpc.CallOption) (*UpdateStudentGuardianReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStudentGuardianReply)
+	err := c.cc.Invoke(ctx, Student_UpdateStudentGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) RemoveStudentGuardian(ctx context.Context, in *RemoveStudentGuardianRequest, opts ...grpc.CallOption) (*RemoveStudentGuardianReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStudentGuardianReply)
+	err := c.cc.Invoke(ctx, Student_RemoveStudentGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) LinkGuardianUser(ctx context.Context, in *LinkGuardianUserRequest, opts ...grpc.CallOption) (*LinkGuardianUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkGuardianUserReply)
+	err := c.cc.Invoke(ctx, Student_LinkGuardianUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentClient) ListMyChildren(ctx context.Context, in *ListMyChildrenRequest, opts ...grpc.CallOption) (*ListMyChildrenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyChildrenReply)
+	err := c.cc.Invoke(ctx, Student_ListMyChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentServer is the server API for Student service.
 // All implementations must embed UnimplementedStudentServer
 // for forward compatibility.
@@ -125,6 +366,40 @@ type StudentServer interface {
 	UpdateStudent(context.Context, *UpdateStudentRequest) (*UpdateStudentReply, error)
 	DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentReply, error)
 	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsReply, error)
+	// 按姓名和备注全文搜索学生，结果按相关度排序，返回带 <mark> 标记的高亮片段
+	SearchStudents(context.Context, *SearchStudentsRequest) (*SearchStudentsReply, error)
+	// 批量导入学生，支持 CSV 和 XLSX，第一行为表头；dry_run 时只校验并返回每行的错误
+	// 也可以通过 multipart 上传：POST /v1/students/import/upload，文件字段为 file
+	ImportStudents(context.Context, *ImportStudentsRequest) (*ImportStudentsReply, error)
+	// 导出学生，按 format 返回 CSV、JSON Lines 或 XLSX 文件的分块，name 过滤与 ListStudents 相同
+	// HTTP 下载地址为 GET /v1/students/export
+	ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// 回收站中已删除的学生，按删除时间倒序
+	ListDeletedStudents(context.Context, *ListDeletedStudentsRequest) (*ListDeletedStudentsReply, error)
+	// 恢复已删除的学生
+	RestoreStudent(context.Context, *RestoreStudentRequest) (*RestoreStudentReply, error)
+	// 彻底删除回收站中的学生，连同选课、成绩、考勤、监护关系和学籍变动记录，不可恢复
+	PurgeStudent(context.Context, *PurgeStudentRequest) (*PurgeStudentReply, error)
+	// 录入成绩，同一学生、课程和学期的成绩已存在时覆盖
+	RecordGrade(context.Context, *RecordGradeRequest) (*RecordGradeReply, error)
+	DeleteGrade(context.Context, *DeleteGradeRequest) (*DeleteGradeReply, error)
+	ListGrades(context.Context, *ListGradesRequest) (*ListGradesReply, error)
+	// 成绩单，按学期汇总学分和绩点
+	GetTranscript(context.Context, *GetTranscriptRequest) (*GetTranscriptReply, error)
+	// 变更学籍状态，必须填写原因，只允许状态机中定义的变动
+	ChangeStudentStatus(context.Context, *ChangeStudentStatusRequest) (*ChangeStudentStatusReply, error)
+	ListStudentStatusChanges(context.Context, *ListStudentStatusChangesRequest) (*ListStudentStatusChangesReply, error)
+	// 学生的监护人和紧急联系人，主要联系人排在最前
+	ListStudentGuardians(context.Context, *ListStudentGuardiansRequest) (*ListStudentGuardiansReply, error)
+	// 添加监护人，guardian_id 不为 0 时关联已有监护人，否则新建监护人
+	AddStudentGuardian(context.Context, *AddStudentGuardianRequest) (*AddStudentGuardianReply, error)
+	UpdateStudentGuardian(context.Context, *UpdateStudentGuardianRequest) (*UpdateStudentGuardianReply, error)
+	// 移除监护人，监护人不再关联任何学生时一并删除
+	RemoveStudentGuardian(context.Context, *RemoveStudentGuardianRequest) (*RemoveStudentGuardianReply, error)
+	// 关联监护人的登录账号，关联后该账号可以查看监护人的学生，user_id 为 0 时取消关联
+	LinkGuardianUser(context.Context, *LinkGuardianUserRequest) (*LinkGuardianUserReply, error)
+	// 当前登录账号作为监护人关联的学生
+	ListMyChildren(context.Context, *ListMyChildrenRequest) (*ListMyChildrenReply, error)
 	mustEmbedUnimplementedStudentServer()
 }
 
@@ -153,6 +428,60 @@ func (UnimplementedStudentServer) DeleteStudent(context.Context, *DeleteStudentR
 func (UnimplementedStudentServer) ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
 }
+func (UnimplementedStudentServer) SearchStudents(context.Context, *SearchStudentsRequest) (*SearchStudentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStudents not implemented")
+}
+func (UnimplementedStudentServer) ImportStudents(context.Context, *ImportStudentsRequest) (*ImportStudentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStudents not implemented")
+}
+func (UnimplementedStudentServer) ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStudents not implemented")
+}
+func (UnimplementedStudentServer) ListDeletedStudents(context.Context, *ListDeletedStudentsRequest) (*ListDeletedStudentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedStudents not implemented")
+}
+func (UnimplementedStudentServer) RestoreStudent(context.Context, *RestoreStudentRequest) (*RestoreStudentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStudent not implemented")
+}
+func (UnimplementedStudentServer) PurgeStudent(context.Context, *PurgeStudentRequest) (*PurgeStudentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeStudent not implemented")
+}
+func (UnimplementedStudentServer) RecordGrade(context.Context, *RecordGradeRequest) (*RecordGradeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordGrade not implemented")
+}
+func (UnimplementedStudentServer) DeleteGrade(context.Context, *DeleteGradeRequest) (*DeleteGradeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGrade not implemented")
+}
+func (UnimplementedStudentServer) ListGrades(context.Context, *ListGradesRequest) (*ListGradesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrades not implemented")
+}
+func (UnimplementedStudentServer) GetTranscript(context.Context, *GetTranscriptRequest) (*GetTranscriptReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTranscript not implemented")
+}
+func (UnimplementedStudentServer) ChangeStudentStatus(context.Context, *ChangeStudentStatusRequest) (*ChangeStudentStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStudentStatus not implemented")
+}
+func (UnimplementedStudentServer) ListStudentStatusChanges(context.Context, *ListStudentStatusChangesRequest) (*ListStudentStatusChangesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentStatusChanges not implemented")
+}
+func (UnimplementedStudentServer) ListStudentGuardians(context.Context, *ListStudentGuardiansRequest) (*ListStudentGuardiansReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentGuardians not implemented")
+}
+func (UnimplementedStudentServer) AddStudentGuardian(context.Context, *AddStudentGuardianRequest) (*AddStudentGuardianReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStudentGuardian not implemented")
+}
+func (UnimplementedStudentServer) UpdateStudentGuardian(context.Context, *UpdateStudentGuardianRequest) (*UpdateStudentGuardianReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStudentGuardian not implemented")
+}
+func (UnimplementedStudentServer) RemoveStudentGuardian(context.Context, *RemoveStudentGuardianRequest) (*RemoveStudentGuardianReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudentGuardian not implemented")
+}
+func (UnimplementedStudentServer) LinkGuardianUser(context.Context, *LinkGuardianUserRequest) (*LinkGuardianUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkGuardianUser not implemented")
+}
+func (UnimplementedStudentServer) ListMyChildren(context.Context, *ListMyChildrenRequest) (*ListMyChildrenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyChildren not implemented")
+}
 func (UnimplementedStudentServer) mustEmbedUnimplementedStudentServer() {}
 func (UnimplementedStudentServer) testEmbeddedByValue()                 {}
 
@@ -282,6 +611,323 @@ func _Student_ListStudents_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Student_SearchStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).SearchStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_SearchStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).SearchStudents(ctx, req.(*SearchStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ImportStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ImportStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ImportStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ImportStudents(ctx, req.(*ImportStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ExportStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudentServer).ExportStudents(m, &grpc.GenericServerStream[ExportStudentsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Student_ExportStudentsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _Student_ListDeletedStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ListDeletedStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ListDeletedStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ListDeletedStudents(ctx, req.(*ListDeletedStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_RestoreStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).RestoreStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_RestoreStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).RestoreStudent(ctx, req.(*RestoreStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_PurgeStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).PurgeStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_PurgeStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).PurgeStudent(ctx, req.(*PurgeStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_RecordGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).RecordGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_RecordGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).RecordGrade(ctx, req.(*RecordGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_DeleteGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).DeleteGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_DeleteGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).DeleteGrade(ctx, req.(*DeleteGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ListGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ListGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ListGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ListGrades(ctx, req.(*ListGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_GetTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).GetTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_GetTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).GetTranscript(ctx, req.(*GetTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ChangeStudentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStudentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ChangeStudentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ChangeStudentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ChangeStudentStatus(ctx, req.(*ChangeStudentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ListStudentStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentStatusChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ListStudentStatusChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ListStudentStatusChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ListStudentStatusChanges(ctx, req.(*ListStudentStatusChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ListStudentGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ListStudentGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ListStudentGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ListStudentGuardians(ctx, req.(*ListStudentGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_AddStudentGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStudentGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).AddStudentGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_AddStudentGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).AddStudentGuardian(ctx, req.(*AddStudentGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_UpdateStudentGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStudentGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).UpdateStudentGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_UpdateStudentGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).UpdateStudentGuardian(ctx, req.(*UpdateStudentGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_RemoveStudentGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStudentGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).RemoveStudentGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_RemoveStudentGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).RemoveStudentGuardian(ctx, req.(*RemoveStudentGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_LinkGuardianUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkGuardianUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).LinkGuardianUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_LinkGuardianUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).LinkGuardianUser(ctx, req.(*LinkGuardianUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Student_ListMyChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServer).ListMyChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Student_ListMyChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServer).ListMyChildren(ctx, req.(*ListMyChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Student_ServiceDesc is the grpc.ServiceDesc for Student service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,7 +959,81 @@ var Student_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStudents",
 			Handler:    _Student_ListStudents_Handler,
 		},
+		{
+			MethodName: "SearchStudents",
+			Handler:    _Student_SearchStudents_Handler,
+		},
+		{
+			MethodName: "ImportStudents",
+			Handler:    _Student_ImportStudents_Handler,
+		},
+		{
+			MethodName: "ListDeletedStudents",
+			Handler:    _Student_ListDeletedStudents_Handler,
+		},
+		{
+			MethodName: "RestoreStudent",
+			Handler:    _Student_RestoreStudent_Handler,
+		},
+		{
+			MethodName: "PurgeStudent",
+			Handler:    _Student_PurgeStudent_Handler,
+		},
+		{
+			MethodName: "RecordGrade",
+			Handler:    _Student_RecordGrade_Handler,
+		},
+		{
+			MethodName: "DeleteGrade",
+			Handler:    _Student_DeleteGrade_Handler,
+		},
+		{
+			MethodName: "ListGrades",
+			Handler:    _Student_ListGrades_Handler,
+		},
+		{
+			MethodName: "GetTranscript",
+			Handler:    _Student_GetTranscript_Handler,
+		},
+		{
+			MethodName: "ChangeStudentStatus",
+			Handler:    _Student_ChangeStudentStatus_Handler,
+		},
+		{
+			MethodName: "ListStudentStatusChanges",
+			Handler:    _Student_ListStudentStatusChanges_Handler,
+		},
+		{
+			MethodName: "ListStudentGuardians",
+			Handler:    _Student_ListStudentGuardians_Handler,
+		},
+		{
+			MethodName: "AddStudentGuardian",
+			Handler:    _Student_AddStudentGuardian_Handler,
+		},
+		{
+			MethodName: "UpdateStudentGuardian",
+			Handler:    _Student_UpdateStudentGuardian_Handler,
+		},
+		{
+			MethodName: "RemoveStudentGuardian",
+			Handler:    _Student_RemoveStudentGuardian_Handler,
+		},
+		{
+			MethodName: "LinkGuardianUser",
+			Handler:    _Student_LinkGuardianUser_Handler,
+		},
+		{
+			MethodName: "ListMyChildren",
+			Handler:    _Student_ListMyChildren_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStudents",
+			Handler:       _Student_ExportStudents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "student/v1/student.proto",
 }
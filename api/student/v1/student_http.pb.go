@@ -19,22 +19,70 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationStudentAddStudentGuardian = "/student.v1.Student/AddStudentGuardian"
+const OperationStudentChangeStudentStatus = "/student.v1.Student/ChangeStudentStatus"
 const OperationStudentCreateStudent = "/student.v1.Student/CreateStudent"
+const OperationStudentDeleteGrade = "/student.v1.Student/DeleteGrade"
 const OperationStudentDeleteStudent = "/student.v1.Student/DeleteStudent"
 const OperationStudentGetStudent = "/student.v1.Student/GetStudent"
+const OperationStudentGetTranscript = "/student.v1.Student/GetTranscript"
 const OperationStudentHealthCheck = "/student.v1.Student/HealthCheck"
+const OperationStudentImportStudents = "/student.v1.Student/ImportStudents"
+const OperationStudentLinkGuardianUser = "/student.v1.Student/LinkGuardianUser"
+const OperationStudentListDeletedStudents = "/student.v1.Student/ListDeletedStudents"
+const OperationStudentListGrades = "/student.v1.Student/ListGrades"
+const OperationStudentListMyChildren = "/student.v1.Student/ListMyChildren"
+const OperationStudentListStudentGuardians = "/student.v1.Student/ListStudentGuardians"
+const OperationStudentListStudentStatusChanges = "/student.v1.Student/ListStudentStatusChanges"
 const OperationStudentListStudents = "/student.v1.Student/ListStudents"
+const OperationStudentPurgeStudent = "/student.v1.Student/PurgeStudent"
+const OperationStudentRecordGrade = "/student.v1.Student/RecordGrade"
+const OperationStudentRemoveStudentGuardian = "/student.v1.Student/RemoveStudentGuardian"
+const OperationStudentRestoreStudent = "/student.v1.Student/RestoreStudent"
+const OperationStudentSearchStudents = "/student.v1.Student/SearchStudents"
 const OperationStudentUpdateStudent = "/student.v1.Student/UpdateStudent"
+const OperationStudentUpdateStudentGuardian = "/student.v1.Student/UpdateStudentGuardian"
 
 type StudentHTTPServer interface {
+	// AddStudentGuardian 添加监护人，guardian_id 不为 0 时关联已有监护人，否则新建监护人
+	AddStudentGuardian(context.Context, *AddStudentGuardianRequest) (*AddStudentGuardianReply, error)
+	// ChangeStudentStatus 变更学籍状态，必须填写原因，只允许状态机中定义的变动
+	ChangeStudentStatus(context.Context, *ChangeStudentStatusRequest) (*ChangeStudentStatusReply, error)
 	CreateStudent(context.Context, *CreateStudentRequest) (*CreateStudentReply, error)
+	DeleteGrade(context.Context, *DeleteGradeRequest) (*DeleteGradeReply, error)
 	DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentReply, error)
 	// GetStudent Sends a greeting
 	GetStudent(context.Context, *GetStudentRequest) (*GetStudentReply, error)
+	// GetTranscript 成绩单，按学期汇总学分和绩点
+	GetTranscript(context.Context, *GetTranscriptRequest) (*GetTranscriptReply, error)
 	// HealthCheck 健康检查
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckReply, error)
+	// ImportStudents 批量导入学生，支持 CSV 和 XLSX，第一行为表头；dry_run 时只校验并返回每行的错误
+	// 也可以通过 multipart 上传：POST /v1/students/import/upload，文件字段为 file
+	ImportStudents(context.Context, *ImportStudentsRequest) (*ImportStudentsReply, error)
+	// LinkGuardianUser 关联监护人的登录账号，关联后该账号可以查看监护人的学生，user_id 为 0 时取消关联
+	LinkGuardianUser(context.Context, *LinkGuardianUserRequest) (*LinkGuardianUserReply, error)
+	// ListDeletedStudents 回收站中已删除的学生，按删除时间倒序
+	ListDeletedStudents(context.Context, *ListDeletedStudentsRequest) (*ListDeletedStudentsReply, error)
+	ListGrades(context.Context, *ListGradesRequest) (*ListGradesReply, error)
+	// ListMyChildren 当前登录账号作为监护人关联的学生
+	ListMyChildren(context.Context, *ListMyChildrenRequest) (*ListMyChildrenReply, error)
+	// ListStudentGuardians 学生的监护人和紧急联系人，主要联系人排在最前
+	ListStudentGuardians(context.Context, *ListStudentGuardiansRequest) (*ListStudentGuardiansReply, error)
+	ListStudentStatusChanges(context.Context, *ListStudentStatusChangesRequest) (*ListStudentStatusChangesReply, error)
 	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsReply, error)
+	// PurgeStudent 彻底删除回收站中的学生，连同选课、成绩、考勤、监护关系和学籍变动记录，不可恢复
+	PurgeStudent(context.Context, *PurgeStudentRequest) (*PurgeStudentReply, error)
+	// RecordGrade 录入成绩，同一学生、课程和学期的成绩已存在时覆盖
+	RecordGrade(context.Context, *RecordGradeRequest) (*RecordGradeReply, error)
+	// RemoveStudentGuardian 移除监护人，监护人不再关联任何学生时一并删除
+	RemoveStudentGuardian(context.Context, *RemoveStudentGuardianRequest) (*RemoveStudentGuardianReply, error)
+	// RestoreStudent 恢复已删除的学生
+	RestoreStudent(context.Context, *RestoreStudentRequest) (*RestoreStudentReply, error)
+	// SearchStudents 按姓名和备注全文搜索学生，结果按相关度排序，返回带 <mark> 标记的高亮片段
+	SearchStudents(context.Context, *SearchStudentsRequest) (*SearchStudentsReply, error)
 	UpdateStudent(context.Context, *UpdateStudentRequest) (*UpdateStudentReply, error)
+	UpdateStudentGuardian(context.Context, *UpdateStudentGuardianRequest) (*UpdateStudentGuardianReply, error)
 }
 
 func RegisterStudentHTTPServer(s *http.Server, srv StudentHTTPServer) {
@@ -42,9 +90,27 @@ func RegisterStudentHTTPServer(s *http.Server, srv StudentHTTPServer) {
 	r.GET("/v1/students/health", _Student_HealthCheck0_HTTP_Handler(srv))
 	r.GET("/v1/student/{id}", _Student_GetStudent0_HTTP_Handler(srv))
 	r.POST("/v1/student", _Student_CreateStudent0_HTTP_Handler(srv))
-	r.PUT("/v1/student/{id}", _Student_UpdateStudent0_HTTP_Handler(srv))
+	r.PATCH("/v1/student/{id}", _Student_UpdateStudent0_HTTP_Handler(srv))
+	r.PUT("/v1/student/{id}", _Student_UpdateStudent1_HTTP_Handler(srv))
 	r.DELETE("/v1/student/{id}", _Student_DeleteStudent0_HTTP_Handler(srv))
 	r.GET("/v1/students", _Student_ListStudents0_HTTP_Handler(srv))
+	r.GET("/v1/students/search", _Student_SearchStudents0_HTTP_Handler(srv))
+	r.POST("/v1/students/import", _Student_ImportStudents0_HTTP_Handler(srv))
+	r.GET("/v1/recycle-bin/students", _Student_ListDeletedStudents0_HTTP_Handler(srv))
+	r.POST("/v1/recycle-bin/students/{id}/restore", _Student_RestoreStudent0_HTTP_Handler(srv))
+	r.DELETE("/v1/recycle-bin/students/{id}", _Student_PurgeStudent0_HTTP_Handler(srv))
+	r.POST("/v1/grades", _Student_RecordGrade0_HTTP_Handler(srv))
+	r.DELETE("/v1/grades/{id}", _Student_DeleteGrade0_HTTP_Handler(srv))
+	r.GET("/v1/grades", _Student_ListGrades0_HTTP_Handler(srv))
+	r.GET("/v1/student/{id}/transcript", _Student_GetTranscript0_HTTP_Handler(srv))
+	r.POST("/v1/student/{id}/status", _Student_ChangeStudentStatus0_HTTP_Handler(srv))
+	r.GET("/v1/student/{id}/status-changes", _Student_ListStudentStatusChanges0_HTTP_Handler(srv))
+	r.GET("/v1/student/{id}/guardians", _Student_ListStudentGuardians0_HTTP_Handler(srv))
+	r.POST("/v1/student/{id}/guardians", _Student_AddStudentGuardian0_HTTP_Handler(srv))
+	r.PUT("/v1/student/{id}/guardians/{guardian_id}", _Student_UpdateStudentGuardian0_HTTP_Handler(srv))
+	r.DELETE("/v1/student/{id}/guardians/{guardian_id}", _Student_RemoveStudentGuardian0_HTTP_Handler(srv))
+	r.PUT("/v1/guardians/{id}/user", _Student_LinkGuardianUser0_HTTP_Handler(srv))
+	r.GET("/v1/account/children", _Student_ListMyChildren0_HTTP_Handler(srv))
 }
 
 func _Student_HealthCheck0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
@@ -135,6 +201,31 @@ func _Student_UpdateStudent0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.C
 	}
 }
 
+func _Student_UpdateStudent1_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateStudentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentUpdateStudent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateStudent(ctx, req.(*UpdateStudentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateStudentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Student_DeleteStudent0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteStudentRequest
@@ -176,13 +267,407 @@ func _Student_ListStudents0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Student_SearchStudents0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchStudentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentSearchStudents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchStudents(ctx, req.(*SearchStudentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchStudentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ImportStudents0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportStudentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentImportStudents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportStudents(ctx, req.(*ImportStudentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportStudentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ListDeletedStudents0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedStudentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentListDeletedStudents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedStudents(ctx, req.(*ListDeletedStudentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedStudentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_RestoreStudent0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreStudentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentRestoreStudent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreStudent(ctx, req.(*RestoreStudentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreStudentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_PurgeStudent0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeStudentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentPurgeStudent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeStudent(ctx, req.(*PurgeStudentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeStudentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_RecordGrade0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecordGradeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentRecordGrade)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecordGrade(ctx, req.(*RecordGradeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecordGradeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_DeleteGrade0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteGradeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentDeleteGrade)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteGrade(ctx, req.(*DeleteGradeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteGradeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ListGrades0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGradesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentListGrades)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGrades(ctx, req.(*ListGradesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGradesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_GetTranscript0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTranscriptRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentGetTranscript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTranscript(ctx, req.(*GetTranscriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTranscriptReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ChangeStudentStatus0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeStudentStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentChangeStudentStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeStudentStatus(ctx, req.(*ChangeStudentStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeStudentStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ListStudentStatusChanges0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStudentStatusChangesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentListStudentStatusChanges)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStudentStatusChanges(ctx, req.(*ListStudentStatusChangesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStudentStatusChangesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ListStudentGuardians0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStudentGuardiansRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentListStudentGuardians)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStudentGuardians(ctx, req.(*ListStudentGuardiansRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStudentGuardiansReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_AddStudentGuardian0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddStudentGuardianRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentAddStudentGuardian)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddStudentGuardian(ctx, req.(*AddStudentGuardianRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddStudentGuardianReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_UpdateStudentGuardian0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateStudentGuardianRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentUpdateStudentGuardian)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateStudentGuardian(ctx, req.(*UpdateStudentGuardianRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateStudentGuardianReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_RemoveStudentGuardian0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveStudentGuardianRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentRemoveStudentGuardian)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveStudentGuardian(ctx, req.(*RemoveStudentGuardianRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveStudentGuardianReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_LinkGuardianUser0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkGuardianUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentLinkGuardianUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkGuardianUser(ctx, req.(*LinkGuardianUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkGuardianUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Student_ListMyChildren0_HTTP_Handler(srv StudentHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyChildrenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStudentListMyChildren)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyChildren(ctx, req.(*ListMyChildrenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyChildrenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type StudentHTTPClient interface {
+	AddStudentGuardian(ctx context.Context, req *AddStudentGuardianRequest, opts ...http.CallOption) (rsp *AddStudentGuardianReply, err error)
+	ChangeStudentStatus(ctx context.Context, req *ChangeStudentStatusRequest, opts ...http.CallOption) (rsp *ChangeStudentStatusReply, err error)
 	CreateStudent(ctx context.Context, req *CreateStudentRequest, opts ...http.CallOption) (rsp *CreateStudentReply, err error)
+	DeleteGrade(ctx context.Context, req *DeleteGradeRequest, opts ...http.CallOption) (rsp *DeleteGradeReply, err error)
 	DeleteStudent(ctx context.Context, req *DeleteStudentRequest, opts ...http.CallOption) (rsp *DeleteStudentReply, err error)
 	GetStudent(ctx context.Context, req *GetStudentRequest, opts ...http.CallOption) (rsp *GetStudentReply, err error)
+	GetTranscript(ctx context.Context, req *GetTranscriptRequest, opts ...http.CallOption) (rsp *GetTranscriptReply, err error)
 	HealthCheck(ctx context.Context, req *HealthCheckRequest, opts ...http.CallOption) (rsp *HealthCheckReply, err error)
+	ImportStudents(ctx context.Context, req *ImportStudentsRequest, opts ...http.CallOption) (rsp *ImportStudentsReply, err error)
+	LinkGuardianUser(ctx context.Context, req *LinkGuardianUserRequest, opts ...http.CallOption) (rsp *LinkGuardianUserReply, err error)
+	ListDeletedStudents(ctx context.Context, req *ListDeletedStudentsRequest, opts ...http.CallOption) (rsp *ListDeletedStudentsReply, err error)
+	ListGrades(ctx context.Context, req *ListGradesRequest, opts ...http.CallOption) (rsp *ListGradesReply, err error)
+	ListMyChildren(ctx context.Context, req *ListMyChildrenRequest, opts ...http.CallOption) (rsp *ListMyChildrenReply, err error)
+	ListStudentGuardians(ctx context.Context, req *ListStudentGuardiansRequest, opts ...http.CallOption) (rsp *ListStudentGuardiansReply, err error)
+	ListStudentStatusChanges(ctx context.Context, req *ListStudentStatusChangesRequest, opts ...http.CallOption) (rsp *ListStudentStatusChangesReply, err error)
 	ListStudents(ctx context.Context, req *ListStudentsRequest, opts ...http.CallOption) (rsp *ListStudentsReply, err error)
+	PurgeStudent(ctx context.Context, req *PurgeStudentRequest, opts ...http.CallOption) (rsp *PurgeStudentReply, err error)
+	RecordGrade(ctx context.Context, req *RecordGradeRequest, opts ...http.CallOption) (rsp *RecordGradeReply, err error)
+	RemoveStudentGuardian(ctx context.Context, req *RemoveStudentGuardianRequest, opts ...http.CallOption) (rsp *RemoveStudentGuardianReply, err error)
+	RestoreStudent(ctx context.Context, req *RestoreStudentRequest, opts ...http.CallOption) (rsp *RestoreStudentReply, err error)
+	SearchStudents(ctx context.Context, req *SearchStudentsRequest, opts ...http.CallOption) (rsp *SearchStudentsReply, err error)
 	UpdateStudent(ctx context.Context, req *UpdateStudentRequest, opts ...http.CallOption) (rsp *UpdateStudentReply, err error)
+	UpdateStudentGuardian(ctx context.Context, req *UpdateStudentGuardianRequest, opts ...http.CallOption) (rsp *UpdateStudentGuardianReply, err error)
 }
 
 type StudentHTTPClientImpl struct {
@@ -193,6 +678,32 @@ func NewStudentHTTPClient(client *http.Client) StudentHTTPClient {
 	return &StudentHTTPClientImpl{client}
 }
 
+func (c *StudentHTTPClientImpl) AddStudentGuardian(ctx context.Context, in *AddStudentGuardianRequest, opts ...http.CallOption) (*AddStudentGuardianReply, error) {
+	var out AddStudentGuardianReply
+	pattern := "/v1/student/{id}/guardians"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentAddStudentGuardian))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ChangeStudentStatus(ctx context.Context, in *ChangeStudentStatusRequest, opts ...http.CallOption) (*ChangeStudentStatusReply, error) {
+	var out ChangeStudentStatusReply
+	pattern := "/v1/student/{id}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentChangeStudentStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StudentHTTPClientImpl) CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...http.CallOption) (*CreateStudentReply, error) {
 	var out CreateStudentReply
 	pattern := "/v1/student"
@@ -206,6 +717,19 @@ func (c *StudentHTTPClientImpl) CreateStudent(ctx context.Context, in *CreateStu
 	return &out, nil
 }
 
+func (c *StudentHTTPClientImpl) DeleteGrade(ctx context.Context, in *DeleteGradeRequest, opts ...http.CallOption) (*DeleteGradeReply, error) {
+	var out DeleteGradeReply
+	pattern := "/v1/grades/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentDeleteGrade))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StudentHTTPClientImpl) DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...http.CallOption) (*DeleteStudentReply, error) {
 	var out DeleteStudentReply
 	pattern := "/v1/student/{id}"
@@ -232,6 +756,19 @@ func (c *StudentHTTPClientImpl) GetStudent(ctx context.Context, in *GetStudentRe
 	return &out, nil
 }
 
+func (c *StudentHTTPClientImpl) GetTranscript(ctx context.Context, in *GetTranscriptRequest, opts ...http.CallOption) (*GetTranscriptReply, error) {
+	var out GetTranscriptReply
+	pattern := "/v1/student/{id}/transcript"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentGetTranscript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StudentHTTPClientImpl) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...http.CallOption) (*HealthCheckReply, error) {
 	var out HealthCheckReply
 	pattern := "/v1/students/health"
@@ -245,6 +782,97 @@ func (c *StudentHTTPClientImpl) HealthCheck(ctx context.Context, in *HealthCheck
 	return &out, nil
 }
 
+func (c *StudentHTTPClientImpl) ImportStudents(ctx context.Context, in *ImportStudentsRequest, opts ...http.CallOption) (*ImportStudentsReply, error) {
+	var out ImportStudentsReply
+	pattern := "/v1/students/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentImportStudents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) LinkGuardianUser(ctx context.Context, in *LinkGuardianUserRequest, opts ...http.CallOption) (*LinkGuardianUserReply, error) {
+	var out LinkGuardianUserReply
+	pattern := "/v1/guardians/{id}/user"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentLinkGuardianUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ListDeletedStudents(ctx context.Context, in *ListDeletedStudentsRequest, opts ...http.CallOption) (*ListDeletedStudentsReply, error) {
+	var out ListDeletedStudentsReply
+	pattern := "/v1/recycle-bin/students"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentListDeletedStudents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ListGrades(ctx context.Context, in *ListGradesRequest, opts ...http.CallOption) (*ListGradesReply, error) {
+	var out ListGradesReply
+	pattern := "/v1/grades"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentListGrades))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ListMyChildren(ctx context.Context, in *ListMyChildrenRequest, opts ...http.CallOption) (*ListMyChildrenReply, error) {
+	var out ListMyChildrenReply
+	pattern := "/v1/account/children"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentListMyChildren))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ListStudentGuardians(ctx context.Context, in *ListStudentGuardiansRequest, opts ...http.CallOption) (*ListStudentGuardiansReply, error) {
+	var out ListStudentGuardiansReply
+	pattern := "/v1/student/{id}/guardians"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentListStudentGuardians))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) ListStudentStatusChanges(ctx context.Context, in *ListStudentStatusChangesRequest, opts ...http.CallOption) (*ListStudentStatusChangesReply, error) {
+	var out ListStudentStatusChangesReply
+	pattern := "/v1/student/{id}/status-changes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentListStudentStatusChanges))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StudentHTTPClientImpl) ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...http.CallOption) (*ListStudentsReply, error) {
 	var out ListStudentsReply
 	pattern := "/v1/students"
@@ -258,6 +886,71 @@ func (c *StudentHTTPClientImpl) ListStudents(ctx context.Context, in *ListStuden
 	return &out, nil
 }
 
+func (c *StudentHTTPClientImpl) PurgeStudent(ctx context.Context, in *PurgeStudentRequest, opts ...http.CallOption) (*PurgeStudentReply, error) {
+	var out PurgeStudentReply
+	pattern := "/v1/recycle-bin/students/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentPurgeStudent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) RecordGrade(ctx context.Context, in *RecordGradeRequest, opts ...http.CallOption) (*RecordGradeReply, error) {
+	var out RecordGradeReply
+	pattern := "/v1/grades"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentRecordGrade))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) RemoveStudentGuardian(ctx context.Context, in *RemoveStudentGuardianRequest, opts ...http.CallOption) (*RemoveStudentGuardianReply, error) {
+	var out RemoveStudentGuardianReply
+	pattern := "/v1/student/{id}/guardians/{guardian_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentRemoveStudentGuardian))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) RestoreStudent(ctx context.Context, in *RestoreStudentRequest, opts ...http.CallOption) (*RestoreStudentReply, error) {
+	var out RestoreStudentReply
+	pattern := "/v1/recycle-bin/students/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentRestoreStudent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StudentHTTPClientImpl) SearchStudents(ctx context.Context, in *SearchStudentsRequest, opts ...http.CallOption) (*SearchStudentsReply, error) {
+	var out SearchStudentsReply
+	pattern := "/v1/students/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStudentSearchStudents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StudentHTTPClientImpl) UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...http.CallOption) (*UpdateStudentReply, error) {
 	var out UpdateStudentReply
 	pattern := "/v1/student/{id}"
@@ -270,3 +963,16 @@ func (c *StudentHTTPClientImpl) UpdateStudent(ctx context.Context, in *UpdateStu
 	}
 	return &out, nil
 }
+
+func (c *StudentHTTPClientImpl) UpdateStudentGuardian(ctx context.Context, in *UpdateStudentGuardianRequest, opts ...http.CallOption) (*UpdateStudentGuardianReply, error) {
+	var out UpdateStudentGuardianReply
+	pattern := "/v1/student/{id}/guardians/{guardian_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStudentUpdateStudentGuardian))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -19,44 +19,158 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserChangePassword = "/user.v1.User/ChangePassword"
+const OperationUserCreateAPIKey = "/user.v1.User/CreateAPIKey"
+const OperationUserCreateOAuthClient = "/user.v1.User/CreateOAuthClient"
 const OperationUserCreateUser = "/user.v1.User/CreateUser"
+const OperationUserDeleteOAuthClient = "/user.v1.User/DeleteOAuthClient"
 const OperationUserDeleteUser = "/user.v1.User/DeleteUser"
+const OperationUserDisableMFA = "/user.v1.User/DisableMFA"
+const OperationUserEnableMFA = "/user.v1.User/EnableMFA"
+const OperationUserExternalLoginCallback = "/user.v1.User/ExternalLoginCallback"
 const OperationUserGetMe = "/user.v1.User/GetMe"
 const OperationUserGetUser = "/user.v1.User/GetUser"
+const OperationUserImpersonate = "/user.v1.User/Impersonate"
+const OperationUserListAPIKeys = "/user.v1.User/ListAPIKeys"
+const OperationUserListDeletedUsers = "/user.v1.User/ListDeletedUsers"
+const OperationUserListMySessions = "/user.v1.User/ListMySessions"
+const OperationUserListOAuthClients = "/user.v1.User/ListOAuthClients"
 const OperationUserListUsers = "/user.v1.User/ListUsers"
 const OperationUserLogin = "/user.v1.User/Login"
+const OperationUserLogout = "/user.v1.User/Logout"
+const OperationUserPurgeUser = "/user.v1.User/PurgeUser"
+const OperationUserRefreshToken = "/user.v1.User/RefreshToken"
 const OperationUserRegister = "/user.v1.User/Register"
+const OperationUserRequestPasswordReset = "/user.v1.User/RequestPasswordReset"
+const OperationUserResetPassword = "/user.v1.User/ResetPassword"
+const OperationUserRestoreUser = "/user.v1.User/RestoreUser"
+const OperationUserRevokeAPIKey = "/user.v1.User/RevokeAPIKey"
+const OperationUserRevokeAllSessions = "/user.v1.User/RevokeAllSessions"
+const OperationUserRevokeSession = "/user.v1.User/RevokeSession"
+const OperationUserSendVerificationEmail = "/user.v1.User/SendVerificationEmail"
+const OperationUserSetupMFA = "/user.v1.User/SetupMFA"
+const OperationUserStartExternalLogin = "/user.v1.User/StartExternalLogin"
+const OperationUserUnlockUser = "/user.v1.User/UnlockUser"
+const OperationUserUpdateMe = "/user.v1.User/UpdateMe"
 const OperationUserUpdateUser = "/user.v1.User/UpdateUser"
+const OperationUserVerifyEmail = "/user.v1.User/VerifyEmail"
+const OperationUserVerifyMFA = "/user.v1.User/VerifyMFA"
 
 type UserHTTPServer interface {
+	// ChangePassword 修改当前用户密码，成功后撤销其他会话
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// CreateAPIKey 为当前用户创建 API Key，API Key 只能在 HTTP 接口中通过 X-API-Key 或 Authorization: ApiKey 请求头使用，gRPC 接口不支持
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	// CreateOAuthClient 注册单点登录客户端
+	CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientReply, error)
 	// CreateUser 创建用户
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserReply, error)
+	// DeleteOAuthClient 删除单点登录客户端
+	DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*DeleteOAuthClientReply, error)
 	// DeleteUser 删除用户
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserReply, error)
+	// DisableMFA 关闭两步验证
+	DisableMFA(context.Context, *DisableMFARequest) (*DisableMFAReply, error)
+	// EnableMFA 启用两步验证
+	EnableMFA(context.Context, *EnableMFARequest) (*EnableMFAReply, error)
+	// ExternalLoginCallback 外部身份提供方回调，校验授权码后登录
+	ExternalLoginCallback(context.Context, *ExternalLoginCallbackRequest) (*LoginReply, error)
 	// GetMe 获取当前用户信息
 	GetMe(context.Context, *GetMeRequest) (*GetMeReply, error)
 	// GetUser 获取用户信息
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
+	// Impersonate 以指定用户的身份登录，用于排查权限问题，返回短期Token
+	Impersonate(context.Context, *ImpersonateRequest) (*ImpersonateReply, error)
+	// ListAPIKeys 获取当前用户的 API Key 列表
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error)
+	// ListDeletedUsers 回收站中已删除的用户，按删除时间倒序
+	ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersReply, error)
+	// ListMySessions 获取当前用户的登录会话
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsReply, error)
+	// ListOAuthClients 获取单点登录客户端列表
+	ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsReply, error)
 	// ListUsers 获取用户列表
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
 	// Login 用户登录
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// Logout 退出登录，撤销当前访问令牌及其会话
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// PurgeUser 彻底删除回收站中的用户，连同其会话、API 密钥、第三方账号绑定和角色，不可恢复
+	PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserReply, error)
+	// RefreshToken 刷新访问令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
 	// Register 用户注册
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	// RequestPasswordReset 申请重置密码，向注册邮箱发送重置链接
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	// ResetPassword 使用邮件中的令牌重置密码
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	// RestoreUser 恢复已删除的用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserReply, error)
+	// RevokeAPIKey 撤销当前用户的 API Key
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	// RevokeAllSessions 撤销用户的全部会话
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsReply, error)
+	// RevokeSession 撤销当前用户的指定会话
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error)
+	// SendVerificationEmail 向当前用户邮箱发送验证邮件
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailReply, error)
+	// SetupMFA 初始化两步验证，生成 TOTP 密钥
+	SetupMFA(context.Context, *SetupMFARequest) (*SetupMFAReply, error)
+	// StartExternalLogin 发起外部身份提供方登录，返回授权地址
+	StartExternalLogin(context.Context, *StartExternalLoginRequest) (*StartExternalLoginReply, error)
+	// UnlockUser 解除账户锁定
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserReply, error)
+	// UpdateMe 更新当前用户资料，仅允许修改邮箱、手机号、头像和年龄
+	UpdateMe(context.Context, *UpdateMeRequest) (*UpdateMeReply, error)
 	// UpdateUser 更新用户
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserReply, error)
+	// VerifyEmail 使用邮件中的令牌验证邮箱
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailReply, error)
+	// VerifyMFA 两步验证登录
+	VerifyMFA(context.Context, *VerifyMFARequest) (*LoginReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/account/me", _User_GetMe0_HTTP_Handler(srv))
+	r.PATCH("/v1/account/me", _User_UpdateMe0_HTTP_Handler(srv))
+	r.PUT("/v1/account/me", _User_UpdateMe1_HTTP_Handler(srv))
+	r.POST("/v1/account/password", _User_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/v1/account/api-keys", _User_CreateAPIKey0_HTTP_Handler(srv))
+	r.GET("/v1/account/api-keys", _User_ListAPIKeys0_HTTP_Handler(srv))
+	r.DELETE("/v1/account/api-keys/{id}", _User_RevokeAPIKey0_HTTP_Handler(srv))
+	r.GET("/v1/account/sessions", _User_ListMySessions0_HTTP_Handler(srv))
+	r.DELETE("/v1/account/sessions/{id}", _User_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/v1/oauth/clients", _User_CreateOAuthClient0_HTTP_Handler(srv))
+	r.GET("/v1/oauth/clients", _User_ListOAuthClients0_HTTP_Handler(srv))
+	r.DELETE("/v1/oauth/clients/{client_id}", _User_DeleteOAuthClient0_HTTP_Handler(srv))
+	r.POST("/v1/impersonations", _User_Impersonate0_HTTP_Handler(srv))
 	r.GET("/v1/user/{id}", _User_GetUser0_HTTP_Handler(srv))
 	r.POST("/v1/user", _User_CreateUser0_HTTP_Handler(srv))
-	r.PUT("/v1/user/{id}", _User_UpdateUser0_HTTP_Handler(srv))
+	r.PATCH("/v1/user/{id}", _User_UpdateUser0_HTTP_Handler(srv))
+	r.PUT("/v1/user/{id}", _User_UpdateUser1_HTTP_Handler(srv))
 	r.DELETE("/v1/user/{id}", _User_DeleteUser0_HTTP_Handler(srv))
 	r.GET("/v1/users", _User_ListUsers0_HTTP_Handler(srv))
+	r.GET("/v1/recycle-bin/users", _User_ListDeletedUsers0_HTTP_Handler(srv))
+	r.POST("/v1/recycle-bin/users/{id}/restore", _User_RestoreUser0_HTTP_Handler(srv))
+	r.DELETE("/v1/recycle-bin/users/{id}", _User_PurgeUser0_HTTP_Handler(srv))
 	r.POST("/v1/user/login", _User_Login0_HTTP_Handler(srv))
 	r.POST("/v1/user/register", _User_Register0_HTTP_Handler(srv))
+	r.POST("/v1/user/refresh", _User_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/v1/user/logout", _User_Logout0_HTTP_Handler(srv))
+	r.POST("/v1/user/{id}/sessions/revoke", _User_RevokeAllSessions0_HTTP_Handler(srv))
+	r.POST("/v1/user/{id}/unlock", _User_UnlockUser0_HTTP_Handler(srv))
+	r.POST("/v1/user/login/mfa", _User_VerifyMFA0_HTTP_Handler(srv))
+	r.GET("/v1/user/oauth/{provider}/start", _User_StartExternalLogin0_HTTP_Handler(srv))
+	r.GET("/v1/user/oauth/{provider}/callback", _User_ExternalLoginCallback0_HTTP_Handler(srv))
+	r.POST("/v1/account/mfa/setup", _User_SetupMFA0_HTTP_Handler(srv))
+	r.POST("/v1/account/mfa/enable", _User_EnableMFA0_HTTP_Handler(srv))
+	r.POST("/v1/account/mfa/disable", _User_DisableMFA0_HTTP_Handler(srv))
+	r.POST("/v1/user/password/forgot", _User_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/v1/user/password/reset", _User_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/v1/account/email/verification", _User_SendVerificationEmail0_HTTP_Handler(srv))
+	r.POST("/v1/user/email/verify", _User_VerifyEmail0_HTTP_Handler(srv))
 }
 
 func _User_GetMe0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -78,6 +192,261 @@ func _User_GetMe0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _User_UpdateMe0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateMe)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMe(ctx, req.(*UpdateMeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateMeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UpdateMe1_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateMe)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMe(ctx, req.(*UpdateMeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateMeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ChangePassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CreateAPIKey0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreateAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListAPIKeys0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListAPIKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokeAPIKey0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListMySessions0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMySessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListMySessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMySessions(ctx, req.(*ListMySessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMySessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokeSession0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CreateOAuthClient0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOAuthClientRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCreateOAuthClient)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOAuthClient(ctx, req.(*CreateOAuthClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOAuthClientReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListOAuthClients0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOAuthClientsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListOAuthClients)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOAuthClients(ctx, req.(*ListOAuthClientsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOAuthClientsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DeleteOAuthClient0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOAuthClientRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDeleteOAuthClient)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOAuthClient(ctx, req.(*DeleteOAuthClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteOAuthClientReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Impersonate0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImpersonateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserImpersonate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Impersonate(ctx, req.(*ImpersonateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImpersonateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_GetUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetUserRequest
@@ -147,6 +516,31 @@ func _User_UpdateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _User_UpdateUser1_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUser(ctx, req.(*UpdateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_DeleteUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteUserRequest
@@ -188,59 +582,467 @@ func _User_ListUsers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) er
 	}
 }
 
-func _User_Login0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_ListDeletedUsers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginRequest
+		var in ListDeletedUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListDeletedUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedUsers(ctx, req.(*ListDeletedUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RestoreUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreUserRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserLogin)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRestoreUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Login(ctx, req.(*LoginRequest))
+			return srv.RestoreUser(ctx, req.(*RestoreUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LoginReply)
+		reply := out.(*RestoreUserReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _User_Register0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+func _User_PurgeUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in RegisterRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in PurgeUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindQuery(&in); err != nil {
+		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserRegister)
+		http.SetOperation(ctx, OperationUserPurgeUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Register(ctx, req.(*RegisterRequest))
+			return srv.PurgeUser(ctx, req.(*PurgeUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*RegisterReply)
+		reply := out.(*PurgeUserReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type UserHTTPClient interface {
-	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserReply, err error)
-	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
-	GetMe(ctx context.Context, req *GetMeRequest, opts ...http.CallOption) (rsp *GetMeReply, err error)
-	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
-	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
+func _User_Login0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Login(ctx, req.(*LoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Register0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegisterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRegister)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Register(ctx, req.(*RegisterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RefreshToken0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Logout0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RevokeAllSessions0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAllSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRevokeAllSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAllSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UnlockUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_VerifyMFA0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_StartExternalLogin0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartExternalLoginRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserStartExternalLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartExternalLogin(ctx, req.(*StartExternalLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StartExternalLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ExternalLoginCallback0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExternalLoginCallbackRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserExternalLoginCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExternalLoginCallback(ctx, req.(*ExternalLoginCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SetupMFA0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetupMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetupMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetupMFA(ctx, req.(*SetupMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetupMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_EnableMFA0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserEnableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnableMFA(ctx, req.(*EnableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnableMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_DisableMFA0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserDisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*DisableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DisableMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RequestPasswordReset0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ResetPassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SendVerificationEmail0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendVerificationEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSendVerificationEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendVerificationEmailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_VerifyEmail0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyEmailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserHTTPClient interface {
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyReply, err error)
+	CreateOAuthClient(ctx context.Context, req *CreateOAuthClientRequest, opts ...http.CallOption) (rsp *CreateOAuthClientReply, err error)
+	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserReply, err error)
+	DeleteOAuthClient(ctx context.Context, req *DeleteOAuthClientRequest, opts ...http.CallOption) (rsp *DeleteOAuthClientReply, err error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest, opts ...http.CallOption) (rsp *DisableMFAReply, err error)
+	EnableMFA(ctx context.Context, req *EnableMFARequest, opts ...http.CallOption) (rsp *EnableMFAReply, err error)
+	ExternalLoginCallback(ctx context.Context, req *ExternalLoginCallbackRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	GetMe(ctx context.Context, req *GetMeRequest, opts ...http.CallOption) (rsp *GetMeReply, err error)
+	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
+	Impersonate(ctx context.Context, req *ImpersonateRequest, opts ...http.CallOption) (rsp *ImpersonateReply, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysReply, err error)
+	ListDeletedUsers(ctx context.Context, req *ListDeletedUsersRequest, opts ...http.CallOption) (rsp *ListDeletedUsersReply, err error)
+	ListMySessions(ctx context.Context, req *ListMySessionsRequest, opts ...http.CallOption) (rsp *ListMySessionsReply, err error)
+	ListOAuthClients(ctx context.Context, req *ListOAuthClientsRequest, opts ...http.CallOption) (rsp *ListOAuthClientsReply, err error)
+	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	PurgeUser(ctx context.Context, req *PurgeUserRequest, opts ...http.CallOption) (rsp *PurgeUserReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RestoreUser(ctx context.Context, req *RestoreUserRequest, opts ...http.CallOption) (rsp *RestoreUserReply, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *RevokeAPIKeyReply, err error)
+	RevokeAllSessions(ctx context.Context, req *RevokeAllSessionsRequest, opts ...http.CallOption) (rsp *RevokeAllSessionsReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *RevokeSessionReply, err error)
+	SendVerificationEmail(ctx context.Context, req *SendVerificationEmailRequest, opts ...http.CallOption) (rsp *SendVerificationEmailReply, err error)
+	SetupMFA(ctx context.Context, req *SetupMFARequest, opts ...http.CallOption) (rsp *SetupMFAReply, err error)
+	StartExternalLogin(ctx context.Context, req *StartExternalLoginRequest, opts ...http.CallOption) (rsp *StartExternalLoginReply, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *UnlockUserReply, err error)
+	UpdateMe(ctx context.Context, req *UpdateMeRequest, opts ...http.CallOption) (rsp *UpdateMeReply, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserReply, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *VerifyEmailReply, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 }
 
 type UserHTTPClientImpl struct {
@@ -251,6 +1053,45 @@ func NewUserHTTPClient(client *http.Client) UserHTTPClient {
 	return &UserHTTPClientImpl{client}
 }
 
+func (c *UserHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordReply, error) {
+	var out ChangePasswordReply
+	pattern := "/v1/account/password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyReply, error) {
+	var out CreateAPIKeyReply
+	pattern := "/v1/account/api-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...http.CallOption) (*CreateOAuthClientReply, error) {
+	var out CreateOAuthClientReply
+	pattern := "/v1/oauth/clients"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCreateOAuthClient))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserReply, error) {
 	var out CreateUserReply
 	pattern := "/v1/user"
@@ -264,6 +1105,19 @@ func (c *UserHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserReque
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...http.CallOption) (*DeleteOAuthClientReply, error) {
+	var out DeleteOAuthClientReply
+	pattern := "/v1/oauth/clients/{client_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserDeleteOAuthClient))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...http.CallOption) (*DeleteUserReply, error) {
 	var out DeleteUserReply
 	pattern := "/v1/user/{id}"
@@ -277,6 +1131,45 @@ func (c *UserHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserReque
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...http.CallOption) (*DisableMFAReply, error) {
+	var out DisableMFAReply
+	pattern := "/v1/account/mfa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserDisableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) EnableMFA(ctx context.Context, in *EnableMFARequest, opts ...http.CallOption) (*EnableMFAReply, error) {
+	var out EnableMFAReply
+	pattern := "/v1/account/mfa/enable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserEnableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ExternalLoginCallback(ctx context.Context, in *ExternalLoginCallbackRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/user/oauth/{provider}/callback"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserExternalLoginCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) GetMe(ctx context.Context, in *GetMeRequest, opts ...http.CallOption) (*GetMeReply, error) {
 	var out GetMeReply
 	pattern := "/v1/account/me"
@@ -303,6 +1196,71 @@ func (c *UserHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, op
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...http.CallOption) (*ImpersonateReply, error) {
+	var out ImpersonateReply
+	pattern := "/v1/impersonations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserImpersonate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysReply, error) {
+	var out ListAPIKeysReply
+	pattern := "/v1/account/api-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListAPIKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...http.CallOption) (*ListDeletedUsersReply, error) {
+	var out ListDeletedUsersReply
+	pattern := "/v1/recycle-bin/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListDeletedUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...http.CallOption) (*ListMySessionsReply, error) {
+	var out ListMySessionsReply
+	pattern := "/v1/account/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListMySessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...http.CallOption) (*ListOAuthClientsReply, error) {
+	var out ListOAuthClientsReply
+	pattern := "/v1/oauth/clients"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListOAuthClients))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersReply, error) {
 	var out ListUsersReply
 	pattern := "/v1/users"
@@ -329,6 +1287,45 @@ func (c *UserHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts .
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/v1/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...http.CallOption) (*PurgeUserReply, error) {
+	var out PurgeUserReply
+	pattern := "/v1/recycle-bin/users/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserPurgeUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/v1/user/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
 	var out RegisterReply
 	pattern := "/v1/user/register"
@@ -342,6 +1339,149 @@ func (c *UserHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest,
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetReply, error) {
+	var out RequestPasswordResetReply
+	pattern := "/v1/user/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
+	var out ResetPasswordReply
+	pattern := "/v1/user/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...http.CallOption) (*RestoreUserReply, error) {
+	var out RestoreUserReply
+	pattern := "/v1/recycle-bin/users/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRestoreUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*RevokeAPIKeyReply, error) {
+	var out RevokeAPIKeyReply
+	pattern := "/v1/account/api-keys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...http.CallOption) (*RevokeAllSessionsReply, error) {
+	var out RevokeAllSessionsReply
+	pattern := "/v1/user/{id}/sessions/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRevokeAllSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*RevokeSessionReply, error) {
+	var out RevokeSessionReply
+	pattern := "/v1/account/sessions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...http.CallOption) (*SendVerificationEmailReply, error) {
+	var out SendVerificationEmailReply
+	pattern := "/v1/account/email/verification"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSendVerificationEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) SetupMFA(ctx context.Context, in *SetupMFARequest, opts ...http.CallOption) (*SetupMFAReply, error) {
+	var out SetupMFAReply
+	pattern := "/v1/account/mfa/setup"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSetupMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) StartExternalLogin(ctx context.Context, in *StartExternalLoginRequest, opts ...http.CallOption) (*StartExternalLoginReply, error) {
+	var out StartExternalLoginReply
+	pattern := "/v1/user/oauth/{provider}/start"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserStartExternalLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*UnlockUserReply, error) {
+	var out UnlockUserReply
+	pattern := "/v1/user/{id}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...http.CallOption) (*UpdateMeReply, error) {
+	var out UpdateMeReply
+	pattern := "/v1/account/me"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUpdateMe))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UpdateUserReply, error) {
 	var out UpdateUserReply
 	pattern := "/v1/user/{id}"
@@ -354,3 +1494,29 @@ func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserReque
 	}
 	return &out, nil
 }
+
+func (c *UserHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*VerifyEmailReply, error) {
+	var out VerifyEmailReply
+	pattern := "/v1/user/email/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/user/login/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -14,6 +14,7 @@ import (
 	"student/internal/data"
 	"student/internal/pkg/jwt"
 	"student/internal/pkg/nacos"
+	"student/internal/pkg/password"
 	"student/internal/student-service/biz"
 	data2 "student/internal/student-service/data"
 	"student/internal/student-service/server"
@@ -52,10 +53,34 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger, discovery *nacos.Disc
 	rbacRepo := data.NewRBACRepo(data3, logger, string2)
 	rbac := data.NewRBACConfig(bootstrap)
 	rbacUsecase := biz2.NewRBACUsecase(rbacRepo, logger, rbac)
+	userRepo := data.NewUserRepo(data3, logger)
+	tokenRepo := data.NewTokenRepo(data3, logger)
+	mfaRepo := data.NewMFARepo(data3, logger)
+	sessionRepo := data.NewSessionRepo(data3, logger)
+	loginAttemptRepo := data.NewLoginAttemptRepo(data3, logger)
+	loginSecurity := data.NewLoginSecurityConfig(bootstrap)
+	loginLimiter := biz2.NewLoginLimiter(loginAttemptRepo, loginSecurity, logger)
 	config := data.NewJWTConfig(bootstrap)
-	jwtUtil := jwt.NewJWTUtil(config)
-	grpcServer := server.NewGRPCServer(bootstrap, studentService, rbacUsecase, jwtUtil, logger)
-	httpServer := server.NewHTTPServer(bootstrap, studentService, rbacUsecase, jwtUtil, logger)
+	jwtUtil, err := jwt.NewJWTUtil(config)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordConfig := data.NewPasswordConfig(bootstrap)
+	manager, err := password.NewManager(passwordConfig)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz2.NewUserUsecase(userRepo, tokenRepo, mfaRepo, sessionRepo, loginLimiter, rbacUsecase, jwtUtil, manager, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(data3, logger)
+	apiKeyUsecase := biz2.NewAPIKeyUsecase(apiKeyRepo, userRepo, logger)
+	auditLogRepo := data.NewAuditLogRepo(data3, logger)
+	impersonationUsecase := biz2.NewImpersonationUsecase(userRepo, auditLogRepo, rbacUsecase, jwtUtil, logger)
+	grpcServer := server.NewGRPCServer(bootstrap, studentService, rbacUsecase, userUsecase, apiKeyUsecase, impersonationUsecase, jwtUtil, logger)
+	httpServer := server.NewHTTPServer(bootstrap, studentService, rbacUsecase, userUsecase, apiKeyUsecase, impersonationUsecase, jwtUtil, logger)
 	app := newApp(logger, grpcServer, httpServer, discovery, bootstrap)
 	return app, func() {
 		cleanup2()
@@ -13,6 +13,8 @@ import (
 	"student/internal/conf"
 	"student/internal/data"
 	"student/internal/pkg/jwt"
+	"student/internal/pkg/mailer"
+	"student/internal/pkg/password"
 	"student/internal/server"
 	"student/internal/service"
 )
@@ -38,25 +40,105 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(),
 		return nil, nil, err
 	}
 	studentRepo := data.NewStudentRepo(dataData, logger)
-	studentUsecase := biz.NewStudentUsecase(studentRepo, logger)
-	studentService := service.NewStudentService(studentUsecase, logger)
+	academicTermRepo := data.NewAcademicTermRepo(dataData, logger)
+	searchIndex, cleanup2, err := data.NewSearchIndex(bootstrap, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	studentUsecase := biz.NewStudentUsecase(studentRepo, academicTermRepo, searchIndex, logger)
+	gradeRepo := data.NewGradeRepo(dataData, logger)
+	courseRepo := data.NewCourseRepo(dataData, logger)
+	grading := data.NewGradingConfig(bootstrap)
+	gradeUsecase, err := biz.NewGradeUsecase(gradeRepo, studentRepo, courseRepo, grading, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	guardianRepo := data.NewGuardianRepo(dataData, logger)
 	userRepo := data.NewUserRepo(dataData, logger)
 	string2 := data.NewRBACModelPath(bootstrap)
 	rbacRepo := data.NewRBACRepo(dataData, logger, string2)
 	rbac := data.NewRBACConfig(bootstrap)
 	rbacUsecase := biz.NewRBACUsecase(rbacRepo, logger, rbac)
+	guardianUsecase := biz.NewGuardianUsecase(guardianRepo, studentRepo, userRepo, rbacUsecase, logger)
+	recycleBin := data.NewRecycleBinConfig(bootstrap)
+	recycleBinUsecase := biz.NewRecycleBinUsecase(studentRepo, userRepo, rbacRepo, rbacUsecase, searchIndex, recycleBin, logger)
+	studentService := service.NewStudentService(studentUsecase, gradeUsecase, guardianUsecase, recycleBinUsecase, logger)
+	tokenRepo := data.NewTokenRepo(dataData, logger)
+	mfaRepo := data.NewMFARepo(dataData, logger)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	loginAttemptRepo := data.NewLoginAttemptRepo(dataData, logger)
+	loginSecurity := data.NewLoginSecurityConfig(bootstrap)
+	loginLimiter := biz.NewLoginLimiter(loginAttemptRepo, loginSecurity, logger)
 	config := data.NewJWTConfig(bootstrap)
-	jwtUtil := jwt.NewJWTUtil(config)
-	userUsecase := biz.NewUserUsecase(userRepo, rbacUsecase, jwtUtil, logger)
-	userService := service.NewUserService(userUsecase, logger)
-	grpcServer := server.NewGRPCServer(bootstrap, studentService, userService, rbacUsecase, jwtUtil, logger)
-	rbacService := service.NewRBACService(rbacUsecase, logger)
+	jwtUtil, err := jwt.NewJWTUtil(config)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordConfig := data.NewPasswordConfig(bootstrap)
+	manager, err := password.NewManager(passwordConfig)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(userRepo, tokenRepo, mfaRepo, sessionRepo, loginLimiter, rbacUsecase, jwtUtil, manager, logger)
+	accountTokenRepo := data.NewAccountTokenRepo(dataData, logger)
+	mailerConfig := data.NewMailConfig(bootstrap)
+	mailerMailer, err := mailer.NewMailer(mailerConfig)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	account := data.NewAccountConfig(bootstrap)
+	accountUsecase := biz.NewAccountUsecase(userRepo, accountTokenRepo, userUsecase, mailerMailer, account, logger)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData, logger)
+	apiKeyUsecase := biz.NewAPIKeyUsecase(apiKeyRepo, userRepo, logger)
+	oAuthClientRepo := data.NewOAuthClientRepo(dataData, logger)
+	authorizationCodeRepo := data.NewAuthorizationCodeRepo(dataData, logger)
+	oidc := data.NewOIDCConfig(bootstrap)
+	oidcUsecase := biz.NewOIDCUsecase(oAuthClientRepo, authorizationCodeRepo, userUsecase, jwtUtil, oidc, logger)
+	externalIdentityRepo := data.NewExternalIdentityRepo(dataData, logger)
+	externalLoginStateRepo := data.NewExternalLoginStateRepo(dataData, logger)
+	v := data.NewExternalProviderConfigs(bootstrap)
+	externalLoginUsecase, err := biz.NewExternalLoginUsecase(externalIdentityRepo, externalLoginStateRepo, userRepo, userUsecase, rbacUsecase, v, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auditLogRepo := data.NewAuditLogRepo(dataData, logger)
+	impersonationUsecase := biz.NewImpersonationUsecase(userRepo, auditLogRepo, rbacUsecase, jwtUtil, logger)
+	userService := service.NewUserService(userUsecase, accountUsecase, apiKeyUsecase, oidcUsecase, externalLoginUsecase, impersonationUsecase, recycleBinUsecase, logger)
+	enrollmentRepo := data.NewEnrollmentRepo(dataData, logger)
+	courseUsecase := biz.NewCourseUsecase(courseRepo, enrollmentRepo, academicTermRepo, logger)
+	academicTermUsecase := biz.NewAcademicTermUsecase(academicTermRepo, logger)
+	courseService := service.NewCourseService(courseUsecase, academicTermUsecase, logger)
+	attendanceRepo := data.NewAttendanceRepo(dataData, logger)
+	attendanceUsecase := biz.NewAttendanceUsecase(attendanceRepo, rbacUsecase, logger)
+	attendanceService := service.NewAttendanceService(attendanceUsecase, logger)
+	trustedProxies, err := server.NewTrustedProxies(bootstrap)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(bootstrap, studentService, userService, courseService, attendanceService, rbacUsecase, userUsecase, apiKeyUsecase, impersonationUsecase, jwtUtil, trustedProxies, logger)
+	rbacService := service.NewRBACService(rbacUsecase, recycleBinUsecase, logger)
 	errorRepo := data.NewErrorRepo(dataData, logger)
 	errorUsecase := biz.NewErrorUsecase(errorRepo, logger)
 	errorService := service.NewErrorService(errorUsecase, logger)
-	httpServer := server.NewHTTPServer(bootstrap, studentService, userService, rbacService, errorService, rbacUsecase, jwtUtil, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	oidcService := service.NewOIDCService(oidcUsecase, logger)
+	httpServer := server.NewHTTPServer(bootstrap, studentService, userService, rbacService, errorService, oidcService, courseService, attendanceService, rbacUsecase, userUsecase, apiKeyUsecase, impersonationUsecase, jwtUtil, trustedProxies, logger)
+	recycleBinPurger := server.NewRecycleBinPurger(recycleBin, recycleBinUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, recycleBinPurger)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -32,12 +32,16 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger, discovery *nacos.Disc
 		return nil, nil, err
 	}
 	config := data.NewJWTConfig(bootstrap)
-	jwtUtil := data.NewJWTUtil(config)
+	jwtUtil, err := data.NewJWTUtil(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	userRepo := data.NewUserRepo(dataData, logger, jwtUtil)
 	userUsecase := biz.NewUserUsecase(userRepo, logger)
 	userService := service.NewUserService(userUsecase, logger, jwtUtil)
 	grpcServer := server.NewGRPCServer(bootstrap, userService, logger)
-	httpServer := server.NewHTTPServer(bootstrap, userService, logger)
+	httpServer := server.NewHTTPServer(bootstrap, userService, jwtUtil, logger)
 	app := newApp(logger, grpcServer, httpServer, discovery, bootstrap)
 	return app, func() {
 		cleanup()
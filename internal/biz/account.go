@@ -0,0 +1,352 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"student/internal/conf"
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/mailer"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 账户令牌用途
+const (
+	AccountTokenPasswordReset     = "password_reset"
+	AccountTokenEmailVerification = "email_verification"
+)
+
+// 账户令牌默认有效期
+const (
+	defaultPasswordResetTTL     = 30 * time.Minute
+	defaultEmailVerificationTTL = 24 * time.Hour
+)
+
+// AccountToken 一次性账户令牌绑定的信息
+type AccountToken struct {
+	UserID uint
+	// 签发时的邮箱，邮箱变更后令牌失效
+	Email string
+}
+
+// AccountMessage 账户操作消息
+type AccountMessage struct {
+	Success bool
+	Message string
+}
+
+// ProfileForm 用户可自行修改的资料
+type ProfileForm struct {
+	Email  string
+	Phone  string
+	Avatar string
+	Age    int
+}
+
+// UpdateMeMessage 更新资料消息
+type UpdateMeMessage struct {
+	User    *User
+	Message string
+	Success bool
+}
+
+// 定义 AccountToken 的操作接口
+type AccountTokenRepo interface {
+	// 保存令牌，同一用户同一用途只保留最新的令牌
+	CreateToken(ctx context.Context, purpose, tokenHash string, token *AccountToken, ttl time.Duration) error
+	// 使用并删除令牌，令牌不存在时返回 nil
+	ConsumeToken(ctx context.Context, purpose, tokenHash string) (*AccountToken, error)
+}
+
+// AccountUsecase 负责用户自助管理账户，包括修改资料、修改密码、密码找回和邮箱验证
+type AccountUsecase struct {
+	repo   UserRepo
+	tokens AccountTokenRepo
+	userUC *UserUsecase
+	mailer mailer.Mailer
+	conf   *conf.Account
+	log    *log.Helper
+	now    func() time.Time
+}
+
+// 初始化 AccountUsecase
+func NewAccountUsecase(repo UserRepo, tokens AccountTokenRepo, userUC *UserUsecase, m mailer.Mailer, c *conf.Account, logger log.Logger) *AccountUsecase {
+	if c == nil {
+		c = &conf.Account{}
+	}
+	return &AccountUsecase{
+		repo:   repo,
+		tokens: tokens,
+		userUC: userUC,
+		mailer: m,
+		conf:   c,
+		log:    log.NewHelper(logger),
+		now:    time.Now,
+	}
+}
+
+// 用户可以自行更新的资料字段
+var profileUpdateFields = UpdateFields{
+	"email":  "email",
+	"phone":  "phone",
+	"avatar": "avatar",
+	"age":    "age",
+}
+
+// 更新当前用户资料，paths 为 update_mask，为空时更新所有字段
+func (uc *AccountUsecase) UpdateMe(ctx context.Context, userID uint, p *ProfileForm, paths []string) (*UpdateMeMessage, error) {
+	uc.log.Info("update me", userID, paths)
+	columns, err := profileUpdateFields.Resolve(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	p.Email = strings.TrimSpace(p.Email)
+	if hasColumn(columns, "email") && p.Email != "" && p.Email != user.Email {
+		existing, err := uc.repo.GetUserByEmail(ctx, p.Email)
+		if err == nil && existing != nil && existing.ID != user.ID {
+			return &UpdateMeMessage{
+				Message: "邮箱已存在",
+				Success: false,
+			}, nil
+		}
+	}
+	if hasColumn(columns, "age") && p.Age < 0 {
+		return &UpdateMeMessage{
+			Message: "年龄无效",
+			Success: false,
+		}, nil
+	}
+
+	if err := uc.repo.UpdateProfile(ctx, user.ID, p, columns); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateMeMessage{
+		User:    updated,
+		Message: "资料更新成功",
+		Success: true,
+	}, nil
+}
+
+// 修改当前用户密码，成功后撤销除当前会话以外的全部会话
+func (uc *AccountUsecase) ChangePassword(ctx context.Context, claims *jwt.Claims, currentPassword, newPassword string) (*AccountMessage, error) {
+	uc.log.Info("change password", claims.UserID)
+
+	user, err := uc.repo.GetUser(ctx, int32(claims.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	passwords := uc.userUC.passwords
+	if !passwords.Check(currentPassword, user.Password) {
+		return &AccountMessage{Success: false, Message: "当前密码错误"}, nil
+	}
+	if currentPassword == newPassword {
+		return &AccountMessage{Success: false, Message: "新密码不能与当前密码相同"}, nil
+	}
+	if err := passwords.Validate(newPassword, user.Username); err != nil {
+		return &AccountMessage{Success: false, Message: err.Error()}, nil
+	}
+
+	hashed, err := passwords.Hash(newPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdatePassword(ctx, user.ID, hashed); err != nil {
+		return nil, err
+	}
+	if err := uc.userUC.RevokeOtherSessions(ctx, user.ID, claims.SessionID); err != nil {
+		uc.log.Error("修改密码后撤销其他会话失败", err)
+	}
+	return &AccountMessage{Success: true, Message: "密码修改成功"}, nil
+}
+
+// 申请重置密码，无论邮箱是否存在都返回相同结果，避免泄露账户信息
+func (uc *AccountUsecase) RequestPasswordReset(ctx context.Context, email string) (*AccountMessage, error) {
+	uc.log.Info("request password reset", email)
+
+	reply := &AccountMessage{
+		Success: true,
+		Message: "如果该邮箱已注册，重置密码邮件将很快送达",
+	}
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return &AccountMessage{Success: false, Message: "邮箱不能为空"}, nil
+	}
+	user, err := uc.repo.GetUserByEmail(ctx, email)
+	if err != nil || user.Status != 1 {
+		return reply, nil
+	}
+
+	ttl := uc.passwordResetTTL()
+	token, err := uc.createToken(ctx, AccountTokenPasswordReset, user, ttl)
+	if err != nil {
+		uc.log.Error("创建重置密码令牌失败", err)
+		return reply, nil
+	}
+
+	err = uc.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "重置密码",
+		Body: fmt.Sprintf("%s，您好：\n\n请在 %d 分钟内访问以下链接重置密码：\n%s\n\n如果这不是您本人的操作，请忽略此邮件。\n",
+			user.Username, int(ttl.Minutes()), uc.link("/reset-password", token)),
+	})
+	if err != nil {
+		uc.log.Error("发送重置密码邮件失败", err)
+	}
+	return reply, nil
+}
+
+// 使用令牌重置密码，成功后撤销该用户的所有会话
+func (uc *AccountUsecase) ResetPassword(ctx context.Context, token, newPassword string) (*AccountMessage, error) {
+	passwords := uc.userUC.passwords
+	// 先校验密码强度，避免不合格的密码消耗令牌
+	if err := passwords.Validate(newPassword, ""); err != nil {
+		return &AccountMessage{Success: false, Message: err.Error()}, nil
+	}
+
+	user, ok, err := uc.consumeToken(ctx, AccountTokenPasswordReset, token)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &AccountMessage{Success: false, Message: "链接无效或已过期"}, nil
+	}
+	if err := passwords.Validate(newPassword, user.Username); err != nil {
+		return &AccountMessage{Success: false, Message: err.Error()}, nil
+	}
+
+	hashed, err := passwords.Hash(newPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdatePassword(ctx, user.ID, hashed); err != nil {
+		return nil, err
+	}
+	if err := uc.userUC.RevokeAllSessions(ctx, user.ID); err != nil {
+		uc.log.Error("重置密码后撤销会话失败", err)
+	}
+
+	uc.log.Info("password reset", user.ID)
+	return &AccountMessage{Success: true, Message: "密码已重置，请重新登录"}, nil
+}
+
+// 向当前用户的邮箱发送验证邮件
+func (uc *AccountUsecase) SendVerificationEmail(ctx context.Context, userID uint) (*AccountMessage, error) {
+	uc.log.Info("send verification email", userID)
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == "" {
+		return &AccountMessage{Success: false, Message: "未设置邮箱"}, nil
+	}
+	if user.EmailVerifiedAt != nil {
+		return &AccountMessage{Success: false, Message: "邮箱已验证"}, nil
+	}
+
+	ttl := uc.emailVerificationTTL()
+	token, err := uc.createToken(ctx, AccountTokenEmailVerification, user, ttl)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "验证邮箱",
+		Body: fmt.Sprintf("%s，您好：\n\n请在 %d 小时内访问以下链接完成邮箱验证：\n%s\n",
+			user.Username, int(ttl.Hours()), uc.link("/verify-email", token)),
+	})
+	if err != nil {
+		uc.log.Error("发送验证邮件失败", err)
+		return &AccountMessage{Success: false, Message: "邮件发送失败，请稍后重试"}, nil
+	}
+	return &AccountMessage{Success: true, Message: "验证邮件已发送"}, nil
+}
+
+// 使用令牌验证邮箱
+func (uc *AccountUsecase) VerifyEmail(ctx context.Context, token string) (*AccountMessage, error) {
+	user, ok, err := uc.consumeToken(ctx, AccountTokenEmailVerification, token)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &AccountMessage{Success: false, Message: "链接无效或已过期"}, nil
+	}
+
+	if err := uc.repo.MarkEmailVerified(ctx, user.ID, uc.now()); err != nil {
+		return nil, err
+	}
+	uc.log.Info("email verified", user.ID)
+	return &AccountMessage{Success: true, Message: "邮箱验证成功"}, nil
+}
+
+// 生成一次性令牌，只保存哈希
+func (uc *AccountUsecase) createToken(ctx context.Context, purpose string, user *User, ttl time.Duration) (string, error) {
+	token, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	err = uc.tokens.CreateToken(ctx, purpose, jwt.HashRefreshToken(token), &AccountToken{
+		UserID: user.ID,
+		Email:  user.Email,
+	}, ttl)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// 使用令牌并返回对应的用户，令牌无效、用户被禁用或邮箱已变更时返回 false
+func (uc *AccountUsecase) consumeToken(ctx context.Context, purpose, token string) (*User, bool, error) {
+	if token == "" {
+		return nil, false, nil
+	}
+	record, err := uc.tokens.ConsumeToken(ctx, purpose, jwt.HashRefreshToken(token))
+	if err != nil {
+		return nil, false, err
+	}
+	if record == nil {
+		return nil, false, nil
+	}
+
+	user, err := uc.repo.GetUser(ctx, int32(record.UserID))
+	if err != nil || user.Status != 1 || user.Email != record.Email {
+		return nil, false, nil
+	}
+	return user, true, nil
+}
+
+// 生成前端链接
+func (uc *AccountUsecase) link(path, token string) string {
+	return strings.TrimRight(uc.conf.BaseUrl, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func (uc *AccountUsecase) passwordResetTTL() time.Duration {
+	if d := uc.conf.PasswordResetTtl.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultPasswordResetTTL
+}
+
+func (uc *AccountUsecase) emailVerificationTTL() time.Duration {
+	if d := uc.conf.EmailVerificationTtl.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultEmailVerificationTTL
+}
@@ -0,0 +1,320 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/mailer"
+	"student/internal/pkg/password"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type fakeAccountUserRepo struct {
+	UserRepo
+	user *User
+	// UpdateUser 收到的参数
+	form    *UserForm
+	columns []string
+}
+
+func (r *fakeAccountUserRepo) GetUser(ctx context.Context, id int32) (*User, error) {
+	u := *r.user
+	return &u, nil
+}
+
+func (r *fakeAccountUserRepo) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	if email != r.user.Email {
+		return nil, gorm.ErrRecordNotFound
+	}
+	u := *r.user
+	return &u, nil
+}
+
+func (r *fakeAccountUserRepo) UpdatePassword(ctx context.Context, id uint, hashedPassword string) error {
+	r.user.Password = hashedPassword
+	return nil
+}
+
+func (r *fakeAccountUserRepo) MarkEmailVerified(ctx context.Context, id uint, verifiedAt time.Time) error {
+	r.user.EmailVerifiedAt = &verifiedAt
+	return nil
+}
+
+func (r *fakeAccountUserRepo) UpdateProfile(ctx context.Context, id uint, p *ProfileForm, columns []string) error {
+	for _, column := range columns {
+		switch column {
+		case "email":
+			if r.user.Email != p.Email {
+				r.user.EmailVerifiedAt = nil
+			}
+			r.user.Email = p.Email
+		case "phone":
+			r.user.Phone = p.Phone
+		case "avatar":
+			r.user.Avatar = p.Avatar
+		case "age":
+			r.user.Age = p.Age
+		}
+	}
+	return nil
+}
+
+type fakeAccountTokenRepo struct {
+	tokens map[string]AccountToken
+}
+
+func (r *fakeAccountTokenRepo) CreateToken(ctx context.Context, purpose, tokenHash string, token *AccountToken, ttl time.Duration) error {
+	r.tokens[purpose+tokenHash] = *token
+	return nil
+}
+
+func (r *fakeAccountTokenRepo) ConsumeToken(ctx context.Context, purpose, tokenHash string) (*AccountToken, error) {
+	token, ok := r.tokens[purpose+tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.tokens, purpose+tokenHash)
+	return &token, nil
+}
+
+type fakeRevokeTokenRepo struct {
+	TokenRepo
+	revoked []uint
+	// 保留的令牌族
+	kept []string
+}
+
+func (r *fakeRevokeTokenRepo) RevokeOtherTokenFamilies(ctx context.Context, userID uint, keepFamilyID string) error {
+	r.kept = append(r.kept, keepFamilyID)
+	return nil
+}
+
+func (r *fakeRevokeTokenRepo) RevokeUserTokens(ctx context.Context, userID uint, ttl time.Duration) error {
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+// 从邮件正文中取出令牌
+func tokenFromMail(t *testing.T, m *mailer.MemoryMailer) string {
+	t.Helper()
+	messages := m.Messages()
+	if len(messages) == 0 {
+		t.Fatal("没有发送邮件")
+	}
+	body := messages[len(messages)-1].Body
+	i := strings.Index(body, "?token=")
+	if i < 0 {
+		t.Fatalf("邮件中没有令牌: %s", body)
+	}
+	token, _ := url.QueryUnescape(strings.Fields(body[i+len("?token="):])[0])
+	return token
+}
+
+func newTestAccountUsecase(t *testing.T) (*AccountUsecase, *fakeAccountUserRepo, *fakeRevokeTokenRepo, *mailer.MemoryMailer) {
+	t.Helper()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &fakeAccountUserRepo{user: &User{ID: 1, Username: "testuser", Email: "test@example.com", Status: 1}}
+	tokenRepo := &fakeRevokeTokenRepo{}
+	passwords, err := password.NewManager(&password.Config{BcryptCost: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userUC := NewUserUsecase(users, tokenRepo, nil, newFakeSessionRepo(), nil, nil, jwtUtil, passwords, log.DefaultLogger)
+	m := mailer.NewMemoryMailer()
+	uc := NewAccountUsecase(users, &fakeAccountTokenRepo{tokens: map[string]AccountToken{}}, userUC, m, nil, log.DefaultLogger)
+	return uc, users, tokenRepo, m
+}
+
+func TestAccountUsecase_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+	uc, users, tokenRepo, m := newTestAccountUsecase(t)
+
+	// 未注册的邮箱同样返回成功，但不发送邮件
+	result, err := uc.RequestPasswordReset(ctx, "unknown@example.com")
+	if err != nil || !result.Success || len(m.Messages()) != 0 {
+		t.Fatalf("RequestPasswordReset(unknown) = %v, %v", result, err)
+	}
+
+	if _, err := uc.RequestPasswordReset(ctx, "test@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	token := tokenFromMail(t, m)
+
+	tests := []struct {
+		name     string
+		token    string
+		password string
+		want     bool
+	}{
+		{name: "弱密码不消耗令牌", token: token, password: "123456", want: false},
+		{name: "错误令牌", token: "invalid", password: "newpassword", want: false},
+		{name: "重置成功", token: token, password: "newpassword", want: true},
+		{name: "令牌只能使用一次", token: token, password: "anotherpassword", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.ResetPassword(ctx, tt.token, tt.password)
+			if err != nil {
+				t.Fatalf("ResetPassword() error = %v", err)
+			}
+			if result.Success != tt.want {
+				t.Errorf("ResetPassword() = %v, want %v", result.Message, tt.want)
+			}
+		})
+	}
+
+	if !(&UserForm{Password: "newpassword"}).CheckPassword(users.user.Password) {
+		t.Error("密码未更新")
+	}
+	if len(tokenRepo.revoked) != 1 {
+		t.Errorf("重置密码后应撤销会话, revoked = %v", tokenRepo.revoked)
+	}
+}
+
+func TestAccountUsecase_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	uc, users, _, m := newTestAccountUsecase(t)
+
+	result, err := uc.SendVerificationEmail(ctx, 1)
+	if err != nil || !result.Success {
+		t.Fatalf("SendVerificationEmail() = %v, %v", result, err)
+	}
+	token := tokenFromMail(t, m)
+
+	// 邮箱变更后旧令牌失效
+	users.user.Email = "new@example.com"
+	if result, _ := uc.VerifyEmail(ctx, token); result.Success {
+		t.Error("邮箱变更后令牌应失效")
+	}
+
+	if _, err := uc.SendVerificationEmail(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	result, err = uc.VerifyEmail(ctx, tokenFromMail(t, m))
+	if err != nil || !result.Success {
+		t.Fatalf("VerifyEmail() = %v, %v", result, err)
+	}
+	if users.user.EmailVerifiedAt == nil {
+		t.Error("EmailVerifiedAt 未设置")
+	}
+
+	if result, _ := uc.SendVerificationEmail(ctx, 1); result.Success {
+		t.Error("已验证的邮箱不应再次发送")
+	}
+}
+
+func TestUserUsecase_UpgradePasswordHash(t *testing.T) {
+	ctx := context.Background()
+	uc, users, _, _ := newTestAccountUsecase(t)
+	userUC := uc.userUC
+
+	// 旧数据使用较弱的 bcrypt 成本
+	weak, _ := password.HashPasswordWithCost("oldpassword", 4)
+	argon, _ := password.HashArgon2id("oldpassword", password.Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1})
+
+	tests := []struct {
+		name    string
+		hash    string
+		upgrade bool
+	}{
+		{name: "哈希符合当前配置", hash: weak, upgrade: false},
+		{name: "算法与当前配置不同", hash: argon, upgrade: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users.user.Password = tt.hash
+			user := *users.user
+			userUC.upgradePasswordHash(ctx, &user, "oldpassword")
+
+			if upgraded := users.user.Password != tt.hash; upgraded != tt.upgrade {
+				t.Errorf("upgraded = %v, want %v", upgraded, tt.upgrade)
+			}
+			if !password.CheckPassword("oldpassword", users.user.Password) {
+				t.Error("升级后的哈希应能验证原密码")
+			}
+		})
+	}
+}
+
+func TestAccountUsecase_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	uc, users, tokenRepo, _ := newTestAccountUsecase(t)
+	users.user.Password, _ = password.HashPasswordWithCost("oldpassword", 4)
+	claims := &jwt.Claims{UserID: 1, SessionID: "current-session"}
+
+	tests := []struct {
+		name    string
+		current string
+		next    string
+		want    bool
+	}{
+		{name: "当前密码错误", current: "wrongpassword", next: "newpassword", want: false},
+		{name: "新密码与当前密码相同", current: "oldpassword", next: "oldpassword", want: false},
+		{name: "新密码不满足策略", current: "oldpassword", next: "short", want: false},
+		{name: "修改成功", current: "oldpassword", next: "newpassword", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.ChangePassword(ctx, claims, tt.current, tt.next)
+			if err != nil {
+				t.Fatalf("ChangePassword() error = %v", err)
+			}
+			if result.Success != tt.want {
+				t.Errorf("ChangePassword() = %v, want %v", result.Message, tt.want)
+			}
+		})
+	}
+
+	if !password.CheckPassword("newpassword", users.user.Password) {
+		t.Error("密码未更新")
+	}
+	if len(tokenRepo.kept) != 1 || tokenRepo.kept[0] != "current-session" || len(tokenRepo.revoked) != 0 {
+		t.Errorf("应只撤销其他会话, kept = %v, revoked = %v", tokenRepo.kept, tokenRepo.revoked)
+	}
+}
+
+func TestAccountUsecase_UpdateMe(t *testing.T) {
+	ctx := context.Background()
+	uc, users, _, _ := newTestAccountUsecase(t)
+	verifiedAt := time.Now()
+	users.user.EmailVerifiedAt = &verifiedAt
+
+	result, err := uc.UpdateMe(ctx, 1, &ProfileForm{Email: "test@example.com", Phone: "13800138000", Age: 20}, nil)
+	if err != nil || !result.Success {
+		t.Fatalf("UpdateMe() = %v, %v", result, err)
+	}
+	if result.User.Phone != "13800138000" || result.User.EmailVerifiedAt == nil {
+		t.Errorf("邮箱未变更时应保留验证状态: %+v", result.User)
+	}
+
+	// 只更新 update_mask 中的字段，其他字段保持不变
+	result, err = uc.UpdateMe(ctx, 1, &ProfileForm{Email: "new@example.com"}, []string{"email"})
+	if err != nil || !result.Success {
+		t.Fatalf("UpdateMe() = %v, %v", result, err)
+	}
+	if result.User.EmailVerifiedAt != nil {
+		t.Error("邮箱变更后应清除验证状态")
+	}
+	if result.User.Phone != "13800138000" || result.User.Age != 20 {
+		t.Errorf("未列出的字段被修改: %+v", result.User)
+	}
+
+	if result, _ := uc.UpdateMe(ctx, 1, &ProfileForm{Age: -1}, nil); result.Success {
+		t.Error("年龄无效时应返回失败")
+	}
+	if _, err := uc.UpdateMe(ctx, 1, &ProfileForm{}, []string{"password"}); err == nil {
+		t.Error("update_mask 包含不支持的字段时应返回错误")
+	}
+}
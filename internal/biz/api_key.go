@@ -0,0 +1,269 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// API Key 前缀，便于识别和泄露扫描
+	apiKeyPrefix = "sk"
+	// 单个用户最多持有的有效 API Key 数量
+	maxAPIKeysPerUser = 20
+)
+
+// 权限范围格式：资源:操作，资源对应 /v1/ 下的一级路径，操作为 read / write / *
+var apiKeyScopePattern = regexp.MustCompile(`^(\*|[a-z][a-z0-9_-]*):(read|write|\*)$`)
+
+// APIKey API Key 模型，只保存哈希
+type APIKey struct {
+	ID     uint
+	UserID uint   `gorm:"column:user_id"`
+	Name   string `gorm:"column:name"`
+	// 用于查找的公开前缀
+	Prefix  string `gorm:"column:prefix"`
+	KeyHash string `gorm:"column:key_hash" json:"-"`
+	// 权限范围，逗号分隔
+	Scopes     string     `gorm:"column:scopes"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at" json:"expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at" json:"last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+	CreatedAt  *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// ScopeList 返回权限范围列表
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+// APIKeyIdentity API Key 认证后对应的身份
+type APIKeyIdentity struct {
+	KeyID    uint
+	UserID   uint
+	Username string
+	Email    string
+	Scopes   []string
+}
+
+// Allows 检查权限范围是否允许访问该路径和方法，最终权限还需与所属用户的 RBAC 权限取交集
+func (i *APIKeyIdentity) Allows(path, method string) bool {
+	for _, scope := range i.Scopes {
+		if scope == "*" {
+			return true
+		}
+		resource, action, _ := strings.Cut(scope, ":")
+		if resource != "*" && !matchScopeResource(path, resource) {
+			continue
+		}
+		if scopeAllowsMethod(action, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// 资源必须是 /v1/ 下完整的一级路径，students 不匹配 /v1/students-archive
+func matchScopeResource(path, resource string) bool {
+	prefix := "/v1/" + resource
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func scopeAllowsMethod(action, method string) bool {
+	switch action {
+	case "*":
+		return true
+	case "read":
+		return method == "GET" || method == "HEAD"
+	case "write":
+		return method == "POST" || method == "PUT" || method == "PATCH" || method == "DELETE"
+	}
+	return false
+}
+
+// CreateAPIKeyMessage 创建 API Key 消息
+type CreateAPIKeyMessage struct {
+	Success bool
+	Message string
+	APIKey  *APIKey
+	// 明文 Key，只在创建时返回一次
+	Key string
+}
+
+// 定义 APIKey 的操作接口
+type APIKeyRepo interface {
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	ListAPIKeys(ctx context.Context, userID uint) ([]*APIKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	// 撤销用户的 API Key，返回是否存在该 Key
+	RevokeAPIKey(ctx context.Context, userID, id uint, revokedAt time.Time) (bool, error)
+	// 更新最后使用时间
+	TouchAPIKey(ctx context.Context, id uint, usedAt time.Time) error
+}
+
+// APIKeyUsecase API Key 管理和认证
+type APIKeyUsecase struct {
+	repo     APIKeyRepo
+	userRepo UserRepo
+	log      *log.Helper
+	now      func() time.Time
+}
+
+// 初始化 APIKeyUsecase
+func NewAPIKeyUsecase(repo APIKeyRepo, userRepo UserRepo, logger log.Logger) *APIKeyUsecase {
+	return &APIKeyUsecase{
+		repo:     repo,
+		userRepo: userRepo,
+		log:      log.NewHelper(logger),
+		now:      time.Now,
+	}
+}
+
+// 创建 API Key，expiresIn 为 0 表示永不过期
+func (uc *APIKeyUsecase) Create(ctx context.Context, userID uint, name string, scopes []string, expiresIn time.Duration) (*CreateAPIKeyMessage, error) {
+	uc.log.Info("create api key", userID, name)
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return &CreateAPIKeyMessage{Success: false, Message: "名称不能为空"}, nil
+	}
+	if len(scopes) == 0 {
+		return &CreateAPIKeyMessage{Success: false, Message: "至少需要指定一个权限范围"}, nil
+	}
+	for _, scope := range scopes {
+		if scope != "*" && !apiKeyScopePattern.MatchString(scope) {
+			return &CreateAPIKeyMessage{Success: false, Message: "无效的权限范围: " + scope}, nil
+		}
+	}
+	if expiresIn < 0 {
+		return &CreateAPIKeyMessage{Success: false, Message: "过期时间无效"}, nil
+	}
+
+	keys, err := uc.repo.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	active := 0
+	for _, k := range keys {
+		if uc.isActive(k) {
+			active++
+		}
+	}
+	if active >= maxAPIKeysPerUser {
+		return &CreateAPIKeyMessage{Success: false, Message: "API Key 数量已达上限"}, nil
+	}
+
+	prefix, secret, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	raw := apiKeyPrefix + "_" + prefix + "_" + secret
+
+	key := &APIKey{
+		UserID:  userID,
+		Name:    name,
+		Prefix:  prefix,
+		KeyHash: jwt.HashRefreshToken(raw),
+		Scopes:  strings.Join(slices.Compact(slices.Sorted(slices.Values(scopes))), ","),
+	}
+	if expiresIn > 0 {
+		expiresAt := uc.now().Add(expiresIn)
+		key.ExpiresAt = &expiresAt
+	}
+	if err := uc.repo.CreateAPIKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &CreateAPIKeyMessage{
+		Success: true,
+		Message: "API Key 创建成功，请妥善保存，此后将无法再次查看",
+		APIKey:  key,
+		Key:     raw,
+	}, nil
+}
+
+// 获取用户的 API Key 列表
+func (uc *APIKeyUsecase) List(ctx context.Context, userID uint) ([]*APIKey, error) {
+	uc.log.Info("list api keys", userID)
+	return uc.repo.ListAPIKeys(ctx, userID)
+}
+
+// 撤销用户的 API Key
+func (uc *APIKeyUsecase) Revoke(ctx context.Context, userID, id uint) (bool, error) {
+	uc.log.Info("revoke api key", userID, id)
+	return uc.repo.RevokeAPIKey(ctx, userID, id, uc.now())
+}
+
+// AuthenticateAPIKey 校验 API Key，返回所属用户的身份
+func (uc *APIKeyUsecase) AuthenticateAPIKey(ctx context.Context, raw string) (*APIKeyIdentity, bool) {
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return nil, false
+	}
+
+	key, err := uc.repo.GetAPIKeyByPrefix(ctx, parts[1])
+	if err != nil || key == nil {
+		return nil, false
+	}
+	if subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(jwt.HashRefreshToken(raw))) != 1 {
+		return nil, false
+	}
+	if !uc.isActive(key) {
+		return nil, false
+	}
+
+	user, err := uc.userRepo.GetUser(ctx, int32(key.UserID))
+	if err != nil || user.Status != 1 {
+		return nil, false
+	}
+
+	if err := uc.repo.TouchAPIKey(ctx, key.ID, uc.now()); err != nil {
+		uc.log.Error("更新 API Key 使用时间失败", err)
+	}
+	return &APIKeyIdentity{
+		KeyID:    key.ID,
+		UserID:   user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+		Scopes:   key.ScopeList(),
+	}, true
+}
+
+// 未撤销且未过期
+func (uc *APIKeyUsecase) isActive(k *APIKey) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || uc.now().Before(*k.ExpiresAt)
+}
+
+// 生成公开前缀和密钥
+func generateAPIKey() (string, string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(buf), secret, nil
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type fakeAPIKeyRepo struct {
+	keys []*APIKey
+}
+
+func (r *fakeAPIKeyRepo) CreateAPIKey(ctx context.Context, key *APIKey) error {
+	key.ID = uint(len(r.keys) + 1)
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *fakeAPIKeyRepo) ListAPIKeys(ctx context.Context, userID uint) ([]*APIKey, error) {
+	var keys []*APIKey
+	for _, k := range r.keys {
+		if k.UserID == userID {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (r *fakeAPIKeyRepo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error) {
+	for _, k := range r.keys {
+		if k.Prefix == prefix {
+			return k, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeAPIKeyRepo) RevokeAPIKey(ctx context.Context, userID, id uint, revokedAt time.Time) (bool, error) {
+	for _, k := range r.keys {
+		if k.ID == id && k.UserID == userID && k.RevokedAt == nil {
+			k.RevokedAt = &revokedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeAPIKeyRepo) TouchAPIKey(ctx context.Context, id uint, usedAt time.Time) error {
+	return nil
+}
+
+func TestAPIKeyIdentity_Allows(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		path   string
+		method string
+		want   bool
+	}{
+		{name: "只读范围允许GET", scopes: []string{"students:read"}, path: "/v1/students/1", method: "GET", want: true},
+		{name: "只读范围拒绝POST", scopes: []string{"students:read"}, path: "/v1/students", method: "POST", want: false},
+		{name: "写入范围允许DELETE", scopes: []string{"students:write"}, path: "/v1/students/1", method: "DELETE", want: true},
+		{name: "其他资源", scopes: []string{"students:*"}, path: "/v1/users", method: "GET", want: false},
+		{name: "资源完整匹配", scopes: []string{"students:read"}, path: "/v1/students", method: "GET", want: true},
+		{name: "前缀相同的其他资源", scopes: []string{"students:read"}, path: "/v1/students-archive/1", method: "GET", want: false},
+		{name: "资源名是其他资源的前缀", scopes: []string{"user:read"}, path: "/v1/users", method: "GET", want: false},
+		{name: "任意资源只读", scopes: []string{"*:read"}, path: "/v1/users", method: "GET", want: true},
+		{name: "不限制", scopes: []string{"*"}, path: "/v1/account/api-keys", method: "POST", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &APIKeyIdentity{Scopes: tt.scopes}
+			if got := identity.Allows(tt.path, tt.method); got != tt.want {
+				t.Errorf("Allows(%s %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyUsecase_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 7, 17, 18, 18, 43, 0, time.UTC)
+	users := &fakeAccountUserRepo{user: &User{ID: 1, Username: "batch", Status: 1}}
+	uc := NewAPIKeyUsecase(&fakeAPIKeyRepo{}, users, log.DefaultLogger)
+	uc.now = func() time.Time { return now }
+
+	if result, _ := uc.Create(ctx, 1, "batch", []string{"students"}, 0); result.Success {
+		t.Error("无效的权限范围应创建失败")
+	}
+
+	result, err := uc.Create(ctx, 1, "batch", []string{"students:read", "students:read"}, time.Hour)
+	if err != nil || !result.Success {
+		t.Fatalf("Create() = %v, %v", result, err)
+	}
+	if !strings.HasPrefix(result.Key, "sk_"+result.APIKey.Prefix+"_") || result.APIKey.Scopes != "students:read" {
+		t.Fatalf("unexpected key: %s, scopes: %s", result.Key, result.APIKey.Scopes)
+	}
+
+	identity, ok := uc.AuthenticateAPIKey(ctx, result.Key)
+	if !ok || identity.UserID != 1 || identity.Username != "batch" {
+		t.Fatalf("AuthenticateAPIKey() = %v, %v", identity, ok)
+	}
+	if _, ok := uc.AuthenticateAPIKey(ctx, result.Key+"x"); ok {
+		t.Error("错误的密钥应认证失败")
+	}
+
+	// 过期后失效
+	uc.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if _, ok := uc.AuthenticateAPIKey(ctx, result.Key); ok {
+		t.Error("过期的 Key 应认证失败")
+	}
+	uc.now = func() time.Time { return now }
+
+	// 撤销后失效
+	if revoked, _ := uc.Revoke(ctx, 2, result.APIKey.ID); revoked {
+		t.Error("不能撤销其他用户的 Key")
+	}
+	if revoked, _ := uc.Revoke(ctx, 1, result.APIKey.ID); !revoked {
+		t.Error("Revoke() = false")
+	}
+	if _, ok := uc.AuthenticateAPIKey(ctx, result.Key); ok {
+		t.Error("已撤销的 Key 应认证失败")
+	}
+
+	// 所属用户被禁用后失效
+	result, _ = uc.Create(ctx, 1, "batch", []string{"*"}, 0)
+	users.user.Status = 0
+	if _, ok := uc.AuthenticateAPIKey(ctx, result.Key); ok {
+		t.Error("禁用用户的 Key 应认证失败")
+	}
+}
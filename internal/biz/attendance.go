@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// 拥有该资源 GET 权限的用户可以查看所有教师的考勤，否则只能查看自己点名的考勤
+	// 不使用路径形式，避免被 /v1/attendance/* 这类按前缀授权的权限覆盖
+	AttendanceAllResource = "attendance:all"
+
+	// DateFormat 日期格式
+	DateFormat = "2006-01-02"
+
+	// 考勤报表的最大日期跨度
+	maxAttendanceReportDays = 366
+)
+
+// 出勤状态
+const (
+	AttendancePresent = 1
+	AttendanceAbsent  = 2
+	AttendanceLate    = 3
+	// 请假，不计入缺勤率的分母
+	AttendanceExcused = 4
+)
+
+// 错误原因，与错误码 2015 对应
+const ReasonAttendanceNotOwner = "ATTENDANCE_NOT_OWNER"
+
+// 考勤已由其他教师提交
+func ErrorAttendanceNotOwner() error {
+	return errors.Forbidden(ReasonAttendanceNotOwner, "该班级当天的考勤已由其他教师提交")
+}
+
+// AttendanceSession 一次点名，同一日期和分组只有一次点名
+type AttendanceSession struct {
+	ID   uint
+	Date time.Time `gorm:"column:date;type:date"`
+	// 分组名称，如班级或课程名称
+	GroupLabel string `gorm:"column:group_label"`
+	// 点名教师的用户ID
+	TeacherID uint       `gorm:"column:teacher_id"`
+	CreatedAt *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time `gorm:"column:updated_at" json:"updated_at"`
+
+	Records []*AttendanceRecord `gorm:"foreignKey:SessionID" json:"records,omitempty"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (AttendanceSession) TableName() string {
+	return "attendance_sessions"
+}
+
+// FormatTimeFields 格式化时间字段
+func (s *AttendanceSession) FormatTimeFields() {
+	s.CreatedAtStr, s.UpdatedAtStr = formatTimes(s.CreatedAt, s.UpdatedAt)
+}
+
+// AttendanceRecord 学生在一次点名中的出勤记录
+type AttendanceRecord struct {
+	ID        uint
+	SessionID uint `gorm:"column:session_id"`
+	StudentID uint `gorm:"column:student_id"`
+	Status    int
+	Note      string
+}
+
+// TableName 指定表名
+func (AttendanceRecord) TableName() string {
+	return "attendance_records"
+}
+
+type RollCallForm struct {
+	Date       time.Time
+	GroupLabel string
+	TeacherID  uint
+	Records    []*AttendanceRecord
+}
+
+// AttendanceFilter 考勤查询条件，TeacherID 为 0 时不按教师筛选
+type AttendanceFilter struct {
+	From       time.Time
+	To         time.Time
+	GroupLabel string
+	TeacherID  uint
+}
+
+// AbsenceRate 学生在一段时间内的出勤统计
+type AbsenceRate struct {
+	StudentID   uint
+	StudentName string
+	Total       int
+	Absent      int
+	Late        int
+	Excused     int
+}
+
+// Rate 缺勤率，请假不计入分母
+func (r *AbsenceRate) Rate() float64 {
+	counted := r.Total - r.Excused
+	if counted <= 0 {
+		return 0
+	}
+	return float64(r.Absent) / float64(counted)
+}
+
+// 定义 Attendance 的操作接口
+type AttendanceRepo interface {
+	// 在一个事务中写入整次点名，重复提交时替换原有记录，其他教师已提交时返回 ErrorAttendanceNotOwner
+	SubmitRollCall(ctx context.Context, f *RollCallForm) (*AttendanceSession, error)
+	// 获取点名及其出勤记录
+	GetSession(ctx context.Context, id uint) (*AttendanceSession, error)
+	ListSessions(ctx context.Context, page, pageSize int32, f *AttendanceFilter) ([]*AttendanceSession, int32, error)
+	// 按学生统计出勤状态
+	ListAbsenceRates(ctx context.Context, f *AttendanceFilter) ([]*AbsenceRate, error)
+}
+
+type AttendanceUsecase struct {
+	repo   AttendanceRepo
+	rbacUC *RBACUsecase
+	log    *log.Helper
+}
+
+// 初始化 AttendanceUsecase
+func NewAttendanceUsecase(repo AttendanceRepo, rbacUC *RBACUsecase, logger log.Logger) *AttendanceUsecase {
+	return &AttendanceUsecase{
+		repo:   repo,
+		rbacUC: rbacUC,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// 提交点名，整个班级的出勤记录一次写入
+func (uc *AttendanceUsecase) SubmitRollCall(ctx context.Context, f *RollCallForm) (*AttendanceSession, error) {
+	uc.log.Info("submit roll call", f.TeacherID, f.GroupLabel, len(f.Records))
+	if err := validateRollCall(f); err != nil {
+		return nil, err
+	}
+	return uc.repo.SubmitRollCall(ctx, f)
+}
+
+// 获取点名详情，没有查看全部考勤权限时只能查看自己的点名
+func (uc *AttendanceUsecase) GetSession(ctx context.Context, viewerID, id uint) (*AttendanceSession, error) {
+	session, err := uc.repo.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	all, err := uc.canViewAll(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !all && session.TeacherID != viewerID {
+		return nil, errors.NotFound("NOT_FOUND", "点名记录不存在")
+	}
+	return session, nil
+}
+
+// 获取点名列表
+func (uc *AttendanceUsecase) ListSessions(ctx context.Context, viewerID uint, page, pageSize int32, f *AttendanceFilter) ([]*AttendanceSession, int32, error) {
+	if err := uc.scopeFilter(ctx, viewerID, f); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListSessions(ctx, page, pageSize, f)
+}
+
+// 统计学生在日期范围内的缺勤率
+func (uc *AttendanceUsecase) ListAbsenceRates(ctx context.Context, viewerID uint, f *AttendanceFilter) ([]*AbsenceRate, error) {
+	if f.From.IsZero() || f.To.IsZero() {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "开始和结束日期不能为空")
+	}
+	if err := uc.scopeFilter(ctx, viewerID, f); err != nil {
+		return nil, err
+	}
+	if f.To.Sub(f.From) > maxAttendanceReportDays*24*time.Hour {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "日期范围不能超过一年")
+	}
+	return uc.repo.ListAbsenceRates(ctx, f)
+}
+
+// 校验日期范围，并将没有查看全部考勤权限的用户限制为只查看自己的点名
+func (uc *AttendanceUsecase) scopeFilter(ctx context.Context, viewerID uint, f *AttendanceFilter) error {
+	f.GroupLabel = strings.TrimSpace(f.GroupLabel)
+	if !f.From.IsZero() && !f.To.IsZero() && f.To.Before(f.From) {
+		return errors.BadRequest("INVALID_ARGUMENT", "结束日期不能早于开始日期")
+	}
+	all, err := uc.canViewAll(ctx, viewerID)
+	if err != nil {
+		return err
+	}
+	if !all {
+		f.TeacherID = viewerID
+	}
+	return nil
+}
+
+func (uc *AttendanceUsecase) canViewAll(ctx context.Context, viewerID uint) (bool, error) {
+	if viewerID == 0 {
+		return false, errors.Unauthorized("UNAUTHORIZED", "未找到用户信息")
+	}
+	return uc.rbacUC.CheckPermission(ctx, strconv.Itoa(int(viewerID)), AttendanceAllResource, "GET")
+}
+
+func validateRollCall(f *RollCallForm) error {
+	f.GroupLabel = strings.TrimSpace(f.GroupLabel)
+	if f.TeacherID == 0 {
+		return errors.Unauthorized("UNAUTHORIZED", "未找到用户信息")
+	}
+	if f.Date.IsZero() || f.GroupLabel == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "日期和分组不能为空")
+	}
+	if len(f.Records) == 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "点名名单不能为空")
+	}
+	seen := make(map[uint]bool, len(f.Records))
+	for _, r := range f.Records {
+		if r.StudentID == 0 {
+			return errors.BadRequest("INVALID_ARGUMENT", "学生不能为空")
+		}
+		if seen[r.StudentID] {
+			return errors.BadRequest("INVALID_ARGUMENT", "点名名单中学生重复: "+strconv.Itoa(int(r.StudentID)))
+		}
+		seen[r.StudentID] = true
+		switch r.Status {
+		case AttendancePresent, AttendanceAbsent, AttendanceLate, AttendanceExcused:
+		default:
+			return errors.BadRequest("INVALID_ARGUMENT", "出勤状态无效")
+		}
+		r.Note = strings.TrimSpace(r.Note)
+	}
+	return nil
+}
+
+// ParseDate 解析 2006-01-02 格式的日期，空字符串返回零值
+func ParseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(DateFormat, s, time.Local)
+	if err != nil {
+		return time.Time{}, errors.BadRequest("INVALID_ARGUMENT", "日期格式应为 "+DateFormat)
+	}
+	return t, nil
+}
@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeAttendanceRepo struct {
+	AttendanceRepo
+	submitted *RollCallForm
+	filter    *AttendanceFilter
+	sessions  map[uint]*AttendanceSession
+}
+
+func (r *fakeAttendanceRepo) SubmitRollCall(ctx context.Context, f *RollCallForm) (*AttendanceSession, error) {
+	r.submitted = f
+	return &AttendanceSession{Date: f.Date, GroupLabel: f.GroupLabel, TeacherID: f.TeacherID, Records: f.Records}, nil
+}
+
+func (r *fakeAttendanceRepo) GetSession(ctx context.Context, id uint) (*AttendanceSession, error) {
+	if s, ok := r.sessions[id]; ok {
+		return s, nil
+	}
+	return nil, errors.NotFound("NOT_FOUND", "点名记录不存在")
+}
+
+func (r *fakeAttendanceRepo) ListAbsenceRates(ctx context.Context, f *AttendanceFilter) ([]*AbsenceRate, error) {
+	r.filter = f
+	return nil, nil
+}
+
+type fakeAttendanceRBACRepo struct {
+	RBACRepo
+	// 可以查看所有考勤的用户
+	viewAll map[string]bool
+}
+
+func (r *fakeAttendanceRBACRepo) GetPermissionsForUser(ctx context.Context, user string) ([][]string, error) {
+	if r.viewAll[user] {
+		return [][]string{{"admin", AttendanceAllResource, "GET"}}, nil
+	}
+	return [][]string{{"teacher", "/v1/attendance/sessions*", "GET"}}, nil
+}
+
+func TestAttendanceUsecase_SubmitRollCall(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+	record := func(studentID uint, status int) *AttendanceRecord {
+		return &AttendanceRecord{StudentID: studentID, Status: status}
+	}
+
+	tests := []struct {
+		name     string
+		form     RollCallForm
+		wantCode int
+	}{
+		{name: "缺少分组", form: RollCallForm{Date: date, TeacherID: 2, Records: []*AttendanceRecord{record(1, AttendancePresent)}}, wantCode: 400},
+		{name: "缺少日期", form: RollCallForm{GroupLabel: "一班", TeacherID: 2, Records: []*AttendanceRecord{record(1, AttendancePresent)}}, wantCode: 400},
+		{name: "名单为空", form: RollCallForm{Date: date, GroupLabel: "一班", TeacherID: 2}, wantCode: 400},
+		{name: "学生重复", form: RollCallForm{Date: date, GroupLabel: "一班", TeacherID: 2, Records: []*AttendanceRecord{record(1, AttendancePresent), record(1, AttendanceAbsent)}}, wantCode: 400},
+		{name: "状态无效", form: RollCallForm{Date: date, GroupLabel: "一班", TeacherID: 2, Records: []*AttendanceRecord{record(1, 9)}}, wantCode: 400},
+		{name: "未登录", form: RollCallForm{Date: date, GroupLabel: "一班", Records: []*AttendanceRecord{record(1, AttendancePresent)}}, wantCode: 401},
+		{name: "提交整个名单", form: RollCallForm{Date: date, GroupLabel: " 一班 ", TeacherID: 2, Records: []*AttendanceRecord{record(1, AttendancePresent), record(2, AttendanceAbsent), record(3, AttendanceExcused)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeAttendanceRepo{}
+			uc := NewAttendanceUsecase(repo, nil, log.DefaultLogger)
+			form := tt.form
+			_, err := uc.SubmitRollCall(ctx, &form)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("SubmitRollCall() error = %v, want code %d", err, tt.wantCode)
+				}
+				if repo.submitted != nil {
+					t.Error("校验失败时不应写入")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SubmitRollCall() error = %v", err)
+			}
+			if repo.submitted.GroupLabel != "一班" || len(repo.submitted.Records) != len(tt.form.Records) {
+				t.Errorf("submitted = %+v", repo.submitted)
+			}
+		})
+	}
+}
+
+func TestAttendanceUsecase_Visibility(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 1, 0)
+	repo := &fakeAttendanceRepo{sessions: map[uint]*AttendanceSession{
+		1: {ID: 1, TeacherID: 2},
+	}}
+	rbacUC := NewRBACUsecase(&fakeAttendanceRBACRepo{viewAll: map[string]bool{"1": true}}, log.DefaultLogger, nil)
+	uc := NewAttendanceUsecase(repo, rbacUC, log.DefaultLogger)
+
+	tests := []struct {
+		name          string
+		viewerID      uint
+		wantTeacherID uint
+		wantSession   bool
+	}{
+		{name: "教师只能查看自己的考勤", viewerID: 2, wantTeacherID: 2, wantSession: true},
+		{name: "其他教师看不到", viewerID: 3, wantTeacherID: 3},
+		{name: "管理员可以按任意教师筛选", viewerID: 1, wantTeacherID: 99, wantSession: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.ListAbsenceRates(ctx, tt.viewerID, &AttendanceFilter{From: from, To: to, TeacherID: 99}); err != nil {
+				t.Fatal(err)
+			}
+			if repo.filter.TeacherID != tt.wantTeacherID {
+				t.Errorf("TeacherID = %d, want %d", repo.filter.TeacherID, tt.wantTeacherID)
+			}
+
+			_, err := uc.GetSession(ctx, tt.viewerID, 1)
+			if tt.wantSession != (err == nil) {
+				t.Errorf("GetSession() error = %v, wantSession %v", err, tt.wantSession)
+			}
+		})
+	}
+}
+
+func TestAbsenceRate_Rate(t *testing.T) {
+	tests := []struct {
+		name string
+		rate AbsenceRate
+		want float64
+	}{
+		{name: "没有点名", rate: AbsenceRate{}, want: 0},
+		{name: "请假不计入分母", rate: AbsenceRate{Total: 5, Absent: 1, Excused: 1}, want: 0.25},
+		{name: "迟到不算缺勤", rate: AbsenceRate{Total: 4, Absent: 2, Late: 2}, want: 0.5},
+		{name: "全部请假", rate: AbsenceRate{Total: 2, Excused: 2}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rate.Rate(); got != tt.want {
+				t.Errorf("Rate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// 审计日志操作类型
+const (
+	AuditActionImpersonationStart   = "impersonation.start"
+	AuditActionImpersonationRequest = "impersonation.request"
+)
+
+// AuditLog 审计日志，只追加不修改
+type AuditLog struct {
+	ID uint
+	// 实际操作人
+	ActorID uint `gorm:"column:actor_id"`
+	// 被操作或被模拟的用户
+	UserID    uint       `gorm:"column:user_id"`
+	SessionID string     `gorm:"column:session_id"`
+	Action    string     `gorm:"column:action"`
+	Method    string     `gorm:"column:method"`
+	Path      string     `gorm:"column:path"`
+	Reason    string     `gorm:"column:reason"`
+	IP        string     `gorm:"column:ip"`
+	UserAgent string     `gorm:"column:user_agent"`
+	CreatedAt *time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// TableName 指定表名
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// 定义 AuditLog 的操作接口
+type AuditLogRepo interface {
+	CreateAuditLog(ctx context.Context, entry *AuditLog) error
+}
@@ -2,6 +2,8 @@ package biz
 
 import (
 	"student/internal/pkg/jwt"
+	"student/internal/pkg/mailer"
+	"student/internal/pkg/password"
 
 	"github.com/google/wire"
 )
@@ -10,7 +12,21 @@ import (
 var ProviderSet = wire.NewSet(
 	NewStudentUsecase,
 	NewUserUsecase,
+	NewAccountUsecase,
+	NewAPIKeyUsecase,
+	NewOIDCUsecase,
+	NewExternalLoginUsecase,
+	NewImpersonationUsecase,
+	NewCourseUsecase,
+	NewAcademicTermUsecase,
+	NewGuardianUsecase,
+	NewGradeUsecase,
+	NewAttendanceUsecase,
+	NewLoginLimiter,
 	NewRBACUsecase,
+	NewRecycleBinUsecase,
 	NewErrorUsecase,
 	jwt.NewJWTUtil,
+	mailer.NewMailer,
+	password.NewManager,
 )
@@ -0,0 +1,362 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 错误原因，与错误码 2009 - 2014 对应
+const (
+	ReasonClassFull        = "CLASS_FULL"
+	ReasonAlreadyEnrolled  = "ALREADY_ENROLLED"
+	ReasonNotEnrolled      = "NOT_ENROLLED"
+	ReasonClassClosed      = "CLASS_CLOSED"
+	ReasonCapacityTooSmall = "CAPACITY_TOO_SMALL"
+	ReasonCourseInUse      = "COURSE_IN_USE"
+)
+
+// 班级状态
+const (
+	ClassStatusClosed = 0
+	// 开放选课
+	ClassStatusOpen = 1
+)
+
+// 选课状态
+const (
+	EnrollmentStatusEnrolled = 1
+	EnrollmentStatusDropped  = 2
+)
+
+// 班级人数已满
+func ErrorClassFull() error {
+	return errors.Conflict(ReasonClassFull, "班级人数已满")
+}
+
+// 学生已选修该班级
+func ErrorAlreadyEnrolled() error {
+	return errors.Conflict(ReasonAlreadyEnrolled, "学生已选修该班级")
+}
+
+// 学生未选修该班级
+func ErrorNotEnrolled() error {
+	return errors.NotFound(ReasonNotEnrolled, "学生未选修该班级")
+}
+
+// 班级未开放选课
+func ErrorClassClosed() error {
+	return errors.BadRequest(ReasonClassClosed, "班级未开放选课")
+}
+
+// 班级容量小于已选人数
+func ErrorCapacityTooSmall() error {
+	return errors.Conflict(ReasonCapacityTooSmall, "班级容量不能小于已选人数")
+}
+
+// 课程或班级仍被引用，不能删除
+func ErrorCourseInUse(message string) error {
+	return errors.Conflict(ReasonCourseInUse, message)
+}
+
+// Course 课程目录中的课程
+type Course struct {
+	ID uint
+	// 课程编号，唯一
+	Code        string
+	Name        string
+	Description string
+	// 学分
+	Credits   float64
+	Status    int
+	CreatedAt *time.Time      `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time      `gorm:"column:updated_at" json:"updated_at"`
+	DeletedAt *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (Course) TableName() string {
+	return "courses"
+}
+
+// FormatTimeFields 格式化时间字段
+func (c *Course) FormatTimeFields() {
+	c.CreatedAtStr, c.UpdatedAtStr = formatTimes(c.CreatedAt, c.UpdatedAt)
+}
+
+// Class 课程在某个学期开设的班级
+type Class struct {
+	ID       uint
+	CourseID uint `gorm:"column:course_id"`
+	// 学期编号，对应 AcademicTerm.Code
+	Term    string
+	Teacher string
+	// 容量，选课人数不能超过容量
+	Capacity int
+	// 当前选课人数，选课和退课时在事务中更新
+	Enrolled  int
+	Status    int
+	CreatedAt *time.Time      `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time      `gorm:"column:updated_at" json:"updated_at"`
+	DeletedAt *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (Class) TableName() string {
+	return "classes"
+}
+
+// FormatTimeFields 格式化时间字段
+func (c *Class) FormatTimeFields() {
+	c.CreatedAtStr, c.UpdatedAtStr = formatTimes(c.CreatedAt, c.UpdatedAt)
+}
+
+// CheckEnroll 检查学生能否选修该班级，existing 为该学生在班级中已有的选课记录
+func (c *Class) CheckEnroll(existing *Enrollment) error {
+	if c.Status != ClassStatusOpen {
+		return ErrorClassClosed()
+	}
+	if existing != nil && existing.Status == EnrollmentStatusEnrolled {
+		return ErrorAlreadyEnrolled()
+	}
+	if c.Enrolled >= c.Capacity {
+		return ErrorClassFull()
+	}
+	return nil
+}
+
+// Enrollment 学生的选课记录，退课后保留记录
+type Enrollment struct {
+	ID         uint
+	ClassID    uint       `gorm:"column:class_id"`
+	StudentID  uint       `gorm:"column:student_id"`
+	Status     int        `gorm:"column:status"`
+	EnrolledAt *time.Time `gorm:"column:enrolled_at" json:"enrolled_at"`
+	DroppedAt  *time.Time `gorm:"column:dropped_at" json:"dropped_at"`
+	CreatedAt  *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Enrollment) TableName() string {
+	return "enrollments"
+}
+
+type CourseForm struct {
+	Code        string
+	Name        string
+	Description string
+	Credits     float64
+	Status      int
+}
+
+type ClassForm struct {
+	CourseID uint
+	Term     string
+	Teacher  string
+	Capacity int
+	Status   int
+}
+
+// 定义 Course 和 Class 的操作接口
+type CourseRepo interface {
+	GetCourse(ctx context.Context, id uint) (*Course, error)
+	CreateCourse(ctx context.Context, c *CourseForm) (*Course, error)
+	UpdateCourse(ctx context.Context, id uint, c *CourseForm) (*Course, error)
+	// 课程下还有班级时不能删除
+	DeleteCourse(ctx context.Context, id uint) error
+	ListCourses(ctx context.Context, page, pageSize int32, name string) ([]*Course, int32, error)
+
+	GetClass(ctx context.Context, id uint) (*Class, error)
+	CreateClass(ctx context.Context, c *ClassForm) (*Class, error)
+	// 容量不能小于当前选课人数
+	UpdateClass(ctx context.Context, id uint, c *ClassForm) (*Class, error)
+	// 班级还有选课学生时不能删除
+	DeleteClass(ctx context.Context, id uint) error
+	ListClasses(ctx context.Context, page, pageSize int32, courseID uint, term string) ([]*Class, int32, error)
+}
+
+// 定义 Enrollment 的操作接口
+type EnrollmentRepo interface {
+	// 在事务中锁定班级，检查容量和重复选课后写入选课记录
+	EnrollStudent(ctx context.Context, classID, studentID uint) (*Enrollment, error)
+	// 在事务中将选课记录标记为退课，并释放名额
+	DropStudent(ctx context.Context, classID, studentID uint) (*Enrollment, error)
+	ListEnrollments(ctx context.Context, classID uint) ([]*Enrollment, error)
+}
+
+type CourseUsecase struct {
+	repo        CourseRepo
+	enrollments EnrollmentRepo
+	terms       AcademicTermRepo
+	log         *log.Helper
+}
+
+// 初始化 CourseUsecase
+func NewCourseUsecase(repo CourseRepo, enrollments EnrollmentRepo, terms AcademicTermRepo, logger log.Logger) *CourseUsecase {
+	return &CourseUsecase{
+		repo:        repo,
+		enrollments: enrollments,
+		terms:       terms,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// 通过 id 获取课程
+func (uc *CourseUsecase) GetCourse(ctx context.Context, id uint) (*Course, error) {
+	return uc.repo.GetCourse(ctx, id)
+}
+
+// 创建课程
+func (uc *CourseUsecase) CreateCourse(ctx context.Context, c *CourseForm) (*Course, error) {
+	uc.log.Info("create course", c.Code, c.Name)
+	if err := validateCourse(c); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateCourse(ctx, c)
+}
+
+// 更新课程
+func (uc *CourseUsecase) UpdateCourse(ctx context.Context, id uint, c *CourseForm) (*Course, error) {
+	uc.log.Info("update course", id)
+	if err := validateCourse(c); err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateCourse(ctx, id, c)
+}
+
+// 删除课程
+func (uc *CourseUsecase) DeleteCourse(ctx context.Context, id uint) error {
+	uc.log.Info("delete course", id)
+	return uc.repo.DeleteCourse(ctx, id)
+}
+
+// 获取课程列表
+func (uc *CourseUsecase) ListCourses(ctx context.Context, page, pageSize int32, name string) ([]*Course, int32, error) {
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListCourses(ctx, page, pageSize, name)
+}
+
+// 通过 id 获取班级
+func (uc *CourseUsecase) GetClass(ctx context.Context, id uint) (*Class, error) {
+	return uc.repo.GetClass(ctx, id)
+}
+
+// 开设班级
+func (uc *CourseUsecase) CreateClass(ctx context.Context, c *ClassForm) (*Class, error) {
+	uc.log.Info("create class", c.CourseID, c.Term)
+	if c.CourseID == 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "课程不能为空")
+	}
+	if err := validateClass(c); err != nil {
+		return nil, err
+	}
+	if _, err := uc.terms.GetTermByCode(ctx, c.Term); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetCourse(ctx, c.CourseID); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateClass(ctx, c)
+}
+
+// 更新班级，不能修改所属课程
+func (uc *CourseUsecase) UpdateClass(ctx context.Context, id uint, c *ClassForm) (*Class, error) {
+	uc.log.Info("update class", id)
+	if err := validateClass(c); err != nil {
+		return nil, err
+	}
+	if _, err := uc.terms.GetTermByCode(ctx, c.Term); err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateClass(ctx, id, c)
+}
+
+// 删除班级
+func (uc *CourseUsecase) DeleteClass(ctx context.Context, id uint) error {
+	uc.log.Info("delete class", id)
+	return uc.repo.DeleteClass(ctx, id)
+}
+
+// 获取班级列表，可按课程和学期筛选
+func (uc *CourseUsecase) ListClasses(ctx context.Context, page, pageSize int32, courseID uint, term string) ([]*Class, int32, error) {
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListClasses(ctx, page, pageSize, courseID, strings.TrimSpace(term))
+}
+
+// 学生选课
+func (uc *CourseUsecase) EnrollStudent(ctx context.Context, classID, studentID uint) (*Enrollment, error) {
+	uc.log.Info("enroll student", classID, studentID)
+	if classID == 0 || studentID == 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "班级和学生不能为空")
+	}
+	return uc.enrollments.EnrollStudent(ctx, classID, studentID)
+}
+
+// 学生退课
+func (uc *CourseUsecase) DropStudent(ctx context.Context, classID, studentID uint) (*Enrollment, error) {
+	uc.log.Info("drop student", classID, studentID)
+	return uc.enrollments.DropStudent(ctx, classID, studentID)
+}
+
+// 获取班级的选课记录
+func (uc *CourseUsecase) ListEnrollments(ctx context.Context, classID uint) ([]*Enrollment, error) {
+	return uc.enrollments.ListEnrollments(ctx, classID)
+}
+
+func validateCourse(c *CourseForm) error {
+	c.Code = strings.TrimSpace(c.Code)
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Code == "" || c.Name == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "课程编号和名称不能为空")
+	}
+	if c.Credits < 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "学分不能为负数")
+	}
+	return nil
+}
+
+func validateClass(c *ClassForm) error {
+	c.Term = strings.TrimSpace(c.Term)
+	if c.Term == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "学期不能为空")
+	}
+	if c.Capacity <= 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "班级容量必须大于 0")
+	}
+	if c.Status != ClassStatusOpen && c.Status != ClassStatusClosed {
+		return errors.BadRequest("INVALID_ARGUMENT", "班级状态无效")
+	}
+	return nil
+}
+
+func normalizePage(page, pageSize int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	return page, pageSize
+}
+
+func formatTimes(createdAt, updatedAt *time.Time) (string, string) {
+	var created, updated string
+	if createdAt != nil {
+		created = createdAt.Format(TimeFormat)
+	}
+	if updatedAt != nil {
+		updated = updatedAt.Format(TimeFormat)
+	}
+	return created, updated
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeCourseRepo struct {
+	CourseRepo
+	courses map[uint]*Course
+	created []*ClassForm
+}
+
+func (r *fakeCourseRepo) GetCourse(ctx context.Context, id uint) (*Course, error) {
+	if course, ok := r.courses[id]; ok {
+		return course, nil
+	}
+	return nil, errors.NotFound("NOT_FOUND", "课程不存在")
+}
+
+func (r *fakeCourseRepo) CreateClass(ctx context.Context, c *ClassForm) (*Class, error) {
+	r.created = append(r.created, c)
+	return &Class{CourseID: c.CourseID, Term: c.Term, Capacity: c.Capacity, Status: c.Status}, nil
+}
+
+type fakeAcademicTermRepo struct {
+	AcademicTermRepo
+	terms []*AcademicTerm
+}
+
+func (r *fakeAcademicTermRepo) GetTermByCode(ctx context.Context, code string) (*AcademicTerm, error) {
+	for _, t := range r.terms {
+		if t.Code == code {
+			return t, nil
+		}
+	}
+	return nil, errors.NotFound("NOT_FOUND", "学期不存在")
+}
+
+func (r *fakeAcademicTermRepo) GetTermByDate(ctx context.Context, date time.Time) (*AcademicTerm, error) {
+	for _, t := range r.terms {
+		if t.Contains(date) {
+			return t, nil
+		}
+	}
+	return nil, errors.NotFound("NOT_FOUND", "学期不存在")
+}
+
+func TestClass_CheckEnroll(t *testing.T) {
+	enrolled := &Enrollment{Status: EnrollmentStatusEnrolled}
+	dropped := &Enrollment{Status: EnrollmentStatusDropped}
+
+	tests := []struct {
+		name       string
+		class      Class
+		existing   *Enrollment
+		wantReason string
+	}{
+		{name: "开放且有名额", class: Class{Status: ClassStatusOpen, Capacity: 2, Enrolled: 1}},
+		{name: "退课后重新选课", class: Class{Status: ClassStatusOpen, Capacity: 2, Enrolled: 1}, existing: dropped},
+		{name: "班级未开放", class: Class{Status: ClassStatusClosed, Capacity: 2}, wantReason: ReasonClassClosed},
+		{name: "重复选课", class: Class{Status: ClassStatusOpen, Capacity: 2, Enrolled: 2}, existing: enrolled, wantReason: ReasonAlreadyEnrolled},
+		{name: "班级已满", class: Class{Status: ClassStatusOpen, Capacity: 2, Enrolled: 2}, wantReason: ReasonClassFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.class.CheckEnroll(tt.existing)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("CheckEnroll() error = %v", err)
+				}
+				return
+			}
+			if errors.Reason(err) != tt.wantReason {
+				t.Errorf("CheckEnroll() error = %v, want reason %s", err, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCourseUsecase_CreateClass(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeCourseRepo{courses: map[uint]*Course{1: {ID: 1, Code: "CS101", Name: "程序设计"}}}
+	terms := &fakeAcademicTermRepo{terms: []*AcademicTerm{{ID: 1, Code: "2025-2026-1"}}}
+	uc := NewCourseUsecase(repo, nil, terms, log.DefaultLogger)
+
+	tests := []struct {
+		name     string
+		form     ClassForm
+		wantCode int
+	}{
+		{name: "缺少课程", form: ClassForm{Term: "2025-2026-1", Capacity: 30, Status: ClassStatusOpen}, wantCode: 400},
+		{name: "缺少学期", form: ClassForm{CourseID: 1, Term: " ", Capacity: 30, Status: ClassStatusOpen}, wantCode: 400},
+		{name: "容量无效", form: ClassForm{CourseID: 1, Term: "2025-2026-1", Status: ClassStatusOpen}, wantCode: 400},
+		{name: "状态无效", form: ClassForm{CourseID: 1, Term: "2025-2026-1", Capacity: 30, Status: 9}, wantCode: 400},
+		{name: "学期不存在", form: ClassForm{CourseID: 1, Term: "2030-2031-1", Capacity: 30, Status: ClassStatusOpen}, wantCode: 404},
+		{name: "课程不存在", form: ClassForm{CourseID: 2, Term: "2025-2026-1", Capacity: 30, Status: ClassStatusOpen}, wantCode: 404},
+		{name: "开设班级", form: ClassForm{CourseID: 1, Term: "2025-2026-1", Capacity: 30, Status: ClassStatusOpen}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.created = nil
+			form := tt.form
+			_, err := uc.CreateClass(ctx, &form)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("CreateClass() error = %v, want code %d", err, tt.wantCode)
+				}
+				if len(repo.created) != 0 {
+					t.Errorf("校验失败时不应创建班级")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateClass() error = %v", err)
+			}
+			if len(repo.created) != 1 {
+				t.Errorf("created = %d, want 1", len(repo.created))
+			}
+		})
+	}
+}
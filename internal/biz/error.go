@@ -154,6 +154,20 @@ var PredefinedErrors = map[int32]ErrorInfo{
 		ErrorDescription: "用户名或密码错误",
 		Solution:         "请检查用户名和密码是否正确",
 	},
+	1004: {
+		ErrorCode:        1004,
+		ErrorType:        "AUTH",
+		ErrorMessage:     "Account Locked",
+		ErrorDescription: "登录失败次数过多，账户已被临时锁定",
+		Solution:         "请在 retry_after 秒后重试，或联系管理员解锁",
+	},
+	1007: {
+		ErrorCode:        1007,
+		ErrorType:        "AUTH",
+		ErrorMessage:     "Rate Limit Exceeded",
+		ErrorDescription: "登录尝试过于频繁",
+		Solution:         "请在 retry_after 秒后重试",
+	},
 }
 
 // GetPredefinedError 获取预定义错误信息
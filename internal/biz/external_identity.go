@@ -0,0 +1,369 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"student/internal/conf"
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/oidc"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// 发起外部登录到回调的最长时间
+	externalLoginStateTTL = 10 * time.Minute
+	// 自动创建用户时用户名冲突的最大重试次数
+	externalUsernameAttempts = 5
+)
+
+// 用户名只保留字母、数字和 . _ -
+var externalUsernamePattern = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// ExternalIdentity 外部身份提供方账号与本地用户的关联
+type ExternalIdentity struct {
+	ID       uint
+	UserID   uint   `gorm:"column:user_id"`
+	Provider string `gorm:"column:provider"`
+	// 提供方返回的 sub，在同一提供方内唯一且不会变化
+	Subject     string     `gorm:"column:subject"`
+	Email       string     `gorm:"column:email"`
+	LastLoginAt *time.Time `gorm:"column:last_login_at" json:"last_login_at"`
+	CreatedAt   *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt   *time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ExternalIdentity) TableName() string {
+	return "external_identities"
+}
+
+// ExternalLoginState 发起外部登录时保存的状态，回调时校验
+type ExternalLoginState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// ExternalLoginMessage 发起外部登录消息
+type ExternalLoginMessage struct {
+	Success bool
+	Message string
+	// 提供方的授权地址
+	URL string
+}
+
+// 定义 ExternalIdentity 的操作接口
+type ExternalIdentityRepo interface {
+	// 关联不存在时返回 nil
+	GetIdentity(ctx context.Context, provider, subject string) (*ExternalIdentity, error)
+	CreateIdentity(ctx context.Context, identity *ExternalIdentity) error
+	// 更新最后登录时间和提供方返回的邮箱
+	TouchIdentity(ctx context.Context, id uint, email string, at time.Time) error
+}
+
+// 定义 ExternalLoginState 的操作接口
+type ExternalLoginStateRepo interface {
+	SaveState(ctx context.Context, stateHash string, state *ExternalLoginState, ttl time.Duration) error
+	// 使用并删除状态，状态不存在时返回 nil
+	ConsumeState(ctx context.Context, stateHash string) (*ExternalLoginState, error)
+}
+
+// ExternalLoginUsecase 通过外部 OIDC 身份提供方登录，首次登录时关联或创建本地用户
+type ExternalLoginUsecase struct {
+	identities ExternalIdentityRepo
+	states     ExternalLoginStateRepo
+	repo       UserRepo
+	userUC     *UserUsecase
+	rbacUC     *RBACUsecase
+	providers  map[string]*oidc.Provider
+	settings   map[string]*conf.ExternalProvider
+	log        *log.Helper
+	now        func() time.Time
+}
+
+// 初始化 ExternalLoginUsecase
+func NewExternalLoginUsecase(identities ExternalIdentityRepo, states ExternalLoginStateRepo, repo UserRepo, userUC *UserUsecase, rbacUC *RBACUsecase, configs []*conf.ExternalProvider, logger log.Logger) (*ExternalLoginUsecase, error) {
+	uc := &ExternalLoginUsecase{
+		identities: identities,
+		states:     states,
+		repo:       repo,
+		userUC:     userUC,
+		rbacUC:     rbacUC,
+		providers:  make(map[string]*oidc.Provider, len(configs)),
+		settings:   make(map[string]*conf.ExternalProvider, len(configs)),
+		log:        log.NewHelper(logger),
+		now:        time.Now,
+	}
+	for _, c := range configs {
+		if _, ok := uc.providers[c.Name]; ok {
+			return nil, fmt.Errorf("external provider %q is duplicated", c.Name)
+		}
+		p, err := oidc.NewProvider(&oidc.Config{
+			Name:         c.Name,
+			Issuer:       c.Issuer,
+			ClientID:     c.ClientId,
+			ClientSecret: c.ClientSecret,
+			RedirectURL:  c.RedirectUrl,
+			Scopes:       c.Scopes,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		uc.providers[c.Name] = p
+		uc.settings[c.Name] = c
+	}
+	return uc, nil
+}
+
+// 发起外部登录，返回提供方的授权地址
+func (uc *ExternalLoginUsecase) Start(ctx context.Context, provider string) (*ExternalLoginMessage, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return &ExternalLoginMessage{Success: false, Message: "不支持的登录方式"}, nil
+	}
+
+	state, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(verifier))
+	if err != nil {
+		uc.log.Error("获取外部身份提供方配置失败", "provider", provider, "error", err)
+		return &ExternalLoginMessage{Success: false, Message: "登录服务暂时不可用，请稍后重试"}, nil
+	}
+	err = uc.states.SaveState(ctx, jwt.HashRefreshToken(state), &ExternalLoginState{
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+	}, externalLoginStateTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExternalLoginMessage{
+		Success: true,
+		Message: "请前往身份提供方登录",
+		URL:     authURL,
+	}, nil
+}
+
+// 处理提供方回调，校验通过后创建会话
+func (uc *ExternalLoginUsecase) Callback(ctx context.Context, provider, code, state string, client ClientInfo) (*LoginMessage, error) {
+	invalid := &LoginMessage{
+		Message: "登录请求无效或已过期，请重新登录",
+		Success: false,
+	}
+
+	p, ok := uc.providers[provider]
+	if !ok || code == "" || state == "" {
+		return invalid, nil
+	}
+	saved, err := uc.states.ConsumeState(ctx, jwt.HashRefreshToken(state))
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil || saved.Provider != provider {
+		return invalid, nil
+	}
+
+	claims, err := p.Exchange(ctx, code, saved.CodeVerifier, saved.Nonce)
+	if err != nil {
+		uc.log.Warn("外部身份验证失败", "provider", provider, "error", err)
+		return &LoginMessage{
+			Message: "外部身份验证失败",
+			Success: false,
+		}, nil
+	}
+
+	user, failed, err := uc.resolveUser(ctx, provider, claims)
+	if err != nil || failed != nil {
+		return failed, err
+	}
+	// 与密码登录相同，被锁定的账户和被限流的IP不能通过外部身份登录绕过
+	if err := uc.userUC.limiter.Check(ctx, user.Username, client.IP); err != nil {
+		return nil, err
+	}
+	if user.Status != 1 {
+		return &LoginMessage{
+			Message: "用户已被禁用",
+			Success: false,
+		}, nil
+	}
+
+	uc.log.Info("外部身份登录", "provider", provider, "user_id", user.ID)
+	// 已启用两步验证时仍需本地验证码，外部身份提供方的验证不能代替
+	if user.MFAEnabled {
+		return uc.userUC.createMFAChallenge(ctx, user)
+	}
+	return uc.userUC.startSession(ctx, user, client)
+}
+
+// 查找外部账号关联的用户，未关联时按已验证的邮箱关联已有用户，或自动创建用户
+func (uc *ExternalLoginUsecase) resolveUser(ctx context.Context, provider string, claims *oidc.Claims) (*User, *LoginMessage, error) {
+	identity, err := uc.identities.GetIdentity(ctx, provider, claims.Subject)
+	if err != nil {
+		return nil, nil, err
+	}
+	if identity != nil {
+		if err := uc.identities.TouchIdentity(ctx, identity.ID, claims.Email, uc.now()); err != nil {
+			uc.log.Error("更新外部账号登录时间失败", err)
+		}
+		user, err := uc.repo.GetUser(ctx, int32(identity.UserID))
+		if err != nil {
+			return nil, nil, err
+		}
+		return user, nil, nil
+	}
+
+	// 只有提供方和本系统都确认过的邮箱才能关联已有用户，避免通过伪造邮箱接管账号
+	var user *User
+	if claims.Email != "" {
+		existing, err := uc.repo.GetUserByEmail(ctx, claims.Email)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if existing != nil && (!claims.EmailVerified || existing.EmailVerifiedAt == nil) {
+			return nil, &LoginMessage{
+				Message: "该邮箱已被其他账号使用，请联系管理员关联",
+				Success: false,
+			}, nil
+		}
+		user = existing
+	}
+
+	if user == nil {
+		if !uc.settings[provider].AutoCreate {
+			return nil, &LoginMessage{
+				Message: "该账号尚未关联本系统用户，请联系管理员",
+				Success: false,
+			}, nil
+		}
+		if claims.Email == "" {
+			return nil, &LoginMessage{
+				Message: "身份提供方未返回邮箱，无法创建用户",
+				Success: false,
+			}, nil
+		}
+		user, err = uc.createUser(ctx, provider, claims)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	now := uc.now()
+	err = uc.identities.CreateIdentity(ctx, &ExternalIdentity{
+		UserID:      user.ID,
+		Provider:    provider,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		LastLoginAt: &now,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	uc.log.Info("关联外部账号", "provider", provider, "user_id", user.ID)
+	return user, nil, nil
+}
+
+// 首次登录时创建用户，并分配默认角色
+func (uc *ExternalLoginUsecase) createUser(ctx context.Context, provider string, claims *oidc.Claims) (*User, error) {
+	username, err := uc.availableUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// 外部登录的用户没有本地密码，使用随机密码占位，之后可以通过找回密码设置
+	random, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	hashed, err := uc.userUC.passwords.Hash(random)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.repo.RegisterUser(ctx, &RegisterForm{
+		Username: username,
+		Email:    claims.Email,
+		Password: hashed,
+		Avatar:   claims.Picture,
+	})
+	if err != nil {
+		return nil, err
+	}
+	user := result.User
+
+	if claims.EmailVerified {
+		now := uc.now()
+		if err := uc.repo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+			uc.log.Error("标记邮箱已验证失败", err)
+		} else {
+			user.EmailVerifiedAt = &now
+		}
+	}
+
+	if role := uc.settings[provider].DefaultRole; role != "" {
+		if err := uc.assignRole(ctx, user.ID, role); err != nil {
+			uc.log.Error("分配默认角色失败", "user_id", user.ID, "role", role, "error", err)
+		}
+	}
+
+	uc.log.Info("外部登录自动创建用户", "provider", provider, "user_id", user.ID, "username", username)
+	return user, nil
+}
+
+func (uc *ExternalLoginUsecase) assignRole(ctx context.Context, userID uint, name string) error {
+	role, err := uc.rbacUC.GetRoleByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return uc.rbacUC.AssignUserRole(ctx, int32(userID), int32(role.ID))
+}
+
+// 根据提供方返回的用户名或邮箱生成未被占用的用户名
+func (uc *ExternalLoginUsecase) availableUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = externalUsernamePattern.ReplaceAllString(base, "")
+	if base == "" {
+		base = "user"
+	}
+	base = truncate(base, 40)
+
+	candidate := base
+	for range externalUsernameAttempts {
+		_, err := uc.repo.GetUserByUsername(ctx, candidate)
+		if errors.IsNotFound(err) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		suffix := make([]byte, 3)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", err
+		}
+		candidate = base + "_" + hex.EncodeToString(suffix)
+	}
+	return "", fmt.Errorf("no available username for %q", base)
+}
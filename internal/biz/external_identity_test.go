@@ -0,0 +1,329 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"student/internal/conf"
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/password"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// 本地模拟的外部身份提供方
+type fakeIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	// 授权码对应的 ID Token 内容
+	codes map[string]jwtv5.MapClaims
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{key: key, codes: map[string]jwtv5.MapClaims{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		jwk, _ := jwt.NewJWK("idp-key", "RS256", &key.PublicKey)
+		json.NewEncoder(w).Encode(jwt.JWKS{Keys: []jwt.JWK{*jwk}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, _ := r.BasicAuth()
+		claims, ok := idp.codes[r.FormValue("code")]
+		if !ok || clientID != "student" || secret != "secret" || r.FormValue("code_verifier") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		delete(idp.codes, r.FormValue("code"))
+
+		token := jwtv5.NewWithClaims(jwtv5.SigningMethodRS256, claims)
+		token.Header["kid"] = "idp-key"
+		signed, _ := token.SignedString(key)
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "at", "token_type": "Bearer", "id_token": signed})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+// 模拟用户在提供方登录，返回授权码
+func (idp *fakeIdP) authorize(authURL, sub, email string, emailVerified bool) (code, state string) {
+	u, _ := url.Parse(authURL)
+	query := u.Query()
+	code = "code-" + sub
+	idp.codes[code] = jwtv5.MapClaims{
+		"iss":                idp.server.URL,
+		"aud":                query.Get("client_id"),
+		"sub":                sub,
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"nonce":              query.Get("nonce"),
+		"email":              email,
+		"email_verified":     emailVerified,
+		"preferred_username": "zhang san",
+	}
+	return code, query.Get("state")
+}
+
+type fakeExternalUserRepo struct {
+	UserRepo
+	users map[uint]*User
+}
+
+func (r *fakeExternalUserRepo) GetUser(ctx context.Context, id int32) (*User, error) {
+	if u, ok := r.users[uint(id)]; ok {
+		return u, nil
+	}
+	return nil, errors.NotFound("NOT_FOUND", "not found")
+}
+
+func (r *fakeExternalUserRepo) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, errors.NotFound("NOT_FOUND", "not found")
+}
+
+func (r *fakeExternalUserRepo) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	for _, u := range r.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return nil, errors.NotFound("NOT_FOUND", "not found")
+}
+
+func (r *fakeExternalUserRepo) RegisterUser(ctx context.Context, f *RegisterForm) (*RegisterMessage, error) {
+	u := &User{ID: uint(len(r.users) + 1), Username: f.Username, Email: f.Email, Password: f.Password, Status: 1}
+	r.users[u.ID] = u
+	return &RegisterMessage{User: u, Success: true}, nil
+}
+
+func (r *fakeExternalUserRepo) MarkEmailVerified(ctx context.Context, id uint, verifiedAt time.Time) error {
+	r.users[id].EmailVerifiedAt = &verifiedAt
+	return nil
+}
+
+type fakeExternalIdentityRepo struct {
+	identities []*ExternalIdentity
+}
+
+func (r *fakeExternalIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*ExternalIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeExternalIdentityRepo) CreateIdentity(ctx context.Context, identity *ExternalIdentity) error {
+	identity.ID = uint(len(r.identities) + 1)
+	r.identities = append(r.identities, identity)
+	return nil
+}
+
+func (r *fakeExternalIdentityRepo) TouchIdentity(ctx context.Context, id uint, email string, at time.Time) error {
+	return nil
+}
+
+type fakeExternalLoginStateRepo struct {
+	states map[string]*ExternalLoginState
+}
+
+func (r *fakeExternalLoginStateRepo) SaveState(ctx context.Context, stateHash string, state *ExternalLoginState, ttl time.Duration) error {
+	r.states[stateHash] = state
+	return nil
+}
+
+func (r *fakeExternalLoginStateRepo) ConsumeState(ctx context.Context, stateHash string) (*ExternalLoginState, error) {
+	state := r.states[stateHash]
+	delete(r.states, stateHash)
+	return state, nil
+}
+
+type fakeExternalRBACRepo struct {
+	RBACRepo
+	// 用户ID到角色ID
+	assigned map[int32]int32
+}
+
+func (r *fakeExternalRBACRepo) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	return &Role{ID: 3, Name: name}, nil
+}
+
+func (r *fakeExternalRBACRepo) AssignUserRole(ctx context.Context, userID, roleID int32) error {
+	r.assigned[userID] = roleID
+	return nil
+}
+
+func (r *fakeExternalRBACRepo) GetUserRoleNames(ctx context.Context, userID int32) ([]string, error) {
+	return []string{"user"}, nil
+}
+
+// 按用户名记录账户锁定时间
+type fakeExternalLoginAttemptRepo struct {
+	LoginAttemptRepo
+	locked map[string]time.Duration
+}
+
+func (r *fakeExternalLoginAttemptRepo) GetRestriction(ctx context.Context, username string) (time.Duration, time.Duration, error) {
+	return r.locked[username], 0, nil
+}
+
+func (r *fakeExternalLoginAttemptRepo) GetIPFailures(ctx context.Context, ip string) (int64, time.Duration, error) {
+	return 0, 0, nil
+}
+
+func TestExternalLoginUsecase_Callback(t *testing.T) {
+	ctx := context.Background()
+	idp := newFakeIdP(t)
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour, RefreshExpire: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwords, err := password.NewManager(&password.Config{BcryptCost: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		autoCreate    bool
+		email         string
+		emailVerified bool
+		// 已有用户的邮箱在本系统中已验证
+		localVerified bool
+		// 回调时重复使用同一个 state
+		replay bool
+		// 已有用户启用了两步验证
+		mfaEnabled bool
+		// 已有用户被锁定
+		locked      bool
+		wantSuccess bool
+		wantMFA     bool
+		wantErr     bool
+		// 期望登录的用户ID
+		wantUserID uint
+		wantRole   bool
+	}{
+		{name: "自动创建用户并分配默认角色", autoCreate: true, email: "new@example.com", emailVerified: true, wantSuccess: true, wantUserID: 2, wantRole: true},
+		{name: "按已验证的邮箱关联已有用户", email: "test@example.com", emailVerified: true, localVerified: true, wantSuccess: true, wantUserID: 1},
+		{name: "邮箱未验证不关联已有用户", autoCreate: true, email: "test@example.com", localVerified: true},
+		{name: "已有用户未验证邮箱不关联", autoCreate: true, email: "test@example.com", emailVerified: true},
+		{name: "未开启自动创建", email: "new@example.com", emailVerified: true},
+		{name: "state 不能重复使用", autoCreate: true, email: "new@example.com", emailVerified: true, replay: true},
+		{name: "已启用两步验证时需要验证码", email: "test@example.com", emailVerified: true, localVerified: true, mfaEnabled: true, wantMFA: true},
+		{name: "被锁定的账户不能登录", email: "test@example.com", emailVerified: true, localVerified: true, locked: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeExternalUserRepo{users: map[uint]*User{
+				1: {ID: 1, Username: "zhangsan", Email: "test@example.com", Status: 1},
+			}}
+			if tt.localVerified {
+				verifiedAt := time.Now()
+				users.users[1].EmailVerifiedAt = &verifiedAt
+			}
+			users.users[1].MFAEnabled = tt.mfaEnabled
+			attempts := &fakeExternalLoginAttemptRepo{locked: map[string]time.Duration{}}
+			if tt.locked {
+				attempts.locked["zhangsan"] = time.Minute
+			}
+			limiter := NewLoginLimiter(attempts, nil, log.DefaultLogger)
+			identities := &fakeExternalIdentityRepo{}
+			rbacRepo := &fakeExternalRBACRepo{assigned: map[int32]int32{}}
+			rbacUC := NewRBACUsecase(rbacRepo, log.DefaultLogger, nil)
+			mfa := &fakeMFARepo{challenges: map[string]uint{}}
+			userUC := NewUserUsecase(users, &fakeTokenRepo{}, mfa, newFakeSessionRepo(), limiter, rbacUC, jwtUtil, passwords, log.DefaultLogger)
+			uc, err := NewExternalLoginUsecase(identities, &fakeExternalLoginStateRepo{states: map[string]*ExternalLoginState{}}, users, userUC, rbacUC, []*conf.ExternalProvider{{
+				Name:         "campus",
+				Issuer:       idp.server.URL,
+				ClientId:     "student",
+				ClientSecret: "secret",
+				RedirectUrl:  "http://localhost:3000/login/oauth/campus",
+				AutoCreate:   tt.autoCreate,
+				DefaultRole:  "student",
+			}}, log.DefaultLogger)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start, err := uc.Start(ctx, "campus")
+			if err != nil || !start.Success {
+				t.Fatalf("Start() = %v, %v", start, err)
+			}
+			code, state := idp.authorize(start.URL, "sub-1", tt.email, tt.emailVerified)
+			if tt.replay {
+				if _, err := uc.Callback(ctx, "campus", code, state, ClientInfo{}); err != nil {
+					t.Fatal(err)
+				}
+				code, _ = idp.authorize(start.URL, "sub-1", tt.email, tt.emailVerified)
+			}
+
+			result, err := uc.Callback(ctx, "campus", code, state, ClientInfo{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Callback() = %+v, 应返回错误", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Callback() error = %v", err)
+			}
+			if result.Success != tt.wantSuccess {
+				t.Fatalf("Callback() = %+v, wantSuccess %v", result, tt.wantSuccess)
+			}
+			if result.MFARequired != tt.wantMFA || (tt.wantMFA && (result.MFAToken == "" || result.Token != "" || len(mfa.challenges) != 1)) {
+				t.Errorf("Callback() = %+v, wantMFA %v", result, tt.wantMFA)
+			}
+			if !tt.wantSuccess {
+				return
+			}
+			if result.User.ID != tt.wantUserID || result.Token == "" {
+				t.Errorf("登录用户 = %d, want %d", result.User.ID, tt.wantUserID)
+			}
+			if len(identities.identities) != 1 || identities.identities[0].UserID != tt.wantUserID {
+				t.Errorf("外部账号关联 = %+v", identities.identities)
+			}
+			if _, ok := rbacRepo.assigned[int32(tt.wantUserID)]; ok != tt.wantRole {
+				t.Errorf("分配默认角色 = %v, want %v", ok, tt.wantRole)
+			}
+			if tt.wantRole && users.users[tt.wantUserID].Username == "zhangsan" {
+				t.Error("自动创建的用户名不能与已有用户重复")
+			}
+
+			// 已关联的外部账号再次登录直接使用关联的用户
+			start, _ = uc.Start(ctx, "campus")
+			code, state = idp.authorize(start.URL, "sub-1", tt.email, tt.emailVerified)
+			again, err := uc.Callback(ctx, "campus", code, state, ClientInfo{})
+			if err != nil || !again.Success || again.User.ID != tt.wantUserID || len(identities.identities) != 1 {
+				t.Errorf("再次登录 = %+v, %v", again, err)
+			}
+		})
+	}
+}
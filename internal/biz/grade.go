@@ -0,0 +1,286 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 成绩的分数范围
+const (
+	MinScore = 0
+	MaxScore = 100
+)
+
+// GradeLevel 评分等级
+type GradeLevel struct {
+	Letter   string
+	MinScore float64
+	Points   float64
+}
+
+// 未配置评分等级时使用的五级制
+var defaultGradingScale = []GradeLevel{
+	{Letter: "A", MinScore: 90, Points: 4.0},
+	{Letter: "B", MinScore: 80, Points: 3.0},
+	{Letter: "C", MinScore: 70, Points: 2.0},
+	{Letter: "D", MinScore: 60, Points: 1.0},
+	{Letter: "F", MinScore: 0, Points: 0},
+}
+
+// GradingScale 分数到等级和绩点的换算规则，按最低分数从高到低排列
+type GradingScale struct {
+	levels []GradeLevel
+}
+
+// NewGradingScale 根据配置创建评分等级，最低一级必须覆盖 0 分
+func NewGradingScale(c *conf.Grading) (*GradingScale, error) {
+	levels := defaultGradingScale
+	if c != nil && len(c.Scale) > 0 {
+		levels = make([]GradeLevel, 0, len(c.Scale))
+		for _, l := range c.Scale {
+			levels = append(levels, GradeLevel{Letter: strings.TrimSpace(l.Letter), MinScore: l.MinScore, Points: l.Points})
+		}
+	}
+	levels = slices.Clone(levels)
+	slices.SortFunc(levels, func(a, b GradeLevel) int {
+		switch {
+		case a.MinScore > b.MinScore:
+			return -1
+		case a.MinScore < b.MinScore:
+			return 1
+		}
+		return 0
+	})
+	for i, l := range levels {
+		if l.Letter == "" {
+			return nil, fmt.Errorf("grading scale: level %d has no letter", i)
+		}
+		if i > 0 && l.MinScore == levels[i-1].MinScore {
+			return nil, fmt.Errorf("grading scale: duplicate min_score %v", l.MinScore)
+		}
+		if l.Points < 0 {
+			return nil, fmt.Errorf("grading scale: negative points for %s", l.Letter)
+		}
+	}
+	if levels[len(levels)-1].MinScore > MinScore {
+		return nil, fmt.Errorf("grading scale: lowest level must start at %d", MinScore)
+	}
+	return &GradingScale{levels: levels}, nil
+}
+
+// Grade 返回分数对应的等级和绩点
+func (s *GradingScale) Grade(score float64) (string, float64) {
+	for _, l := range s.levels {
+		if score >= l.MinScore {
+			return l.Letter, l.Points
+		}
+	}
+	last := s.levels[len(s.levels)-1]
+	return last.Letter, last.Points
+}
+
+// Grade 学生某门课程在某个学期的成绩，同一学生、课程和学期只有一条记录
+type Grade struct {
+	ID        uint
+	StudentID uint `gorm:"column:student_id"`
+	CourseID  uint `gorm:"column:course_id"`
+	Term      string
+	Score     float64
+	// 录入时根据评分等级换算的等级和绩点，调整评分等级不影响已录入的成绩
+	Letter    string
+	Points    float64
+	CreatedAt *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time `gorm:"column:updated_at" json:"updated_at"`
+
+	// 成绩对应的课程，课程被删除后仍然保留
+	Course *Course `gorm:"foreignKey:CourseID" json:"course,omitempty"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (Grade) TableName() string {
+	return "grades"
+}
+
+// FormatTimeFields 格式化时间字段
+func (g *Grade) FormatTimeFields() {
+	g.CreatedAtStr, g.UpdatedAtStr = formatTimes(g.CreatedAt, g.UpdatedAt)
+}
+
+// Credits 成绩对应课程的学分
+func (g *Grade) Credits() float64 {
+	if g.Course == nil {
+		return 0
+	}
+	return g.Course.Credits
+}
+
+// Passed 是否取得学分，绩点为 0 视为不及格
+func (g *Grade) Passed() bool {
+	return g.Points > 0
+}
+
+type GradeForm struct {
+	StudentID uint
+	CourseID  uint
+	Term      string
+	Score     float64
+	Letter    string
+	Points    float64
+}
+
+// TermTranscript 单个学期的成绩汇总
+type TermTranscript struct {
+	Term   string
+	Grades []*Grade
+	// 已取得的学分，不含不及格课程
+	Credits float64
+	GPA     float64
+}
+
+// Transcript 学生的成绩单，按学期汇总
+type Transcript struct {
+	Student *Student
+	Terms   []*TermTranscript
+	// 修读的学分，含不及格课程
+	AttemptedCredits float64
+	// 已取得的学分
+	EarnedCredits float64
+	// 按学分加权的平均绩点
+	GPA float64
+}
+
+// ComputeGPA 计算按学分加权的平均绩点，不及格课程计入分母，0 学分的课程不参与计算
+func ComputeGPA(grades []*Grade) (gpa, attempted, earned float64) {
+	var weighted float64
+	for _, g := range grades {
+		credits := g.Credits()
+		if credits <= 0 {
+			continue
+		}
+		attempted += credits
+		weighted += g.Points * credits
+		if g.Passed() {
+			earned += credits
+		}
+	}
+	if attempted == 0 {
+		return 0, 0, 0
+	}
+	return math.Round(weighted/attempted*100) / 100, attempted, earned
+}
+
+// BuildTranscript 将成绩按学期分组并计算每学期和总的绩点，学期按名称升序排列
+func BuildTranscript(student *Student, grades []*Grade) *Transcript {
+	byTerm := make(map[string]*TermTranscript)
+	var terms []*TermTranscript
+	for _, g := range grades {
+		term, ok := byTerm[g.Term]
+		if !ok {
+			term = &TermTranscript{Term: g.Term}
+			byTerm[g.Term] = term
+			terms = append(terms, term)
+		}
+		term.Grades = append(term.Grades, g)
+	}
+	slices.SortFunc(terms, func(a, b *TermTranscript) int {
+		return strings.Compare(a.Term, b.Term)
+	})
+	for _, term := range terms {
+		term.GPA, _, term.Credits = ComputeGPA(term.Grades)
+	}
+
+	transcript := &Transcript{Student: student, Terms: terms}
+	transcript.GPA, transcript.AttemptedCredits, transcript.EarnedCredits = ComputeGPA(grades)
+	return transcript
+}
+
+// 定义 Grade 的操作接口
+type GradeRepo interface {
+	GetGrade(ctx context.Context, id uint) (*Grade, error)
+	// 按学生、课程和学期写入成绩，已存在时更新
+	SaveGrade(ctx context.Context, g *GradeForm) (*Grade, error)
+	DeleteGrade(ctx context.Context, id uint) error
+	ListGrades(ctx context.Context, page, pageSize int32, studentID uint, term string) ([]*Grade, int32, error)
+	// 获取学生的全部成绩，包含课程信息
+	ListStudentGrades(ctx context.Context, studentID uint) ([]*Grade, error)
+}
+
+type GradeUsecase struct {
+	repo     GradeRepo
+	students StudentRepo
+	courses  CourseRepo
+	scale    *GradingScale
+	log      *log.Helper
+}
+
+// 初始化 GradeUsecase
+func NewGradeUsecase(repo GradeRepo, students StudentRepo, courses CourseRepo, c *conf.Grading, logger log.Logger) (*GradeUsecase, error) {
+	scale, err := NewGradingScale(c)
+	if err != nil {
+		return nil, err
+	}
+	return &GradeUsecase{
+		repo:     repo,
+		students: students,
+		courses:  courses,
+		scale:    scale,
+		log:      log.NewHelper(logger),
+	}, nil
+}
+
+// 录入成绩，根据评分等级换算等级和绩点
+func (uc *GradeUsecase) RecordGrade(ctx context.Context, g *GradeForm) (*Grade, error) {
+	uc.log.Info("record grade", g.StudentID, g.CourseID, g.Term)
+	g.Term = strings.TrimSpace(g.Term)
+	if g.StudentID == 0 || g.CourseID == 0 || g.Term == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "学生、课程和学期不能为空")
+	}
+	if g.Score < MinScore || g.Score > MaxScore || math.IsNaN(g.Score) {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", fmt.Sprintf("分数必须在 %d 到 %d 之间", MinScore, MaxScore))
+	}
+	if _, err := uc.students.GetStudent(ctx, int32(g.StudentID)); err != nil {
+		return nil, err
+	}
+	if _, err := uc.courses.GetCourse(ctx, g.CourseID); err != nil {
+		return nil, err
+	}
+	g.Letter, g.Points = uc.scale.Grade(g.Score)
+	return uc.repo.SaveGrade(ctx, g)
+}
+
+// 删除成绩
+func (uc *GradeUsecase) DeleteGrade(ctx context.Context, id uint) error {
+	uc.log.Info("delete grade", id)
+	return uc.repo.DeleteGrade(ctx, id)
+}
+
+// 获取成绩列表，可按学生和学期筛选
+func (uc *GradeUsecase) ListGrades(ctx context.Context, page, pageSize int32, studentID uint, term string) ([]*Grade, int32, error) {
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListGrades(ctx, page, pageSize, studentID, strings.TrimSpace(term))
+}
+
+// 获取学生的成绩单
+func (uc *GradeUsecase) GetTranscript(ctx context.Context, studentID int32) (*Transcript, error) {
+	student, err := uc.students.GetStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	grades, err := uc.repo.ListStudentGrades(ctx, uint(studentID))
+	if err != nil {
+		return nil, err
+	}
+	return BuildTranscript(student, grades), nil
+}
@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"testing"
+
+	"student/internal/conf"
+)
+
+func TestGradingScale_Grade(t *testing.T) {
+	defaultScale, err := NewGradingScale(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 配置顺序不影响换算
+	customScale, err := NewGradingScale(&conf.Grading{Scale: []*conf.Grading_Level{
+		{Letter: "F", MinScore: 0, Points: 0},
+		{Letter: "A", MinScore: 85, Points: 4.0},
+		{Letter: "B+", MinScore: 75, Points: 3.5},
+		{Letter: "C", MinScore: 60, Points: 2.0},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		scale      *GradingScale
+		score      float64
+		wantLetter string
+		wantPoints float64
+	}{
+		{name: "满分", scale: defaultScale, score: 100, wantLetter: "A", wantPoints: 4.0},
+		{name: "等级下限", scale: defaultScale, score: 80, wantLetter: "B", wantPoints: 3.0},
+		{name: "低于下限", scale: defaultScale, score: 59.5, wantLetter: "F", wantPoints: 0},
+		{name: "零分", scale: defaultScale, score: 0, wantLetter: "F", wantPoints: 0},
+		{name: "自定义等级", scale: customScale, score: 80, wantLetter: "B+", wantPoints: 3.5},
+		{name: "自定义等级上限", scale: customScale, score: 85, wantLetter: "A", wantPoints: 4.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			letter, points := tt.scale.Grade(tt.score)
+			if letter != tt.wantLetter || points != tt.wantPoints {
+				t.Errorf("Grade(%v) = %s, %v, want %s, %v", tt.score, letter, points, tt.wantLetter, tt.wantPoints)
+			}
+		})
+	}
+}
+
+func TestNewGradingScale_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		scale []*conf.Grading_Level
+	}{
+		{name: "缺少等级名称", scale: []*conf.Grading_Level{{Letter: "", MinScore: 0}}},
+		{name: "最低分数重复", scale: []*conf.Grading_Level{{Letter: "P", MinScore: 0}, {Letter: "F", MinScore: 0}}},
+		{name: "未覆盖零分", scale: []*conf.Grading_Level{{Letter: "A", MinScore: 90, Points: 4}, {Letter: "B", MinScore: 60, Points: 3}}},
+		{name: "绩点为负数", scale: []*conf.Grading_Level{{Letter: "F", MinScore: 0, Points: -1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewGradingScale(&conf.Grading{Scale: tt.scale}); err == nil {
+				t.Error("NewGradingScale() 应返回错误")
+			}
+		})
+	}
+}
+
+func TestComputeGPA(t *testing.T) {
+	math := &Course{Code: "MATH101", Credits: 4}
+	english := &Course{Code: "ENG101", Credits: 2}
+	seminar := &Course{Code: "SEM001", Credits: 0}
+
+	tests := []struct {
+		name          string
+		grades        []*Grade
+		wantGPA       float64
+		wantAttempted float64
+		wantEarned    float64
+	}{
+		{name: "没有成绩", grades: nil},
+		{
+			name:          "按学分加权",
+			grades:        []*Grade{{Course: math, Points: 4.0}, {Course: english, Points: 3.0}},
+			wantGPA:       3.67,
+			wantAttempted: 6,
+			wantEarned:    6,
+		},
+		{
+			name:          "不及格计入修读学分",
+			grades:        []*Grade{{Course: math, Points: 0}, {Course: english, Points: 4.0}},
+			wantGPA:       1.33,
+			wantAttempted: 6,
+			wantEarned:    2,
+		},
+		{
+			name:          "零学分课程不参与计算",
+			grades:        []*Grade{{Course: english, Points: 2.0}, {Course: seminar, Points: 4.0}},
+			wantGPA:       2.0,
+			wantAttempted: 2,
+			wantEarned:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gpa, attempted, earned := ComputeGPA(tt.grades)
+			if gpa != tt.wantGPA || attempted != tt.wantAttempted || earned != tt.wantEarned {
+				t.Errorf("ComputeGPA() = %v, %v, %v, want %v, %v, %v", gpa, attempted, earned, tt.wantGPA, tt.wantAttempted, tt.wantEarned)
+			}
+		})
+	}
+}
+
+func TestBuildTranscript(t *testing.T) {
+	math := &Course{Code: "MATH101", Credits: 4}
+	english := &Course{Code: "ENG101", Credits: 2}
+	student := &Student{ID: 1, Name: "测试学生"}
+
+	transcript := BuildTranscript(student, []*Grade{
+		{Term: "2025-2026-1", Course: math, Points: 2.0},
+		{Term: "2024-2025-2", Course: english, Points: 4.0},
+		{Term: "2025-2026-1", Course: english, Points: 0},
+	})
+
+	if len(transcript.Terms) != 2 || transcript.Terms[0].Term != "2024-2025-2" || transcript.Terms[1].Term != "2025-2026-1" {
+		t.Fatalf("学期应按名称升序排列: %+v", transcript.Terms)
+	}
+	second := transcript.Terms[1]
+	if len(second.Grades) != 2 || second.GPA != 1.33 || second.Credits != 4 {
+		t.Errorf("2025-2026-1 = %+v", second)
+	}
+	if transcript.GPA != 2.0 || transcript.AttemptedCredits != 8 || transcript.EarnedCredits != 6 {
+		t.Errorf("transcript = GPA %v, attempted %v, earned %v", transcript.GPA, transcript.AttemptedCredits, transcript.EarnedCredits)
+	}
+}
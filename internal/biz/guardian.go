@@ -0,0 +1,352 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 监护关系
+const (
+	GuardianRelationshipFather      = "father"
+	GuardianRelationshipMother      = "mother"
+	GuardianRelationshipGrandparent = "grandparent"
+	GuardianRelationshipGuardian    = "guardian"
+	GuardianRelationshipOther       = "other"
+)
+
+// 监护人账号的角色，不授予学生相关权限
+const GuardianRoleName = "guardian"
+
+// 监护人账号访问了不是自己监护的学生
+func ErrorNotGuardianOfStudent() error {
+	return errors.Forbidden("FORBIDDEN", "监护人账号只能查看自己监护的学生")
+}
+
+var guardianRelationships = []string{
+	GuardianRelationshipFather,
+	GuardianRelationshipMother,
+	GuardianRelationshipGrandparent,
+	GuardianRelationshipGuardian,
+	GuardianRelationshipOther,
+}
+
+// Guardian 监护人或紧急联系人，可以关联多个学生
+type Guardian struct {
+	ID    uint
+	Name  string
+	Phone string
+	Email string
+	// 关联的登录账号，为空时监护人不能登录
+	UserID    *uint      `gorm:"column:user_id"`
+	CreatedAt *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time `gorm:"column:updated_at" json:"updated_at"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// FormatTimeFields 格式化时间字段
+func (g *Guardian) FormatTimeFields() {
+	g.CreatedAtStr, g.UpdatedAtStr = formatTimes(g.CreatedAt, g.UpdatedAt)
+}
+
+// StudentGuardian 学生与监护人的关联，每个学生最多一个主要联系人
+type StudentGuardian struct {
+	ID           uint
+	StudentID    uint `gorm:"column:student_id"`
+	GuardianID   uint `gorm:"column:guardian_id"`
+	Relationship string
+	IsPrimary    bool       `gorm:"column:is_primary"`
+	CreatedAt    *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt    *time.Time `gorm:"column:updated_at" json:"updated_at"`
+
+	Guardian *Guardian `gorm:"foreignKey:GuardianID" json:"guardian,omitempty"`
+}
+
+// TableName 指定表名
+func (StudentGuardian) TableName() string {
+	return "student_guardians"
+}
+
+type GuardianForm struct {
+	Name  string
+	Phone string
+	Email string
+}
+
+// StudentGuardianForm 为学生添加或修改监护人，GuardianID 为 0 时按 Guardian 新建监护人
+type StudentGuardianForm struct {
+	GuardianID   uint
+	Guardian     GuardianForm
+	Relationship string
+	IsPrimary    bool
+}
+
+// 定义 Guardian 的操作接口
+type GuardianRepo interface {
+	GetGuardian(ctx context.Context, id uint) (*Guardian, error)
+	GetGuardianByUserID(ctx context.Context, userID uint) (*Guardian, error)
+	// 关联的学生ID
+	ListGuardianStudentIDs(ctx context.Context, guardianID uint) ([]uint, error)
+	// 修改关联的登录账号，userID 为 nil 时取消关联
+	SetGuardianUser(ctx context.Context, id uint, userID *uint) error
+	ListStudentGuardians(ctx context.Context, studentID uint) ([]*StudentGuardian, error)
+	// link.GuardianID 为 0 时先创建监护人，link.IsPrimary 为 true 时取消该学生其他主要联系人
+	AddStudentGuardian(ctx context.Context, link *StudentGuardian, g *GuardianForm) (*StudentGuardian, error)
+	UpdateStudentGuardian(ctx context.Context, link *StudentGuardian, g *GuardianForm) (*StudentGuardian, error)
+	// 删除关联，监护人不再关联任何学生时一并删除
+	RemoveStudentGuardian(ctx context.Context, studentID, guardianID uint) error
+	// 登录账号作为监护人关联的学生
+	ListChildren(ctx context.Context, userID uint) ([]*Student, error)
+}
+
+type GuardianUsecase struct {
+	repo     GuardianRepo
+	students StudentRepo
+	users    UserRepo
+	rbacUC   *RBACUsecase
+	log      *log.Helper
+}
+
+// 初始化 GuardianUsecase
+func NewGuardianUsecase(repo GuardianRepo, students StudentRepo, users UserRepo, rbacUC *RBACUsecase, logger log.Logger) *GuardianUsecase {
+	return &GuardianUsecase{
+		repo:     repo,
+		students: students,
+		users:    users,
+		rbacUC:   rbacUC,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// 监护人账号可以访问的学生资源，只允许查看
+func guardianOwnershipResources(studentID uint) []string {
+	id := strconv.Itoa(int(studentID))
+	return []string{"/v1/student/" + id, "/v1/student/" + id + "/*"}
+}
+
+// 授予登录账号查看学生的权限
+func (uc *GuardianUsecase) grantOwnership(ctx context.Context, userID, studentID uint) error {
+	for _, resource := range guardianOwnershipResources(studentID) {
+		if err := uc.rbacUC.AddPolicy(ctx, strconv.Itoa(int(userID)), resource, "GET"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 撤销登录账号查看学生的权限
+func (uc *GuardianUsecase) revokeOwnership(ctx context.Context, userID, studentID uint) error {
+	for _, resource := range guardianOwnershipResources(studentID) {
+		if err := uc.rbacUC.RemovePolicy(ctx, strconv.Itoa(int(userID)), resource, "GET"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 校验监护关系表单
+func (f *StudentGuardianForm) validate() error {
+	f.Relationship = strings.TrimSpace(f.Relationship)
+	if !slices.Contains(guardianRelationships, f.Relationship) {
+		return errors.BadRequest("INVALID_ARGUMENT", "监护关系只能是 "+strings.Join(guardianRelationships, ", "))
+	}
+	if f.GuardianID != 0 {
+		return nil
+	}
+	f.Guardian.Name = strings.TrimSpace(f.Guardian.Name)
+	f.Guardian.Phone = strings.TrimSpace(f.Guardian.Phone)
+	if f.Guardian.Name == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "监护人姓名不能为空")
+	}
+	if f.Guardian.Phone == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "监护人联系电话不能为空")
+	}
+	return nil
+}
+
+// 获取学生的监护人，主要联系人排在最前
+func (uc *GuardianUsecase) ListStudentGuardians(ctx context.Context, studentID int32) ([]*StudentGuardian, error) {
+	if _, err := uc.students.GetStudent(ctx, studentID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListStudentGuardians(ctx, uint(studentID))
+}
+
+// 为学生添加监护人，可以关联已有监护人或新建监护人
+func (uc *GuardianUsecase) AddStudentGuardian(ctx context.Context, studentID int32, f *StudentGuardianForm) (*StudentGuardian, error) {
+	uc.log.Info("add student guardian", studentID, f.GuardianID)
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	student, err := uc.students.GetStudent(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+	var guardian *Guardian
+	if f.GuardianID != 0 {
+		if guardian, err = uc.repo.GetGuardian(ctx, f.GuardianID); err != nil {
+			return nil, err
+		}
+	}
+
+	link, err := uc.repo.AddStudentGuardian(ctx, &StudentGuardian{
+		StudentID:    student.ID,
+		GuardianID:   f.GuardianID,
+		Relationship: f.Relationship,
+		IsPrimary:    f.IsPrimary,
+	}, &f.Guardian)
+	if err != nil {
+		return nil, err
+	}
+	if guardian != nil && guardian.UserID != nil {
+		if err := uc.grantOwnership(ctx, *guardian.UserID, student.ID); err != nil {
+			return nil, err
+		}
+	}
+	return link, nil
+}
+
+// 修改监护人信息和监护关系
+func (uc *GuardianUsecase) UpdateStudentGuardian(ctx context.Context, studentID int32, guardianID uint, f *StudentGuardianForm) (*StudentGuardian, error) {
+	// 修改时不会切换到其他监护人，监护人信息按表单校验
+	f.GuardianID = 0
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateStudentGuardian(ctx, &StudentGuardian{
+		StudentID:    uint(studentID),
+		GuardianID:   guardianID,
+		Relationship: f.Relationship,
+		IsPrimary:    f.IsPrimary,
+	}, &f.Guardian)
+}
+
+// 移除学生的监护人，并撤销监护人账号查看该学生的权限
+func (uc *GuardianUsecase) RemoveStudentGuardian(ctx context.Context, studentID int32, guardianID uint) error {
+	uc.log.Info("remove student guardian", studentID, guardianID)
+	guardian, err := uc.repo.GetGuardian(ctx, guardianID)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.RemoveStudentGuardian(ctx, uint(studentID), guardianID); err != nil {
+		return err
+	}
+	if guardian.UserID != nil {
+		return uc.revokeOwnership(ctx, *guardian.UserID, uint(studentID))
+	}
+	return nil
+}
+
+// CheckStudentAccess 登录账号关联了监护人时只能查看自己监护的学生，studentID 为 0 表示不针对单个学生的操作，监护人账号都不允许
+// 没有关联监护人的账号不受限制，由 RBAC 检查
+func (uc *GuardianUsecase) CheckStudentAccess(ctx context.Context, userID, studentID uint) error {
+	if userID == 0 {
+		return nil
+	}
+	guardian, err := uc.repo.GetGuardianByUserID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if studentID == 0 {
+		return ErrorNotGuardianOfStudent()
+	}
+	studentIDs, err := uc.repo.ListGuardianStudentIDs(ctx, guardian.ID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(studentIDs, studentID) {
+		return ErrorNotGuardianOfStudent()
+	}
+	return nil
+}
+
+// 为账号分配或移除监护人角色
+func (uc *GuardianUsecase) setGuardianRole(ctx context.Context, userID uint, assign bool) error {
+	role, err := uc.rbacUC.GetRoleByName(ctx, GuardianRoleName)
+	if err != nil {
+		return err
+	}
+	roles, err := uc.rbacUC.GetUserRoleNames(ctx, int32(userID))
+	if err != nil {
+		return err
+	}
+	if slices.Contains(roles, GuardianRoleName) == assign {
+		return nil
+	}
+	if assign {
+		return uc.rbacUC.AssignUserRole(ctx, int32(userID), int32(role.ID))
+	}
+	return uc.rbacUC.RemoveUserRole(ctx, int32(userID), int32(role.ID))
+}
+
+// 关联监护人的登录账号，userID 为 0 时取消关联；关联后账号分配监护人角色，只能查看该监护人的学生
+func (uc *GuardianUsecase) LinkUser(ctx context.Context, guardianID, userID uint) (*Guardian, error) {
+	uc.log.Info("link guardian user", guardianID, userID)
+	guardian, err := uc.repo.GetGuardian(ctx, guardianID)
+	if err != nil {
+		return nil, err
+	}
+	if guardian.UserID != nil && *guardian.UserID == userID {
+		return guardian, nil
+	}
+
+	var linked *uint
+	if userID != 0 {
+		if _, err := uc.users.GetUser(ctx, int32(userID)); err != nil {
+			return nil, err
+		}
+		if other, err := uc.repo.GetGuardianByUserID(ctx, userID); err == nil && other.ID != guardianID {
+			return nil, errors.Conflict("CONFLICT", "该账号已关联其他监护人")
+		} else if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		linked = &userID
+	}
+
+	studentIDs, err := uc.repo.ListGuardianStudentIDs(ctx, guardianID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetGuardianUser(ctx, guardianID, linked); err != nil {
+		return nil, err
+	}
+	for _, studentID := range studentIDs {
+		if guardian.UserID != nil {
+			if err := uc.revokeOwnership(ctx, *guardian.UserID, studentID); err != nil {
+				return nil, err
+			}
+		}
+		if linked != nil {
+			if err := uc.grantOwnership(ctx, *linked, studentID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if guardian.UserID != nil {
+		if err := uc.setGuardianRole(ctx, *guardian.UserID, false); err != nil {
+			return nil, err
+		}
+	}
+	if linked != nil {
+		if err := uc.setGuardianRole(ctx, *linked, true); err != nil {
+			return nil, err
+		}
+	}
+	guardian.UserID = linked
+	return guardian, nil
+}
+
+// 登录账号作为监护人可以查看的学生
+func (uc *GuardianUsecase) ListChildren(ctx context.Context, userID uint) ([]*Student, error) {
+	return uc.repo.ListChildren(ctx, userID)
+}
@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeGuardianRepo struct {
+	GuardianRepo
+	guardians map[uint]*Guardian
+	// 监护人关联的学生
+	students map[uint][]uint
+	added    []*StudentGuardian
+}
+
+func (r *fakeGuardianRepo) GetGuardian(ctx context.Context, id uint) (*Guardian, error) {
+	if g, ok := r.guardians[id]; ok {
+		copied := *g
+		return &copied, nil
+	}
+	return nil, errors.NotFound("NOT_FOUND", "监护人不存在")
+}
+
+func (r *fakeGuardianRepo) GetGuardianByUserID(ctx context.Context, userID uint) (*Guardian, error) {
+	for _, g := range r.guardians {
+		if g.UserID != nil && *g.UserID == userID {
+			return g, nil
+		}
+	}
+	return nil, errors.NotFound("NOT_FOUND", "监护人不存在")
+}
+
+func (r *fakeGuardianRepo) ListGuardianStudentIDs(ctx context.Context, guardianID uint) ([]uint, error) {
+	return r.students[guardianID], nil
+}
+
+func (r *fakeGuardianRepo) SetGuardianUser(ctx context.Context, id uint, userID *uint) error {
+	r.guardians[id].UserID = userID
+	return nil
+}
+
+func (r *fakeGuardianRepo) AddStudentGuardian(ctx context.Context, link *StudentGuardian, g *GuardianForm) (*StudentGuardian, error) {
+	r.added = append(r.added, link)
+	return link, nil
+}
+
+// 记录授予的策略，格式为 用户 资源 操作
+type fakeGuardianRBACRepo struct {
+	RBACRepo
+	policies []string
+	// 分配了监护人角色的用户
+	guardianUsers []int32
+}
+
+func (r *fakeGuardianRBACRepo) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	return &Role{ID: 4, Name: name}, nil
+}
+
+func (r *fakeGuardianRBACRepo) GetUserRoleNames(ctx context.Context, userID int32) ([]string, error) {
+	if slices.Contains(r.guardianUsers, userID) {
+		return []string{GuardianRoleName}, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeGuardianRBACRepo) AssignUserRole(ctx context.Context, userID, roleID int32) error {
+	r.guardianUsers = append(r.guardianUsers, userID)
+	return nil
+}
+
+func (r *fakeGuardianRBACRepo) RemoveUserRole(ctx context.Context, userID, roleID int32) error {
+	r.guardianUsers = slices.DeleteFunc(r.guardianUsers, func(id int32) bool { return id == userID })
+	return nil
+}
+
+func (r *fakeGuardianRBACRepo) AddPolicy(ctx context.Context, sub, obj, act string) error {
+	r.policies = append(r.policies, sub+" "+obj+" "+act)
+	return nil
+}
+
+func (r *fakeGuardianRBACRepo) RemovePolicy(ctx context.Context, sub, obj, act string) error {
+	r.policies = slices.DeleteFunc(r.policies, func(p string) bool { return p == sub+" "+obj+" "+act })
+	return nil
+}
+
+func TestGuardianUsecase_AddStudentGuardian(t *testing.T) {
+	ctx := context.Background()
+	students := &fakeStudentRepo{students: map[int32]*Student{1: {ID: 1, Name: "测试学生"}}}
+
+	tests := []struct {
+		name         string
+		form         StudentGuardianForm
+		wantCode     int
+		wantPolicies int
+	}{
+		{name: "监护关系无效", form: StudentGuardianForm{Relationship: "uncle", Guardian: GuardianForm{Name: "张三", Phone: "13800000000"}}, wantCode: 400},
+		{name: "新建监护人缺少姓名", form: StudentGuardianForm{Relationship: GuardianRelationshipFather, Guardian: GuardianForm{Phone: "13800000000"}}, wantCode: 400},
+		{name: "新建监护人缺少电话", form: StudentGuardianForm{Relationship: GuardianRelationshipFather, Guardian: GuardianForm{Name: "张三"}}, wantCode: 400},
+		{name: "监护人不存在", form: StudentGuardianForm{GuardianID: 9, Relationship: GuardianRelationshipMother}, wantCode: 404},
+		{name: "新建监护人", form: StudentGuardianForm{Relationship: GuardianRelationshipFather, Guardian: GuardianForm{Name: "张三", Phone: "13800000000"}, IsPrimary: true}},
+		{name: "关联有账号的监护人时授予查看权限", form: StudentGuardianForm{GuardianID: 2, Relationship: GuardianRelationshipMother}, wantPolicies: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uint(7)
+			repo := &fakeGuardianRepo{guardians: map[uint]*Guardian{2: {ID: 2, Name: "李四", UserID: &userID}}}
+			rbacRepo := &fakeGuardianRBACRepo{}
+			uc := NewGuardianUsecase(repo, students, nil, NewRBACUsecase(rbacRepo, log.DefaultLogger, nil), log.DefaultLogger)
+			form := tt.form
+			_, err := uc.AddStudentGuardian(ctx, 1, &form)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("AddStudentGuardian() error = %v, want code %d", err, tt.wantCode)
+				}
+				if len(repo.added) != 0 {
+					t.Error("校验失败时不应添加监护人")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddStudentGuardian() error = %v", err)
+			}
+			if len(repo.added) != 1 || len(rbacRepo.policies) != tt.wantPolicies {
+				t.Errorf("added = %d, policies = %v", len(repo.added), rbacRepo.policies)
+			}
+		})
+	}
+}
+
+func TestGuardianUsecase_LinkUser(t *testing.T) {
+	ctx := context.Background()
+	users := &fakeExternalUserRepo{users: map[uint]*User{7: {ID: 7}, 8: {ID: 8}}}
+	otherUser := uint(8)
+	repo := &fakeGuardianRepo{
+		guardians: map[uint]*Guardian{1: {ID: 1, Name: "张三"}, 2: {ID: 2, Name: "李四", UserID: &otherUser}},
+		students:  map[uint][]uint{1: {3, 5}},
+	}
+	rbacRepo := &fakeGuardianRBACRepo{}
+	uc := NewGuardianUsecase(repo, nil, users, NewRBACUsecase(rbacRepo, log.DefaultLogger, nil), log.DefaultLogger)
+
+	if _, err := uc.LinkUser(ctx, 1, 9); errors.Code(err) != 404 {
+		t.Errorf("关联不存在的账号 error = %v", err)
+	}
+	if _, err := uc.LinkUser(ctx, 1, 8); errors.Code(err) != 409 {
+		t.Errorf("关联已属于其他监护人的账号 error = %v", err)
+	}
+
+	guardian, err := uc.LinkUser(ctx, 1, 7)
+	if err != nil || guardian.UserID == nil || *guardian.UserID != 7 {
+		t.Fatalf("LinkUser() = %+v, error = %v", guardian, err)
+	}
+	want := []string{"7 /v1/student/3 GET", "7 /v1/student/3/* GET", "7 /v1/student/5 GET", "7 /v1/student/5/* GET"}
+	if !slices.Equal(rbacRepo.policies, want) {
+		t.Errorf("policies = %v, want %v", rbacRepo.policies, want)
+	}
+	if !slices.Equal(rbacRepo.guardianUsers, []int32{7}) {
+		t.Errorf("关联后应分配监护人角色, guardianUsers = %v", rbacRepo.guardianUsers)
+	}
+
+	// 取消关联后撤销查看权限
+	if _, err := uc.LinkUser(ctx, 1, 0); err != nil {
+		t.Fatalf("LinkUser() error = %v", err)
+	}
+	if len(rbacRepo.policies) != 0 || repo.guardians[1].UserID != nil || len(rbacRepo.guardianUsers) != 0 {
+		t.Errorf("policies = %v, user = %v, guardianUsers = %v", rbacRepo.policies, repo.guardians[1].UserID, rbacRepo.guardianUsers)
+	}
+}
+
+func TestGuardianUsecase_CheckStudentAccess(t *testing.T) {
+	ctx := context.Background()
+	guardianUser := uint(7)
+	repo := &fakeGuardianRepo{
+		guardians: map[uint]*Guardian{1: {ID: 1, Name: "张三", UserID: &guardianUser}},
+		students:  map[uint][]uint{1: {3, 5}},
+	}
+	uc := NewGuardianUsecase(repo, nil, nil, NewRBACUsecase(&fakeGuardianRBACRepo{}, log.DefaultLogger, nil), log.DefaultLogger)
+
+	tests := []struct {
+		name      string
+		userID    uint
+		studentID uint
+		wantCode  int
+	}{
+		{name: "监护人查看自己的孩子", userID: 7, studentID: 3},
+		{name: "监护人查看其他学生", userID: 7, studentID: 4, wantCode: 403},
+		{name: "监护人查看学生列表", userID: 7, wantCode: 403},
+		{name: "不是监护人的账号不受限制", userID: 8, studentID: 4},
+		{name: "未登录时由认证中间件处理", userID: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.CheckStudentAccess(ctx, tt.userID, tt.studentID)
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Errorf("CheckStudentAccess() error = %v", err)
+				}
+				return
+			}
+			if errors.Code(err) != tt.wantCode {
+				t.Errorf("CheckStudentAccess() error = %v, want code %d", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// 模拟登录接口，同时作为模拟权限的资源
+	ImpersonationResource = "/v1/impersonations"
+	// 模拟登录Token的最长有效期，不签发刷新Token
+	impersonationTokenTTL = 15 * time.Minute
+	// 模拟原因的最大长度
+	maxImpersonationReasonLength = 255
+)
+
+// 操作人没有模拟权限
+func ErrorImpersonationForbidden() error {
+	return errors.Forbidden("FORBIDDEN", "没有模拟登录的权限")
+}
+
+// ImpersonationMessage 模拟登录消息
+type ImpersonationMessage struct {
+	Success   bool
+	Message   string
+	Token     string
+	ExpiresIn int64
+	// 被模拟的用户
+	User *User
+}
+
+// ImpersonationUsecase 管理员以其他用户身份登录，所有操作写入审计日志
+type ImpersonationUsecase struct {
+	repo    UserRepo
+	audit   AuditLogRepo
+	rbacUC  *RBACUsecase
+	jwtUtil *jwt.JWTUtil
+	log     *log.Helper
+}
+
+// 初始化 ImpersonationUsecase
+func NewImpersonationUsecase(repo UserRepo, audit AuditLogRepo, rbacUC *RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *ImpersonationUsecase {
+	return &ImpersonationUsecase{
+		repo:    repo,
+		audit:   audit,
+		rbacUC:  rbacUC,
+		jwtUtil: jwtUtil,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// 签发模拟目标用户的短期Token，Token 绑定操作人的会话，操作人退出登录后随之失效
+func (uc *ImpersonationUsecase) Impersonate(ctx context.Context, actor *jwt.Claims, targetID uint, reason string, client ClientInfo) (*ImpersonationMessage, error) {
+	uc.log.Info("impersonate user", actor.UserID, targetID)
+
+	if actor.Impersonated() {
+		return &ImpersonationMessage{Success: false, Message: "模拟登录期间不能再模拟其他用户"}, nil
+	}
+	// 接口的 RBAC 检查依赖请求头，这里必须再检查一次操作人的权限
+	allowed, err := uc.rbacUC.CheckPermission(ctx, strconv.Itoa(int(actor.UserID)), ImpersonationResource, "POST")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrorImpersonationForbidden()
+	}
+	if targetID == actor.UserID {
+		return &ImpersonationMessage{Success: false, Message: "不能模拟自己"}, nil
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return &ImpersonationMessage{Success: false, Message: "请填写模拟原因"}, nil
+	}
+	reason = truncate(reason, maxImpersonationReasonLength)
+
+	target, err := uc.repo.GetUser(ctx, int32(targetID))
+	if err != nil {
+		return &ImpersonationMessage{Success: false, Message: "用户不存在"}, nil
+	}
+	if target.Status != 1 {
+		return &ImpersonationMessage{Success: false, Message: "用户已被禁用"}, nil
+	}
+
+	// 拥有模拟权限的用户不能被模拟，避免借此获得其他管理员的权限
+	privileged, err := uc.rbacUC.CheckPermission(ctx, strconv.Itoa(int(target.ID)), ImpersonationResource, "POST")
+	if err != nil {
+		return nil, err
+	}
+	if privileged {
+		return &ImpersonationMessage{Success: false, Message: "不能模拟拥有模拟权限的用户"}, nil
+	}
+
+	roles, err := uc.rbacUC.GetUserRoleNames(ctx, int32(target.ID))
+	if err != nil {
+		return nil, err
+	}
+	target.Roles = roles
+
+	// 先写审计日志，写入失败时不签发Token
+	err = uc.audit.CreateAuditLog(ctx, &AuditLog{
+		ActorID:   actor.UserID,
+		UserID:    target.ID,
+		SessionID: actor.SessionID,
+		Action:    AuditActionImpersonationStart,
+		Method:    "POST",
+		Path:      ImpersonationResource,
+		Reason:    reason,
+		IP:        client.IP,
+		UserAgent: truncate(client.UserAgent, 255),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expire := min(impersonationTokenTTL, uc.jwtUtil.AccessExpire())
+	token, err := uc.jwtUtil.GenerateImpersonationToken(target.ID, target.Username, target.Email, actor.SessionID, &jwt.Actor{
+		UserID:   actor.UserID,
+		Username: actor.Username,
+	}, expire)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Warn("开始模拟登录", "actor_id", actor.UserID, "user_id", target.ID, "reason", reason)
+	return &ImpersonationMessage{
+		Success:   true,
+		Message:   "模拟登录成功",
+		Token:     token,
+		ExpiresIn: int64(expire.Seconds()),
+		User:      target,
+	}, nil
+}
+
+// 记录模拟登录期间的请求，写入失败时返回错误，由调用方拒绝请求
+func (uc *ImpersonationUsecase) AuditImpersonation(ctx context.Context, claims *jwt.Claims, method, path string, client ClientInfo) error {
+	return uc.audit.CreateAuditLog(ctx, &AuditLog{
+		ActorID:   claims.Actor.UserID,
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+		Action:    AuditActionImpersonationRequest,
+		Method:    method,
+		Path:      truncate(path, 255),
+		IP:        client.IP,
+		UserAgent: truncate(client.UserAgent, 255),
+	})
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeAuditLogRepo struct {
+	entries []*AuditLog
+}
+
+func (r *fakeAuditLogRepo) CreateAuditLog(ctx context.Context, entry *AuditLog) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+type fakeImpersonationRBACRepo struct {
+	RBACRepo
+	// 拥有模拟权限的用户
+	admins map[string]bool
+}
+
+func (r *fakeImpersonationRBACRepo) GetPermissionsForUser(ctx context.Context, user string) ([][]string, error) {
+	if r.admins[user] {
+		return [][]string{{"admin", ImpersonationResource, "POST"}}, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeImpersonationRBACRepo) GetUserRoleNames(ctx context.Context, userID int32) ([]string, error) {
+	return []string{"user"}, nil
+}
+
+func TestImpersonationUsecase_Impersonate(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &fakeExternalUserRepo{users: map[uint]*User{
+		1: {ID: 1, Username: "admin", Status: 1},
+		2: {ID: 2, Username: "student", Email: "student@example.com", Status: 1},
+		3: {ID: 3, Username: "disabled", Status: 0},
+		4: {ID: 4, Username: "other-admin", Status: 1},
+	}}
+	audit := &fakeAuditLogRepo{}
+	rbacUC := NewRBACUsecase(&fakeImpersonationRBACRepo{admins: map[string]bool{"1": true, "4": true}}, log.DefaultLogger, nil)
+	uc := NewImpersonationUsecase(users, audit, rbacUC, jwtUtil, log.DefaultLogger)
+
+	admin := &jwt.Claims{UserID: 1, Username: "admin", SessionID: "admin-session"}
+	student := &jwt.Claims{UserID: 2, Username: "student", SessionID: "student-session"}
+	impersonating := &jwt.Claims{UserID: 2, Username: "student", Actor: &jwt.Actor{UserID: 1, Username: "admin"}}
+
+	tests := []struct {
+		name        string
+		actor       *jwt.Claims
+		targetID    uint
+		reason      string
+		wantSuccess bool
+		wantCode    int
+	}{
+		{name: "没有模拟权限的用户", actor: student, targetID: 3, reason: "工单 #1", wantCode: 403},
+		{name: "模拟期间不能再次模拟", actor: impersonating, targetID: 2, reason: "工单 #1"},
+		{name: "不能模拟自己", actor: admin, targetID: 1, reason: "工单 #1"},
+		{name: "缺少模拟原因", actor: admin, targetID: 2, reason: "  "},
+		{name: "用户不存在", actor: admin, targetID: 99, reason: "工单 #1"},
+		{name: "用户已被禁用", actor: admin, targetID: 3, reason: "工单 #1"},
+		{name: "不能模拟其他管理员", actor: admin, targetID: 4, reason: "工单 #1"},
+		{name: "模拟普通用户", actor: admin, targetID: 2, reason: "工单 #1", wantSuccess: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit.entries = nil
+			result, err := uc.Impersonate(ctx, tt.actor, tt.targetID, tt.reason, ClientInfo{IP: "10.0.0.1"})
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Fatalf("Impersonate() error = %v, want code %d", err, tt.wantCode)
+				}
+				if len(audit.entries) != 0 {
+					t.Errorf("失败时不应写入审计日志: %+v", audit.entries)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Impersonate() error = %v", err)
+			}
+			if result.Success != tt.wantSuccess {
+				t.Fatalf("Impersonate() = %+v, wantSuccess %v", result, tt.wantSuccess)
+			}
+			if !tt.wantSuccess {
+				if len(audit.entries) != 0 {
+					t.Errorf("失败时不应写入审计日志: %+v", audit.entries)
+				}
+				return
+			}
+
+			if len(audit.entries) != 1 || audit.entries[0].Action != AuditActionImpersonationStart || audit.entries[0].Reason != tt.reason {
+				t.Errorf("审计日志 = %+v", audit.entries)
+			}
+			claims, err := jwtUtil.ValidateToken(result.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if claims.UserID != tt.targetID || !claims.Impersonated() || claims.Actor.UserID != tt.actor.UserID || claims.SessionID != tt.actor.SessionID {
+				t.Errorf("claims = %+v, actor = %+v", claims, claims.Actor)
+			}
+			if result.ExpiresIn > int64(impersonationTokenTTL.Seconds()) {
+				t.Errorf("ExpiresIn = %d, 超过模拟登录的最长有效期", result.ExpiresIn)
+			}
+
+			// 模拟期间的请求记录操作人和被模拟的用户
+			if err := uc.AuditImpersonation(ctx, claims, "GET", "/v1/students", ClientInfo{}); err != nil {
+				t.Fatal(err)
+			}
+			entry := audit.entries[len(audit.entries)-1]
+			if entry.Action != AuditActionImpersonationRequest || entry.ActorID != 1 || entry.UserID != tt.targetID || entry.Path != "/v1/students" {
+				t.Errorf("请求审计日志 = %+v", entry)
+			}
+		})
+	}
+}
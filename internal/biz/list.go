@@ -0,0 +1,438 @@
+package biz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 列表的默认和最大分页大小，超过最大值时按最大值返回
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// 单个过滤条件中最多的条件数量
+const maxFilterTerms = 20
+
+// 过滤条件的操作符
+const (
+	OpEqual        = "="
+	OpNotEqual     = "!="
+	OpLess         = "<"
+	OpLessEqual    = "<="
+	OpGreater      = ">"
+	OpGreaterEqual = ">="
+	OpIn           = "in"
+	// 前缀匹配，表达式中写作 name = "张*"
+	OpPrefix = "prefix"
+	// 包含匹配，只用于兼容旧的 name 等查询参数，表达式中不能使用
+	OpContains = "contains"
+)
+
+// ListFieldType 字段类型，决定过滤值的解析方式和可以使用的操作符
+type ListFieldType int
+
+const (
+	ListFieldInt ListFieldType = iota
+	ListFieldString
+	ListFieldTime
+)
+
+// ListField 列表中可以过滤和排序的字段
+type ListField struct {
+	Column string
+	Type   ListFieldType
+	// 列可以为 NULL，键集分页时按 NULL 最小处理，与 MySQL 的排序一致
+	Nullable bool
+}
+
+// ListSchema 列表可以使用的字段，键为 API 中的字段名，必须包含 id
+type ListSchema map[string]ListField
+
+// Filter 一个过滤条件，Values 已按字段类型转换
+type Filter struct {
+	Field  string
+	Column string
+	Op     string
+	Values []any
+}
+
+// OrderBy 一个排序字段
+type OrderBy struct {
+	Field    string
+	Column   string
+	Desc     bool
+	Nullable bool
+}
+
+// ListRequest 列表请求中的通用参数
+type ListRequest struct {
+	// 过滤表达式，如 status in (1, 2) AND age >= 18 AND name = "张*"
+	Filter string
+	// 排序，如 age desc, name
+	OrderBy   string
+	PageSize  int32
+	PageToken string
+	// 兼容旧的页码分页，PageToken 为空时使用 OFFSET
+	Page int32
+}
+
+// ListQuery 校验后的列表查询
+type ListQuery struct {
+	Filters []Filter
+	// 最后一个排序字段总是 id，保证顺序稳定
+	OrderBy  []OrderBy
+	PageSize int
+	// 上一页最后一条记录的排序字段值，为空时从第一页开始
+	After []any
+	// 旧的页码，After 为空时使用
+	Page int
+
+	schema      ListSchema
+	fingerprint string
+}
+
+// 翻页令牌的内容，q 为查询条件的摘要，防止令牌用于不同的查询
+// After 中的 null 表示排序字段的值为 NULL
+type pageToken struct {
+	After []*string `json:"a"`
+	Query string    `json:"q"`
+}
+
+// 列表参数错误
+func errInvalidList(format string, args ...any) error {
+	return errors.BadRequest("INVALID_ARGUMENT", fmt.Sprintf(format, args...))
+}
+
+// Parse 解析并校验列表请求，extra 为兼容旧查询参数的附加条件
+func (s ListSchema) Parse(req ListRequest, extra ...Filter) (*ListQuery, error) {
+	q := &ListQuery{schema: s, PageSize: int(req.PageSize), Page: int(req.Page)}
+	if q.PageSize <= 0 {
+		q.PageSize = DefaultPageSize
+	}
+	if q.PageSize > MaxPageSize {
+		q.PageSize = MaxPageSize
+	}
+
+	filters, err := s.parseFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range extra {
+		field, ok := s[f.Field]
+		if !ok {
+			return nil, errInvalidList("不支持按 %s 过滤", f.Field)
+		}
+		f.Column = field.Column
+		filters = append(filters, f)
+	}
+	q.Filters = filters
+	if q.OrderBy, err = s.parseOrderBy(req.OrderBy); err != nil {
+		return nil, err
+	}
+	q.fingerprint = q.digest()
+
+	if req.PageToken != "" {
+		if q.After, err = q.decodeToken(req.PageToken); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// NextPageToken 根据当前页最后一条记录的排序字段值生成下一页的令牌
+func (q *ListQuery) NextPageToken(last []any) string {
+	token := pageToken{Query: q.fingerprint, After: make([]*string, len(last))}
+	for i, v := range last {
+		if isNullKey(v) {
+			continue
+		}
+		key := formatKey(v)
+		token.After[i] = &key
+	}
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// 排序字段的值是否为 NULL
+func isNullKey(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case *time.Time:
+		return v == nil
+	}
+	return false
+}
+
+// 排序字段值在令牌中的文本形式
+func formatKey(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
+
+func (q *ListQuery) decodeToken(raw string) ([]any, error) {
+	invalid := errInvalidList("page_token 无效或与查询条件不一致")
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, invalid
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Query != q.fingerprint || len(token.After) != len(q.OrderBy) {
+		return nil, invalid
+	}
+	after := make([]any, len(token.After))
+	for i, o := range q.OrderBy {
+		if token.After[i] == nil {
+			if !o.Nullable {
+				return nil, invalid
+			}
+			continue
+		}
+		field := q.schema[o.Field]
+		var v any
+		var err error
+		if field.Type == ListFieldTime {
+			v, err = time.Parse(time.RFC3339Nano, *token.After[i])
+		} else {
+			v, err = parseListValue(field.Type, *token.After[i])
+		}
+		if err != nil {
+			return nil, invalid
+		}
+		after[i] = v
+	}
+	return after, nil
+}
+
+// 查询条件的摘要，包含过滤和排序
+func (q *ListQuery) digest() string {
+	var b strings.Builder
+	for _, f := range q.Filters {
+		b.WriteString(f.Column + " " + f.Op)
+		for _, v := range f.Values {
+			b.WriteString(" " + formatKey(v))
+		}
+		b.WriteString(";")
+	}
+	for _, o := range q.OrderBy {
+		b.WriteString(o.Column)
+		if o.Desc {
+			b.WriteString(" desc")
+		}
+		b.WriteString(";")
+	}
+	h := fnv.New64a()
+	h.Write([]byte(b.String()))
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+// 按字段类型解析过滤值
+func parseListValue(t ListFieldType, raw string) (any, error) {
+	switch t {
+	case ListFieldInt:
+		return strconv.ParseInt(raw, 10, 64)
+	case ListFieldTime:
+		for _, layout := range []string{time.RFC3339, TimeFormat, DateFormat} {
+			if v, err := time.ParseInLocation(layout, raw, time.Local); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid time %q", raw)
+	}
+	return raw, nil
+}
+
+// 解析排序，如 "age desc, name"；未指定 id 时追加 id desc
+func (s ListSchema) parseOrderBy(expr string) ([]OrderBy, error) {
+	var orders []OrderBy
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(expr, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			if strings.TrimSpace(expr) != "" {
+				return nil, errInvalidList("order_by 格式错误")
+			}
+			continue
+		}
+		if len(words) > 2 {
+			return nil, errInvalidList("order_by 格式错误: %s", strings.TrimSpace(part))
+		}
+		field, ok := s[words[0]]
+		if !ok {
+			return nil, errInvalidList("不支持按 %s 排序", words[0])
+		}
+		if seen[words[0]] {
+			return nil, errInvalidList("重复的排序字段 %s", words[0])
+		}
+		seen[words[0]] = true
+		order := OrderBy{Field: words[0], Column: field.Column, Nullable: field.Nullable}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				order.Desc = true
+			default:
+				return nil, errInvalidList("排序方向只能是 asc 或 desc")
+			}
+		}
+		orders = append(orders, order)
+	}
+	if !seen["id"] {
+		orders = append(orders, OrderBy{Field: "id", Column: s["id"].Column, Desc: true})
+	}
+	return orders, nil
+}
+
+// 过滤表达式的词法单元
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, filterToken{text: string(r)})
+			i++
+		case r == '"':
+			var b strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				b.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, errInvalidList("过滤条件中的引号不匹配")
+			}
+			tokens = append(tokens, filterToken{text: b.String(), quoted: true})
+			i++
+		case strings.ContainsRune("=!<>", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, errInvalidList("无法识别的操作符 !")
+			}
+			tokens = append(tokens, filterToken{text: op})
+			i += len(op)
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()",=!<>`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+// 解析过滤表达式，条件之间只支持 AND
+func (s ListSchema) parseFilter(expr string) ([]Filter, error) {
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	var filters []Filter
+	for i := 0; i < len(tokens); {
+		if len(filters) > 0 {
+			if tokens[i].quoted || !strings.EqualFold(tokens[i].text, "and") {
+				return nil, errInvalidList("过滤条件之间只支持 AND: %s", tokens[i].text)
+			}
+			i++
+		}
+		if len(filters) == maxFilterTerms {
+			return nil, errInvalidList("过滤条件最多 %d 个", maxFilterTerms)
+		}
+		if i+2 >= len(tokens) {
+			return nil, errInvalidList("过滤条件不完整")
+		}
+		name := tokens[i].text
+		field, ok := s[name]
+		if tokens[i].quoted || !ok {
+			return nil, errInvalidList("不支持按 %s 过滤", name)
+		}
+		f := Filter{Field: name, Column: field.Column, Op: strings.ToLower(tokens[i+1].text)}
+		i += 2
+
+		var raws []filterToken
+		switch f.Op {
+		case OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual:
+			if tokens[i-1].quoted {
+				return nil, errInvalidList("无法识别的操作符 %s", tokens[i-1].text)
+			}
+			raws = []filterToken{tokens[i]}
+			i++
+		case OpIn:
+			if tokens[i].text != "(" || tokens[i].quoted {
+				return nil, errInvalidList("in 的值需要写在括号中")
+			}
+			for i++; ; i++ {
+				if i >= len(tokens) {
+					return nil, errInvalidList("过滤条件中的括号不匹配")
+				}
+				raws = append(raws, tokens[i])
+				i++
+				if i < len(tokens) && tokens[i].text == ")" && !tokens[i].quoted {
+					i++
+					break
+				}
+				if i >= len(tokens) || tokens[i].text != "," {
+					return nil, errInvalidList("in 的值之间使用逗号分隔")
+				}
+			}
+		default:
+			return nil, errInvalidList("无法识别的操作符 %s", f.Op)
+		}
+
+		if field.Type == ListFieldString && f.Op == OpEqual && strings.HasSuffix(raws[0].text, "*") {
+			f.Op = OpPrefix
+			raws[0].text = strings.TrimSuffix(raws[0].text, "*")
+		}
+		if field.Type == ListFieldTime && f.Op == OpIn {
+			return nil, errInvalidList("%s 不支持 in", name)
+		}
+		if field.Type == ListFieldString && f.Op != OpEqual && f.Op != OpNotEqual && f.Op != OpIn && f.Op != OpPrefix {
+			return nil, errInvalidList("%s 只支持 =、!= 和 in", name)
+		}
+		for _, raw := range raws {
+			if !raw.quoted && strings.ContainsRune("(),", []rune(raw.text)[0]) {
+				return nil, errInvalidList("过滤条件格式错误: %s", raw.text)
+			}
+			v, err := parseListValue(field.Type, raw.text)
+			if err != nil {
+				return nil, errInvalidList("%s 的值 %s 格式错误", name, raw.text)
+			}
+			f.Values = append(f.Values, v)
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
@@ -0,0 +1,185 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListSchema_Parse(t *testing.T) {
+	createdAt := time.Date(2024, 9, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name         string
+		req          ListRequest
+		extra        []Filter
+		wantFilters  []Filter
+		wantOrder    []OrderBy
+		wantPageSize int
+		wantErr      bool
+	}{
+		{
+			name:         "默认排序和分页大小",
+			wantOrder:    []OrderBy{{Field: "id", Column: "id", Desc: true}},
+			wantPageSize: DefaultPageSize,
+		},
+		{
+			name:         "分页大小超过最大值",
+			req:          ListRequest{PageSize: 1000},
+			wantOrder:    []OrderBy{{Field: "id", Column: "id", Desc: true}},
+			wantPageSize: MaxPageSize,
+		},
+		{
+			name: "状态、年龄范围、创建时间和姓名前缀",
+			req: ListRequest{
+				Filter:   `status in (1, 2) AND age >= 18 and age < 30 AND created_at >= "2024-09-01" AND name = "张*"`,
+				PageSize: 20,
+			},
+			wantFilters: []Filter{
+				{Field: "status", Column: "status", Op: OpIn, Values: []any{int64(1), int64(2)}},
+				{Field: "age", Column: "age", Op: OpGreaterEqual, Values: []any{int64(18)}},
+				{Field: "age", Column: "age", Op: OpLess, Values: []any{int64(30)}},
+				{Field: "created_at", Column: "created_at", Op: OpGreaterEqual, Values: []any{createdAt}},
+				{Field: "name", Column: "name", Op: OpPrefix, Values: []any{"张"}},
+			},
+			wantOrder:    []OrderBy{{Field: "id", Column: "id", Desc: true}},
+			wantPageSize: 20,
+		},
+		{
+			name:  "旧的查询参数作为附加条件",
+			extra: []Filter{{Field: "name", Op: OpContains, Values: []any{"三"}}},
+			wantFilters: []Filter{
+				{Field: "name", Column: "name", Op: OpContains, Values: []any{"三"}},
+			},
+			wantOrder:    []OrderBy{{Field: "id", Column: "id", Desc: true}},
+			wantPageSize: DefaultPageSize,
+		},
+		{
+			name: "多字段排序",
+			req:  ListRequest{OrderBy: "age desc, name"},
+			wantOrder: []OrderBy{
+				{Field: "age", Column: "age", Desc: true},
+				{Field: "name", Column: "name"},
+				{Field: "id", Column: "id", Desc: true},
+			},
+			wantPageSize: DefaultPageSize,
+		},
+		{
+			name:         "指定 id 排序时不再追加",
+			req:          ListRequest{OrderBy: "id asc"},
+			wantOrder:    []OrderBy{{Field: "id", Column: "id"}},
+			wantPageSize: DefaultPageSize,
+		},
+		{name: "不支持的过滤字段", req: ListRequest{Filter: "info = 1"}, wantErr: true},
+		{name: "条件之间只支持 AND", req: ListRequest{Filter: "age = 1 OR age = 2"}, wantErr: true},
+		{name: "字符串不支持范围比较", req: ListRequest{Filter: `name > "a"`}, wantErr: true},
+		{name: "数值格式错误", req: ListRequest{Filter: "age >= abc"}, wantErr: true},
+		{name: "时间不支持 in", req: ListRequest{Filter: `created_at in ("2024-09-01")`}, wantErr: true},
+		{name: "括号不匹配", req: ListRequest{Filter: "status in (1, 2"}, wantErr: true},
+		{name: "条件不完整", req: ListRequest{Filter: "age >="}, wantErr: true},
+		{name: "不支持的排序字段", req: ListRequest{OrderBy: "info"}, wantErr: true},
+		{name: "重复的排序字段", req: ListRequest{OrderBy: "age, age desc"}, wantErr: true},
+		{name: "排序方向错误", req: ListRequest{OrderBy: "age down"}, wantErr: true},
+		{name: "无效的 page_token", req: ListRequest{PageToken: "abc"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := StudentListSchema.Parse(tt.req, tt.extra...)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Parse() 应返回错误")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(q.Filters, tt.wantFilters) {
+				t.Errorf("Filters = %+v, want %+v", q.Filters, tt.wantFilters)
+			}
+			if !reflect.DeepEqual(q.OrderBy, tt.wantOrder) {
+				t.Errorf("OrderBy = %+v, want %+v", q.OrderBy, tt.wantOrder)
+			}
+			if q.PageSize != tt.wantPageSize {
+				t.Errorf("PageSize = %d, want %d", q.PageSize, tt.wantPageSize)
+			}
+		})
+	}
+}
+
+func TestListQuery_NextPageToken(t *testing.T) {
+	req := ListRequest{Filter: "status = 1", OrderBy: "created_at desc"}
+	q, err := StudentListSchema.Parse(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	createdAt := time.Date(2024, 9, 1, 8, 30, 0, 0, time.UTC)
+	token := q.NextPageToken([]any{&createdAt, uint(42)})
+
+	tests := []struct {
+		name      string
+		req       ListRequest
+		wantAfter []any
+		wantErr   bool
+	}{
+		{name: "相同查询可以翻页", req: req, wantAfter: []any{createdAt, int64(42)}},
+		{name: "分页大小不影响令牌", req: ListRequest{Filter: req.Filter, OrderBy: req.OrderBy, PageSize: 50}, wantAfter: []any{createdAt, int64(42)}},
+		{name: "过滤条件改变", req: ListRequest{Filter: "status = 2", OrderBy: req.OrderBy}, wantErr: true},
+		{name: "排序改变", req: ListRequest{Filter: req.Filter, OrderBy: "created_at"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.PageToken = token
+			q, err := StudentListSchema.Parse(tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Parse() 应返回错误")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(q.After) != len(tt.wantAfter) {
+				t.Fatalf("After = %v, want %v", q.After, tt.wantAfter)
+			}
+			for i, v := range q.After {
+				if tv, ok := v.(time.Time); ok {
+					if !tv.Equal(tt.wantAfter[i].(time.Time)) {
+						t.Errorf("After[%d] = %v, want %v", i, v, tt.wantAfter[i])
+					}
+				} else if v != tt.wantAfter[i] {
+					t.Errorf("After[%d] = %v, want %v", i, v, tt.wantAfter[i])
+				}
+			}
+		})
+	}
+}
+
+func TestListQuery_NextPageTokenNull(t *testing.T) {
+	req := ListRequest{OrderBy: "created_at desc"}
+	q, err := StudentListSchema.Parse(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 创建时间为 NULL 的记录
+	req.PageToken = q.NextPageToken([]any{(*time.Time)(nil), uint(42)})
+	q, err = StudentListSchema.Parse(req)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(q.After, []any{nil, int64(42)}) {
+		t.Errorf("After = %v, want [<nil> 42]", q.After)
+	}
+
+	// 不能为 NULL 的字段
+	q, err = StudentListSchema.Parse(ListRequest{OrderBy: "age"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := StudentListSchema.Parse(ListRequest{OrderBy: "age", PageToken: q.NextPageToken([]any{nil, uint(42)})}); err == nil {
+		t.Error("Parse() 应拒绝不能为 NULL 的字段中的 NULL")
+	}
+}
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 登录安全默认配置
+const (
+	defaultMaxFailures     = 5
+	defaultFailureWindow   = 15 * time.Minute
+	defaultLockoutDuration = 15 * time.Minute
+	defaultMaxIPFailures   = 50
+	defaultBaseDelay       = time.Second
+	defaultMaxDelay        = 30 * time.Second
+)
+
+// 错误原因，与错误码 1004 / 1007 对应
+const (
+	ReasonAccountLocked     = "ACCOUNT_LOCKED"
+	ReasonRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+)
+
+// 账户已被锁定
+func ErrorAccountLocked(retryAfter time.Duration) error {
+	return errors.New(423, ReasonAccountLocked, "账户已被临时锁定，请稍后再试").WithMetadata(map[string]string{
+		"retry_after": retryAfterSeconds(retryAfter),
+	})
+}
+
+// 登录尝试过于频繁
+func ErrorRateLimitExceeded(retryAfter time.Duration) error {
+	return errors.New(429, ReasonRateLimitExceeded, "登录尝试过于频繁，请稍后再试").WithMetadata(map[string]string{
+		"retry_after": retryAfterSeconds(retryAfter),
+	})
+}
+
+func retryAfterSeconds(d time.Duration) string {
+	seconds := int64((d + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return strconv.FormatInt(seconds, 10)
+}
+
+// 定义 LoginAttempt 的操作接口
+type LoginAttemptRepo interface {
+	// 记录一次登录失败，返回窗口期内该用户名和该IP的失败次数
+	RecordFailure(ctx context.Context, username, ip string, window time.Duration) (int64, int64, error)
+	// 清除用户名的失败记录
+	ResetFailures(ctx context.Context, username string) error
+	// 获取IP的失败次数以及计数剩余有效期
+	GetIPFailures(ctx context.Context, ip string) (int64, time.Duration, error)
+	// 锁定账户
+	LockAccount(ctx context.Context, username string, duration time.Duration) error
+	// 设置下次允许尝试前的退避时间
+	SetBackoff(ctx context.Context, username string, duration time.Duration) error
+	// 获取账户锁定和退避的剩余时间
+	GetRestriction(ctx context.Context, username string) (time.Duration, time.Duration, error)
+	// 解除账户锁定并清除失败记录
+	UnlockAccount(ctx context.Context, username string) error
+}
+
+// 登录限流器，负责失败计数、渐进退避和账户锁定
+type LoginLimiter struct {
+	repo LoginAttemptRepo
+	conf *conf.LoginSecurity
+	log  *log.Helper
+}
+
+// 初始化 LoginLimiter
+func NewLoginLimiter(repo LoginAttemptRepo, c *conf.LoginSecurity, logger log.Logger) *LoginLimiter {
+	if c == nil {
+		c = &conf.LoginSecurity{}
+	}
+	return &LoginLimiter{
+		repo: repo,
+		conf: c,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 登录前检查账户和IP是否允许尝试
+func (l *LoginLimiter) Check(ctx context.Context, username, ip string) error {
+	locked, backoff, err := l.repo.GetRestriction(ctx, normalizeUsername(username))
+	if err != nil {
+		return err
+	}
+	if locked > 0 {
+		return ErrorAccountLocked(locked)
+	}
+	if backoff > 0 {
+		return ErrorRateLimitExceeded(backoff)
+	}
+
+	if ip != "" {
+		failures, ttl, err := l.repo.GetIPFailures(ctx, ip)
+		if err != nil {
+			return err
+		}
+		if failures >= int64(l.maxIPFailures()) {
+			return ErrorRateLimitExceeded(ttl)
+		}
+	}
+	return nil
+}
+
+// 记录登录失败，达到阈值时锁定账户并返回锁定错误
+func (l *LoginLimiter) Fail(ctx context.Context, username, ip string) error {
+	name := normalizeUsername(username)
+	failures, _, err := l.repo.RecordFailure(ctx, name, ip, l.failureWindow())
+	if err != nil {
+		return err
+	}
+
+	if failures >= int64(l.maxFailures()) {
+		l.log.Warn("登录失败次数过多，锁定账户", "username", name, "ip", ip, "failures", failures)
+		if err := l.repo.LockAccount(ctx, name, l.lockoutDuration()); err != nil {
+			return err
+		}
+		return ErrorAccountLocked(l.lockoutDuration())
+	}
+
+	return l.repo.SetBackoff(ctx, name, l.backoffDelay(failures))
+}
+
+// 登录成功后清除失败记录
+func (l *LoginLimiter) Succeed(ctx context.Context, username string) error {
+	return l.repo.ResetFailures(ctx, normalizeUsername(username))
+}
+
+// 解除账户锁定
+func (l *LoginLimiter) Unlock(ctx context.Context, username string) error {
+	return l.repo.UnlockAccount(ctx, normalizeUsername(username))
+}
+
+// 第 n 次失败后的退避时间，按 2 的指数增长，不超过最大间隔
+func (l *LoginLimiter) backoffDelay(failures int64) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	delay := l.baseDelay()
+	for i := int64(1); i < failures && delay < l.maxDelay(); i++ {
+		delay *= 2
+	}
+	return min(delay, l.maxDelay())
+}
+
+func (l *LoginLimiter) maxFailures() int32 {
+	if l.conf.MaxFailures > 0 {
+		return l.conf.MaxFailures
+	}
+	return defaultMaxFailures
+}
+
+func (l *LoginLimiter) maxIPFailures() int32 {
+	if l.conf.MaxIpFailures > 0 {
+		return l.conf.MaxIpFailures
+	}
+	return defaultMaxIPFailures
+}
+
+func (l *LoginLimiter) failureWindow() time.Duration {
+	if d := l.conf.FailureWindow.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultFailureWindow
+}
+
+func (l *LoginLimiter) lockoutDuration() time.Duration {
+	if d := l.conf.LockoutDuration.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultLockoutDuration
+}
+
+func (l *LoginLimiter) baseDelay() time.Duration {
+	if d := l.conf.BaseDelay.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultBaseDelay
+}
+
+func (l *LoginLimiter) maxDelay() time.Duration {
+	if d := l.conf.MaxDelay.AsDuration(); d > 0 {
+		return d
+	}
+	return defaultMaxDelay
+}
+
+// 用户名统一转为小写，避免通过大小写变化绕过计数
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestLoginLimiter_BackoffDelay(t *testing.T) {
+	limiter := NewLoginLimiter(nil, &conf.LoginSecurity{
+		BaseDelay: durationpb.New(time.Second),
+		MaxDelay:  durationpb.New(10 * time.Second),
+	}, log.DefaultLogger)
+
+	tests := []struct {
+		name     string
+		failures int64
+		expected time.Duration
+	}{
+		{name: "没有失败", failures: 0, expected: 0},
+		{name: "第一次失败", failures: 1, expected: time.Second},
+		{name: "第二次失败", failures: 2, expected: 2 * time.Second},
+		{name: "第四次失败", failures: 4, expected: 8 * time.Second},
+		{name: "超过最大间隔", failures: 10, expected: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := limiter.backoffDelay(tt.failures); got != tt.expected {
+				t.Errorf("backoffDelay(%d) = %v, want %v", tt.failures, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoginLimiter_Defaults(t *testing.T) {
+	limiter := NewLoginLimiter(nil, nil, log.DefaultLogger)
+
+	if limiter.maxFailures() != defaultMaxFailures {
+		t.Errorf("maxFailures = %v, want %v", limiter.maxFailures(), defaultMaxFailures)
+	}
+	if limiter.lockoutDuration() != defaultLockoutDuration {
+		t.Errorf("lockoutDuration = %v, want %v", limiter.lockoutDuration(), defaultLockoutDuration)
+	}
+	if got := retryAfterSeconds(1500 * time.Millisecond); got != "2" {
+		t.Errorf("retryAfterSeconds = %v, want 2", got)
+	}
+}
+
+// 记录被解锁的账户
+type fakeUnlockAttemptRepo struct {
+	LoginAttemptRepo
+	unlocked []string
+}
+
+func (r *fakeUnlockAttemptRepo) UnlockAccount(ctx context.Context, username string) error {
+	r.unlocked = append(r.unlocked, username)
+	return nil
+}
+
+func TestUserUsecase_Unlock(t *testing.T) {
+	ctx := context.Background()
+	roles := &fakeRoleRepo{permissions: map[string][][]string{
+		"1": {{"admin", "/v1/user/*/unlock", "POST"}},
+		"2": {{"user", "/v1/users", "GET"}},
+	}}
+	attempts := &fakeUnlockAttemptRepo{}
+	users := &fakeAccountUserRepo{user: &User{ID: 3, Username: "zhangsan", Status: 1}}
+	uc := NewUserUsecase(users, nil, nil, nil, NewLoginLimiter(attempts, nil, log.DefaultLogger), NewRBACUsecase(roles, log.DefaultLogger, nil), nil, nil, log.DefaultLogger)
+
+	tests := []struct {
+		name       string
+		operatorID uint
+		wantErr    bool
+	}{
+		{name: "未登录", operatorID: 0, wantErr: true},
+		{name: "没有权限", operatorID: 2, wantErr: true},
+		{name: "管理员", operatorID: 1, wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.Unlock(ctx, tt.operatorID, 3)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unlock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if len(attempts.unlocked) != 1 || attempts.unlocked[0] != "zhangsan" {
+		t.Errorf("unlocked = %v, want [zhangsan]", attempts.unlocked)
+	}
+}
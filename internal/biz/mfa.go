@@ -0,0 +1,295 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/totp"
+)
+
+const (
+	// 认证器 App 中显示的签发方
+	mfaIssuer = "student-system"
+	// 两步验证挑战的有效期
+	mfaChallengeTTL = 5 * time.Minute
+	// 每个挑战允许的最大尝试次数
+	mfaMaxAttempts = 5
+	// 允许的时钟偏差（时间步）
+	mfaSkew = 1
+	// 恢复码数量
+	recoveryCodeCount = 10
+)
+
+// MFASettings 用户两步验证设置
+type MFASettings struct {
+	Enabled bool
+	Secret  string
+	// 恢复码哈希，逗号分隔
+	RecoveryCodes string
+}
+
+// MFASetupMessage 两步验证初始化消息
+type MFASetupMessage struct {
+	Success    bool
+	Message    string
+	Secret     string
+	OTPAuthURL string
+}
+
+// MFAMessage 两步验证操作消息
+type MFAMessage struct {
+	Success bool
+	Message string
+	// 仅在启用时返回一次明文恢复码
+	RecoveryCodes []string
+}
+
+// 定义 MFA 的操作接口
+type MFARepo interface {
+	// 保存登录挑战
+	CreateChallenge(ctx context.Context, challengeHash string, userID uint, ttl time.Duration) error
+	// 使用登录挑战，返回用户ID以及包含本次在内的尝试次数
+	UseChallenge(ctx context.Context, challengeHash string) (uint, int64, error)
+	// 删除登录挑战
+	DeleteChallenge(ctx context.Context, challengeHash string) error
+	// 标记验证码时间步已使用，返回 false 表示该时间步已被使用过
+	MarkStepUsed(ctx context.Context, userID uint, step int64, ttl time.Duration) (bool, error)
+}
+
+// 创建两步验证挑战，密码校验通过后调用
+func (uc *UserUsecase) createMFAChallenge(ctx context.Context, user *User) (*LoginMessage, error) {
+	challenge, err := jwt.GenerateRefreshToken()
+	if err == nil {
+		err = uc.mfaRepo.CreateChallenge(ctx, jwt.HashRefreshToken(challenge), user.ID, mfaChallengeTTL)
+	}
+	if err != nil {
+		uc.log.Error("创建两步验证挑战失败", err)
+		return &LoginMessage{
+			Message: "登录失败，请稍后重试",
+			Success: false,
+		}, nil
+	}
+
+	return &LoginMessage{
+		Message:     "请输入两步验证码",
+		Success:     false,
+		MFARequired: true,
+		MFAToken:    challenge,
+	}, nil
+}
+
+// 完成两步验证登录
+func (uc *UserUsecase) VerifyMFA(ctx context.Context, mfaToken, code string, client ClientInfo) (*LoginMessage, error) {
+	invalid := &LoginMessage{
+		Message: "验证码错误或已过期",
+		Success: false,
+	}
+
+	challengeHash := jwt.HashRefreshToken(mfaToken)
+	userID, attempts, err := uc.mfaRepo.UseChallenge(ctx, challengeHash)
+	if err != nil {
+		return invalid, nil
+	}
+	if attempts > mfaMaxAttempts {
+		if err := uc.mfaRepo.DeleteChallenge(ctx, challengeHash); err != nil {
+			uc.log.Error("删除两步验证挑战失败", err)
+		}
+		return &LoginMessage{
+			Message: "验证失败次数过多，请重新登录",
+			Success: false,
+		}, nil
+	}
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil || user.Status != 1 || !user.MFAEnabled {
+		return invalid, nil
+	}
+
+	ok, err := uc.verifyMFACode(ctx, user, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return invalid, nil
+	}
+
+	// 挑战只能使用一次
+	if err := uc.mfaRepo.DeleteChallenge(ctx, challengeHash); err != nil {
+		uc.log.Error("删除两步验证挑战失败", err)
+	}
+	return uc.startSession(ctx, user, client)
+}
+
+// 生成两步验证密钥，验证通过前不会生效
+func (uc *UserUsecase) SetupMFA(ctx context.Context, userID uint) (*MFASetupMessage, error) {
+	uc.log.Info("setup mfa", userID)
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled {
+		return &MFASetupMessage{
+			Success: false,
+			Message: "两步验证已启用",
+		}, nil
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateMFA(ctx, user.ID, &MFASettings{Secret: secret}); err != nil {
+		return nil, err
+	}
+
+	return &MFASetupMessage{
+		Success:    true,
+		Message:    "请使用认证器扫描并输入验证码完成启用",
+		Secret:     secret,
+		OTPAuthURL: totp.URL(mfaIssuer, user.Username, secret),
+	}, nil
+}
+
+// 校验验证码后启用两步验证，并生成恢复码
+func (uc *UserUsecase) EnableMFA(ctx context.Context, userID uint, code string) (*MFAMessage, error) {
+	uc.log.Info("enable mfa", userID)
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled {
+		return &MFAMessage{Success: false, Message: "两步验证已启用"}, nil
+	}
+	if user.MFASecret == "" {
+		return &MFAMessage{Success: false, Message: "请先初始化两步验证"}, nil
+	}
+
+	ok, err := uc.verifyTOTP(ctx, user, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &MFAMessage{Success: false, Message: "验证码错误"}, nil
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = uc.repo.UpdateMFA(ctx, user.ID, &MFASettings{
+		Enabled:       true,
+		Secret:        user.MFASecret,
+		RecoveryCodes: strings.Join(hashes, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MFAMessage{
+		Success:       true,
+		Message:       "两步验证已启用，请妥善保存恢复码",
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// 校验验证码或恢复码后关闭两步验证
+func (uc *UserUsecase) DisableMFA(ctx context.Context, userID uint, code string) (*MFAMessage, error) {
+	uc.log.Info("disable mfa", userID)
+
+	user, err := uc.repo.GetUser(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled {
+		return &MFAMessage{Success: false, Message: "两步验证未启用"}, nil
+	}
+
+	ok, err := uc.verifyMFACode(ctx, user, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &MFAMessage{Success: false, Message: "验证码错误"}, nil
+	}
+
+	if err := uc.repo.UpdateMFA(ctx, user.ID, &MFASettings{}); err != nil {
+		return nil, err
+	}
+	return &MFAMessage{Success: true, Message: "两步验证已关闭"}, nil
+}
+
+// 校验 TOTP 验证码或恢复码
+func (uc *UserUsecase) verifyMFACode(ctx context.Context, user *User, code string) (bool, error) {
+	if ok, err := uc.verifyTOTP(ctx, user, code); err != nil || ok {
+		return ok, err
+	}
+	return uc.useRecoveryCode(ctx, user, code)
+}
+
+// 校验 TOTP 验证码，同一时间步的验证码只能使用一次
+func (uc *UserUsecase) verifyTOTP(ctx context.Context, user *User, code string) (bool, error) {
+	step, ok := totp.Validate(user.MFASecret, code, uc.now(), mfaSkew)
+	if !ok {
+		return false, nil
+	}
+	ttl := time.Duration(2*mfaSkew+1) * totp.Period * time.Second
+	return uc.mfaRepo.MarkStepUsed(ctx, user.ID, step, ttl)
+}
+
+// 使用恢复码，每个恢复码只能使用一次
+func (uc *UserUsecase) useRecoveryCode(ctx context.Context, user *User, code string) (bool, error) {
+	if user.MFARecoveryCodes == "" {
+		return false, nil
+	}
+
+	hash := hashRecoveryCode(code)
+	hashes := strings.Split(user.MFARecoveryCodes, ",")
+	for i, h := range hashes {
+		if h != hash {
+			continue
+		}
+		remaining := append(hashes[:i:i], hashes[i+1:]...)
+		err := uc.repo.UpdateMFA(ctx, user.ID, &MFASettings{
+			Enabled:       user.MFAEnabled,
+			Secret:        user.MFASecret,
+			RecoveryCodes: strings.Join(remaining, ","),
+		})
+		if err != nil {
+			return false, err
+		}
+		user.MFARecoveryCodes = strings.Join(remaining, ",")
+		uc.log.Info("使用恢复码", "user_id", user.ID, "remaining", len(remaining))
+		return true, nil
+	}
+	return false, nil
+}
+
+// 生成恢复码，返回明文和哈希
+func generateRecoveryCodes() ([]string, []string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(buf))
+		code := raw[:4] + "-" + raw[4:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// 恢复码忽略大小写和分隔符
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	return jwt.HashRefreshToken(code)
+}
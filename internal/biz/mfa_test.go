@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"student/internal/pkg/totp"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeMFAUserRepo struct {
+	UserRepo
+	user *User
+}
+
+func (r *fakeMFAUserRepo) GetUser(ctx context.Context, id int32) (*User, error) {
+	u := *r.user
+	return &u, nil
+}
+
+func (r *fakeMFAUserRepo) UpdateMFA(ctx context.Context, id uint, settings *MFASettings) error {
+	r.user.MFAEnabled = settings.Enabled
+	r.user.MFASecret = settings.Secret
+	r.user.MFARecoveryCodes = settings.RecoveryCodes
+	return nil
+}
+
+type fakeMFARepo struct {
+	MFARepo
+	steps map[string]bool
+	// 挑战哈希到用户ID
+	challenges map[string]uint
+}
+
+func (r *fakeMFARepo) CreateChallenge(ctx context.Context, challengeHash string, userID uint, ttl time.Duration) error {
+	r.challenges[challengeHash] = userID
+	return nil
+}
+
+func (r *fakeMFARepo) MarkStepUsed(ctx context.Context, userID uint, step int64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%d:%d", userID, step)
+	if r.steps[key] {
+		return false, nil
+	}
+	r.steps[key] = true
+	return true, nil
+}
+
+func TestUserUsecase_MFA(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 7, 17, 18, 18, 43, 0, time.UTC)
+	users := &fakeMFAUserRepo{user: &User{ID: 1, Username: "testuser", Status: 1}}
+	uc := NewUserUsecase(users, nil, &fakeMFARepo{steps: map[string]bool{}}, nil, nil, nil, nil, nil, log.DefaultLogger)
+	uc.now = func() time.Time { return now }
+
+	setup, err := uc.SetupMFA(ctx, 1)
+	if err != nil || !setup.Success {
+		t.Fatalf("SetupMFA() = %v, %v", setup, err)
+	}
+	if users.user.MFAEnabled {
+		t.Fatal("验证前不应启用两步验证")
+	}
+
+	code, _ := totp.GenerateCode(setup.Secret, now)
+	enabled, err := uc.EnableMFA(ctx, 1, code)
+	if err != nil || !enabled.Success {
+		t.Fatalf("EnableMFA() = %v, %v", enabled, err)
+	}
+	if len(enabled.RecoveryCodes) != recoveryCodeCount {
+		t.Fatalf("恢复码数量 = %d, want %d", len(enabled.RecoveryCodes), recoveryCodeCount)
+	}
+
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "重放同一验证码", code: code, want: false},
+		{name: "错误验证码", code: "000000", want: false},
+		{name: "恢复码忽略大小写", code: strings.ToUpper(enabled.RecoveryCodes[0]), want: true},
+		{name: "恢复码只能使用一次", code: enabled.RecoveryCodes[0], want: false},
+		{name: "其他恢复码", code: enabled.RecoveryCodes[1], want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := uc.verifyMFACode(ctx, users.user, tt.code)
+			if err != nil {
+				t.Fatalf("verifyMFACode() error = %v", err)
+			}
+			if ok != tt.want {
+				t.Errorf("verifyMFACode(%q) = %v, want %v", tt.code, ok, tt.want)
+			}
+		})
+	}
+
+	if got := len(strings.Split(users.user.MFARecoveryCodes, ",")); got != recoveryCodeCount-2 {
+		t.Errorf("剩余恢复码 = %d, want %d", got, recoveryCodeCount-2)
+	}
+
+	now = now.Add(totp.Period * time.Second)
+	next, _ := totp.GenerateCode(setup.Secret, now)
+	disabled, err := uc.DisableMFA(ctx, 1, next)
+	if err != nil || !disabled.Success {
+		t.Fatalf("DisableMFA() = %v, %v", disabled, err)
+	}
+	if users.user.MFAEnabled || users.user.MFASecret != "" {
+		t.Error("关闭后应清除两步验证设置")
+	}
+}
@@ -0,0 +1,602 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"student/internal/conf"
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// OIDC 默认配置
+const (
+	defaultOIDCIssuer           = "http://localhost:8000"
+	defaultAuthorizationCodeTTL = time.Minute
+	defaultIDTokenTTL           = time.Hour
+)
+
+// 客户端管理接口，同时作为管理客户端权限的资源
+const OAuthClientResource = "/v1/oauth/clients"
+
+// 操作人没有管理客户端的权限
+func ErrorOAuthClientForbidden() error {
+	return errors.Forbidden("FORBIDDEN", "没有管理 OAuth 客户端的权限")
+}
+
+// 授权请求和令牌请求支持的参数值
+const (
+	OAuthResponseTypeCode        = "code"
+	OAuthGrantAuthorizationCode  = "authorization_code"
+	OAuthGrantRefreshToken       = "refresh_token"
+	OAuthCodeChallengeMethodS256 = "S256"
+	OAuthScopeOpenID             = "openid"
+	OAuthScopeProfile            = "profile"
+	OAuthScopeEmail              = "email"
+	oauthCodeVerifierMinLength   = 43
+	oauthCodeVerifierMaxLength   = 128
+	maxOAuthRedirectURIs         = 10
+)
+
+// 支持的权限范围
+var OAuthScopes = []string{OAuthScopeOpenID, OAuthScopeProfile, OAuthScopeEmail}
+
+// OAuth2 协议错误码 (RFC 6749)
+const (
+	OAuthErrInvalidRequest          = "invalid_request"
+	OAuthErrInvalidClient           = "invalid_client"
+	OAuthErrInvalidGrant            = "invalid_grant"
+	OAuthErrInvalidScope            = "invalid_scope"
+	OAuthErrInvalidToken            = "invalid_token"
+	OAuthErrUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthErrUnsupportedResponseType = "unsupported_response_type"
+	OAuthErrServerError             = "server_error"
+)
+
+// OAuthError OAuth2 协议错误，按协议格式返回给客户端
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func newOAuthError(code, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+// OAuthClient 接入单点登录的客户端应用
+type OAuthClient struct {
+	ID       uint
+	ClientID string `gorm:"column:client_id"`
+	Name     string `gorm:"column:name"`
+	// 客户端密钥哈希，为空表示公开客户端（只能依赖 PKCE）
+	SecretHash string `gorm:"column:secret_hash" json:"-"`
+	// 允许的回调地址，空格分隔
+	RedirectURIs string     `gorm:"column:redirect_uris"`
+	CreatedBy    uint       `gorm:"column:created_by"`
+	CreatedAt    *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt    *time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName 指定表名
+func (OAuthClient) TableName() string {
+	return "oauth_clients"
+}
+
+// RedirectURIList 返回回调地址列表
+func (c *OAuthClient) RedirectURIList() []string {
+	return strings.Fields(c.RedirectURIs)
+}
+
+// Public 是否为公开客户端
+func (c *OAuthClient) Public() bool {
+	return c.SecretHash == ""
+}
+
+// CreateOAuthClientMessage 创建客户端消息
+type CreateOAuthClientMessage struct {
+	Success bool
+	Message string
+	Client  *OAuthClient
+	// 明文密钥，只在创建时返回一次
+	ClientSecret string
+}
+
+// AuthorizationRequest 授权请求参数
+type AuthorizationRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// AuthorizationCode 授权码绑定的信息
+type AuthorizationCode struct {
+	ClientID      string `json:"client_id"`
+	UserID        uint   `json:"user_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	Scope         string `json:"scope"`
+	Nonce         string `json:"nonce,omitempty"`
+	CodeChallenge string `json:"code_challenge"`
+	AuthTime      int64  `json:"auth_time"`
+}
+
+// TokenRequest 令牌请求参数
+type TokenRequest struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	ClientID     string
+	ClientSecret string
+	CodeVerifier string
+	RefreshToken string
+	// 发起请求的客户端信息，用于记录会话
+	Client ClientInfo
+}
+
+// TokenResponse 令牌响应
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// 定义 OAuthClient 的操作接口
+type OAuthClientRepo interface {
+	CreateClient(ctx context.Context, client *OAuthClient) error
+	ListClients(ctx context.Context) ([]*OAuthClient, error)
+	// 客户端不存在时返回 nil
+	GetClient(ctx context.Context, clientID string) (*OAuthClient, error)
+	// 返回是否存在该客户端
+	DeleteClient(ctx context.Context, clientID string) (bool, error)
+}
+
+// 定义 AuthorizationCode 的操作接口
+type AuthorizationCodeRepo interface {
+	SaveCode(ctx context.Context, codeHash string, code *AuthorizationCode, ttl time.Duration) error
+	// 使用并删除授权码，授权码不存在时返回 nil
+	ConsumeCode(ctx context.Context, codeHash string) (*AuthorizationCode, error)
+}
+
+// OIDCUsecase 内置的 OpenID Connect 提供方，支持授权码 + PKCE 模式
+type OIDCUsecase struct {
+	clients OAuthClientRepo
+	codes   AuthorizationCodeRepo
+	userUC  *UserUsecase
+	jwtUtil *jwt.JWTUtil
+	conf    *conf.OIDC
+	log     *log.Helper
+	now     func() time.Time
+}
+
+// 初始化 OIDCUsecase
+func NewOIDCUsecase(clients OAuthClientRepo, codes AuthorizationCodeRepo, userUC *UserUsecase, jwtUtil *jwt.JWTUtil, c *conf.OIDC, logger log.Logger) *OIDCUsecase {
+	if c == nil {
+		c = &conf.OIDC{}
+	}
+	return &OIDCUsecase{
+		clients: clients,
+		codes:   codes,
+		userUC:  userUC,
+		jwtUtil: jwtUtil,
+		conf:    c,
+		log:     log.NewHelper(logger),
+		now:     time.Now,
+	}
+}
+
+// 签发方地址
+func (uc *OIDCUsecase) Issuer() string {
+	if uc.conf.Issuer == "" {
+		return defaultOIDCIssuer
+	}
+	return strings.TrimRight(uc.conf.Issuer, "/")
+}
+
+// ID Token 签名算法
+func (uc *OIDCUsecase) SigningAlgorithm() string {
+	return uc.jwtUtil.Algorithm()
+}
+
+// 注册客户端，public 为 true 时不生成密钥
+func (uc *OIDCUsecase) CreateClient(ctx context.Context, userID uint, name string, redirectURIs []string, public bool) (*CreateOAuthClientMessage, error) {
+	uc.log.Info("create oauth client", userID, name)
+	if err := uc.authorize(ctx, userID, OAuthClientResource, "POST"); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return &CreateOAuthClientMessage{Success: false, Message: "名称不能为空且不超过100个字符"}, nil
+	}
+	if len(redirectURIs) == 0 || len(redirectURIs) > maxOAuthRedirectURIs {
+		return &CreateOAuthClientMessage{Success: false, Message: "回调地址数量必须在 1 到 10 个之间"}, nil
+	}
+	for _, uri := range redirectURIs {
+		if !validRedirectURI(uri) {
+			return &CreateOAuthClientMessage{Success: false, Message: "回调地址无效：" + uri}, nil
+		}
+	}
+
+	clientID, err := jwt.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	client := &OAuthClient{
+		ClientID:     clientID,
+		Name:         name,
+		RedirectURIs: strings.Join(redirectURIs, " "),
+		CreatedBy:    userID,
+	}
+
+	var secret string
+	if !public {
+		secret, err = jwt.GenerateRefreshToken()
+		if err != nil {
+			return nil, err
+		}
+		client.SecretHash = jwt.HashRefreshToken(secret)
+	}
+
+	if err := uc.clients.CreateClient(ctx, client); err != nil {
+		return nil, err
+	}
+	return &CreateOAuthClientMessage{
+		Success:      true,
+		Message:      "客户端创建成功，请妥善保存密钥",
+		Client:       client,
+		ClientSecret: secret,
+	}, nil
+}
+
+// 获取已注册的客户端
+func (uc *OIDCUsecase) ListClients(ctx context.Context, userID uint) ([]*OAuthClient, error) {
+	if err := uc.authorize(ctx, userID, OAuthClientResource, "GET"); err != nil {
+		return nil, err
+	}
+	return uc.clients.ListClients(ctx)
+}
+
+// 删除客户端，已签发的会话不受影响
+func (uc *OIDCUsecase) DeleteClient(ctx context.Context, userID uint, clientID string) (bool, error) {
+	uc.log.Info("delete oauth client", userID, clientID)
+	if err := uc.authorize(ctx, userID, OAuthClientResource+"/"+clientID, "DELETE"); err != nil {
+		return false, err
+	}
+	return uc.clients.DeleteClient(ctx, clientID)
+}
+
+// 检查操作人是否有管理客户端的权限，不依赖请求头中的 RBAC 中间件
+func (uc *OIDCUsecase) authorize(ctx context.Context, userID uint, obj, act string) error {
+	if userID == 0 {
+		return ErrorOAuthClientForbidden()
+	}
+	allowed, err := uc.userUC.rbacUC.CheckPermission(ctx, strconv.Itoa(int(userID)), obj, act)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrorOAuthClientForbidden()
+	}
+	return nil
+}
+
+// 校验授权请求，返回可以安全重定向的回调地址。
+// 客户端或回调地址无效时回调地址为空，此时错误不能重定向给客户端
+func (uc *OIDCUsecase) ValidateAuthorization(ctx context.Context, req *AuthorizationRequest) (string, error) {
+	if req.ClientID == "" {
+		return "", newOAuthError(OAuthErrInvalidRequest, "缺少 client_id")
+	}
+	client, err := uc.clients.GetClient(ctx, req.ClientID)
+	if err != nil {
+		return "", newOAuthError(OAuthErrServerError, "获取客户端失败")
+	}
+	if client == nil {
+		return "", newOAuthError(OAuthErrInvalidClient, "客户端不存在")
+	}
+
+	// 未指定回调地址时，只有注册了唯一回调地址的客户端可以省略
+	registered := client.RedirectURIList()
+	if req.RedirectURI == "" && len(registered) == 1 {
+		req.RedirectURI = registered[0]
+	}
+	if !slices.Contains(registered, req.RedirectURI) {
+		return "", newOAuthError(OAuthErrInvalidRequest, "回调地址未注册")
+	}
+
+	if req.ResponseType != OAuthResponseTypeCode {
+		return req.RedirectURI, newOAuthError(OAuthErrUnsupportedResponseType, "只支持授权码模式")
+	}
+	scope, ok := normalizeScope(req.Scope)
+	if !ok {
+		return req.RedirectURI, newOAuthError(OAuthErrInvalidScope, "scope 必须包含 openid")
+	}
+	req.Scope = scope
+	if req.CodeChallenge == "" {
+		return req.RedirectURI, newOAuthError(OAuthErrInvalidRequest, "缺少 code_challenge")
+	}
+	if req.CodeChallengeMethod != OAuthCodeChallengeMethodS256 {
+		return req.RedirectURI, newOAuthError(OAuthErrInvalidRequest, "code_challenge_method 必须为 S256")
+	}
+	return req.RedirectURI, nil
+}
+
+// 校验登录表单中的账号密码，启用两步验证的用户需要同时提交验证码
+func (uc *OIDCUsecase) Authenticate(ctx context.Context, form *LoginForm, mfaCode string) (*User, *LoginMessage, error) {
+	user, failed, err := uc.userUC.checkCredentials(ctx, form)
+	if err != nil || failed != nil {
+		return nil, failed, err
+	}
+	if !user.MFAEnabled {
+		return user, nil, nil
+	}
+
+	if mfaCode == "" {
+		return nil, &LoginMessage{
+			Message:     "请输入两步验证码",
+			Success:     false,
+			MFARequired: true,
+		}, nil
+	}
+	ok, err := uc.userUC.verifyMFACode(ctx, user, mfaCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, &LoginMessage{
+			Message:     "验证码错误或已过期",
+			Success:     false,
+			MFARequired: true,
+		}, nil
+	}
+	return user, nil, nil
+}
+
+// 为已登录的用户签发授权码，请求必须已通过 ValidateAuthorization 校验
+func (uc *OIDCUsecase) IssueCode(ctx context.Context, req *AuthorizationRequest, user *User) (string, error) {
+	code, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	ttl := defaultAuthorizationCodeTTL
+	if uc.conf.CodeTtl != nil && uc.conf.CodeTtl.AsDuration() > 0 {
+		ttl = uc.conf.CodeTtl.AsDuration()
+	}
+	err = uc.codes.SaveCode(ctx, jwt.HashRefreshToken(code), &AuthorizationCode{
+		ClientID:      req.ClientID,
+		UserID:        user.ID,
+		RedirectURI:   req.RedirectURI,
+		Scope:         req.Scope,
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      uc.now().Unix(),
+	}, ttl)
+	if err != nil {
+		return "", err
+	}
+	uc.log.Info("签发授权码", "client_id", req.ClientID, "user_id", user.ID)
+	return code, nil
+}
+
+// 令牌端点，使用授权码或刷新令牌换取令牌
+func (uc *OIDCUsecase) Exchange(ctx context.Context, req *TokenRequest) (*TokenResponse, error) {
+	switch req.GrantType {
+	case OAuthGrantAuthorizationCode, OAuthGrantRefreshToken:
+	default:
+		return nil, newOAuthError(OAuthErrUnsupportedGrantType, "不支持的 grant_type")
+	}
+
+	client, err := uc.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if req.GrantType == OAuthGrantRefreshToken {
+		return uc.refresh(ctx, client, req)
+	}
+	return uc.exchangeCode(ctx, client, req)
+}
+
+// 使用授权码换取令牌，授权码只能使用一次
+func (uc *OIDCUsecase) exchangeCode(ctx context.Context, client *OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	invalid := newOAuthError(OAuthErrInvalidGrant, "授权码无效或已过期")
+	if req.Code == "" {
+		return nil, invalid
+	}
+	code, err := uc.codes.ConsumeCode(ctx, jwt.HashRefreshToken(req.Code))
+	if err != nil {
+		return nil, newOAuthError(OAuthErrServerError, "校验授权码失败")
+	}
+	if code == nil || code.ClientID != client.ClientID || code.RedirectURI != req.RedirectURI {
+		return nil, invalid
+	}
+	if !verifyCodeChallenge(code.CodeChallenge, req.CodeVerifier) {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "code_verifier 校验失败")
+	}
+
+	user, err := uc.userUC.repo.GetUser(ctx, int32(code.UserID))
+	if err != nil || user.Status != 1 {
+		return nil, invalid
+	}
+
+	info := req.Client
+	info.OAuthClientID = client.ClientID
+	session, err := uc.userUC.startSession(ctx, user, info)
+	if err != nil {
+		return nil, err
+	}
+	if !session.Success {
+		return nil, newOAuthError(OAuthErrServerError, session.Message)
+	}
+
+	idToken, err := uc.generateIDToken(user, client.ClientID, code.Scope, code.Nonce, code.AuthTime)
+	if err != nil {
+		uc.log.Error("签发 ID Token 失败", err)
+		return nil, newOAuthError(OAuthErrServerError, "签发 ID Token 失败")
+	}
+	return &TokenResponse{
+		AccessToken:  session.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    session.ExpiresIn,
+		RefreshToken: session.RefreshToken,
+		IDToken:      idToken,
+		Scope:        code.Scope,
+	}, nil
+}
+
+// 使用刷新令牌换取新的访问令牌，刷新令牌必须是签发给该客户端的（RFC 6749 §6）
+func (uc *OIDCUsecase) refresh(ctx context.Context, client *OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	result, err := uc.userUC.refreshToken(ctx, req.RefreshToken, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Success {
+		return nil, newOAuthError(OAuthErrInvalidGrant, result.Message)
+	}
+	return &TokenResponse{
+		AccessToken:  result.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    result.ExpiresIn,
+		RefreshToken: result.RefreshToken,
+	}, nil
+}
+
+// 校验客户端身份，机密客户端必须提供正确的密钥
+func (uc *OIDCUsecase) authenticateClient(ctx context.Context, clientID, secret string) (*OAuthClient, error) {
+	invalid := newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	if clientID == "" {
+		return nil, invalid
+	}
+	client, err := uc.clients.GetClient(ctx, clientID)
+	if err != nil {
+		return nil, newOAuthError(OAuthErrServerError, "获取客户端失败")
+	}
+	if client == nil {
+		return nil, invalid
+	}
+	if client.Public() {
+		return client, nil
+	}
+	hash := jwt.HashRefreshToken(secret)
+	if secret == "" || subtle.ConstantTimeCompare([]byte(hash), []byte(client.SecretHash)) != 1 {
+		return nil, invalid
+	}
+	return client, nil
+}
+
+// 签发 ID Token，按授权的 scope 填充用户信息
+func (uc *OIDCUsecase) generateIDToken(user *User, clientID, scope, nonce string, authTime int64) (string, error) {
+	ttl := defaultIDTokenTTL
+	if uc.conf.IdTokenTtl != nil && uc.conf.IdTokenTtl.AsDuration() > 0 {
+		ttl = uc.conf.IdTokenTtl.AsDuration()
+	}
+
+	now := uc.now()
+	claims := &jwt.IDTokenClaims{
+		Nonce:    nonce,
+		AuthTime: authTime,
+		RegisteredClaims: jwtv5.RegisteredClaims{
+			Issuer:    uc.Issuer(),
+			Subject:   strconv.FormatUint(uint64(user.ID), 10),
+			Audience:  jwtv5.ClaimStrings{clientID},
+			ExpiresAt: jwtv5.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwtv5.NewNumericDate(now),
+		},
+	}
+	scopes := strings.Fields(scope)
+	if slices.Contains(scopes, OAuthScopeProfile) {
+		claims.PreferredUsername = user.Username
+	}
+	if slices.Contains(scopes, OAuthScopeEmail) {
+		verified := user.EmailVerifiedAt != nil
+		claims.Email = user.Email
+		claims.EmailVerified = &verified
+	}
+	return uc.jwtUtil.GenerateIDToken(claims)
+}
+
+// userinfo 端点，校验访问令牌后返回当前用户信息
+func (uc *OIDCUsecase) UserInfo(ctx context.Context, accessToken string) (*User, error) {
+	invalid := newOAuthError(OAuthErrInvalidToken, "访问令牌无效或已过期")
+	if accessToken == "" {
+		return nil, invalid
+	}
+	claims, err := uc.jwtUtil.ValidateToken(accessToken)
+	if err != nil {
+		return nil, invalid
+	}
+	revoked, err := uc.userUC.IsTokenRevoked(ctx, claims)
+	if err != nil || revoked {
+		return nil, invalid
+	}
+
+	result, err := uc.userUC.GetMe(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Success {
+		return nil, invalid
+	}
+	return result.User, nil
+}
+
+// 去重并过滤不支持的 scope，必须包含 openid
+func normalizeScope(scope string) (string, bool) {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if slices.Contains(OAuthScopes, s) && !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	if !slices.Contains(scopes, OAuthScopeOpenID) {
+		return "", false
+	}
+	return strings.Join(scopes, " "), true
+}
+
+// 校验 PKCE：BASE64URL(SHA256(code_verifier)) 必须等于 code_challenge
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if len(verifier) < oauthCodeVerifierMinLength || len(verifier) > oauthCodeVerifierMaxLength {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// 回调地址必须是不带 fragment 的绝对地址，本机地址以外必须使用 https
+func validRedirectURI(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.Fragment != "" || strings.ContainsAny(raw, " \t\n") {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		return host == "localhost" || host == "127.0.0.1" || host == "::1"
+	default:
+		return false
+	}
+}
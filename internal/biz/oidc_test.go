@@ -0,0 +1,258 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+type fakeOAuthClientRepo struct {
+	OAuthClientRepo
+	clients map[string]*OAuthClient
+}
+
+func (r *fakeOAuthClientRepo) CreateClient(ctx context.Context, client *OAuthClient) error {
+	r.clients[client.ClientID] = client
+	return nil
+}
+
+func (r *fakeOAuthClientRepo) GetClient(ctx context.Context, clientID string) (*OAuthClient, error) {
+	return r.clients[clientID], nil
+}
+
+func (r *fakeOAuthClientRepo) ListClients(ctx context.Context) ([]*OAuthClient, error) {
+	clients := make([]*OAuthClient, 0, len(r.clients))
+	for _, client := range r.clients {
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+func (r *fakeOAuthClientRepo) DeleteClient(ctx context.Context, clientID string) (bool, error) {
+	_, ok := r.clients[clientID]
+	delete(r.clients, clientID)
+	return ok, nil
+}
+
+type fakeAuthorizationCodeRepo struct {
+	codes map[string]*AuthorizationCode
+}
+
+func (r *fakeAuthorizationCodeRepo) SaveCode(ctx context.Context, codeHash string, code *AuthorizationCode, ttl time.Duration) error {
+	r.codes[codeHash] = code
+	return nil
+}
+
+func (r *fakeAuthorizationCodeRepo) ConsumeCode(ctx context.Context, codeHash string) (*AuthorizationCode, error) {
+	code := r.codes[codeHash]
+	delete(r.codes, codeHash)
+	return code, nil
+}
+
+type fakeRoleRepo struct {
+	RBACRepo
+	// Casbin 中用户的权限
+	permissions map[string][][]string
+}
+
+func (r *fakeRoleRepo) GetPermissionsForUser(ctx context.Context, user string) ([][]string, error) {
+	return r.permissions[user], nil
+}
+
+func (r *fakeRoleRepo) GetUserRoleNames(ctx context.Context, userID int32) ([]string, error) {
+	return []string{"user"}, nil
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestOIDCUsecase_AuthorizationCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour, RefreshExpire: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifiedAt := time.Now()
+	users := &fakeAccountUserRepo{user: &User{ID: 7, Username: "testuser", Email: "test@example.com", Status: 1, EmailVerifiedAt: &verifiedAt}}
+	roles := &fakeRoleRepo{permissions: map[string][][]string{"1": {{"admin", OAuthClientResource, "POST"}}}}
+	userUC := NewUserUsecase(users, &fakeTokenRepo{}, nil, newFakeSessionRepo(), nil, NewRBACUsecase(roles, log.DefaultLogger, nil), jwtUtil, nil, log.DefaultLogger)
+	codes := &fakeAuthorizationCodeRepo{codes: map[string]*AuthorizationCode{}}
+	uc := NewOIDCUsecase(&fakeOAuthClientRepo{clients: map[string]*OAuthClient{}}, codes, userUC, jwtUtil, nil, log.DefaultLogger)
+
+	created, err := uc.CreateClient(ctx, 1, "教务系统", []string{"https://app.example.com/callback"}, false)
+	if err != nil || !created.Success {
+		t.Fatalf("CreateClient() = %v, %v", created, err)
+	}
+	clientID := created.Client.ClientID
+	verifier := strings.Repeat("v", 43)
+
+	authorizeTests := []struct {
+		name         string
+		req          AuthorizationRequest
+		wantRedirect bool
+		wantErr      string
+	}{
+		{name: "客户端不存在", req: AuthorizationRequest{ClientID: "missing"}, wantErr: OAuthErrInvalidClient},
+		{name: "回调地址未注册", req: AuthorizationRequest{ClientID: clientID, RedirectURI: "https://evil.example.com/cb"}, wantErr: OAuthErrInvalidRequest},
+		{name: "不支持的响应类型", req: AuthorizationRequest{ClientID: clientID, ResponseType: "token"}, wantRedirect: true, wantErr: OAuthErrUnsupportedResponseType},
+		{name: "缺少 openid", req: AuthorizationRequest{ClientID: clientID, ResponseType: "code", Scope: "profile"}, wantRedirect: true, wantErr: OAuthErrInvalidScope},
+		{name: "缺少 PKCE", req: AuthorizationRequest{ClientID: clientID, ResponseType: "code", Scope: "openid"}, wantRedirect: true, wantErr: OAuthErrInvalidRequest},
+		{name: "不接受 plain", req: AuthorizationRequest{ClientID: clientID, ResponseType: "code", Scope: "openid", CodeChallenge: verifier, CodeChallengeMethod: "plain"}, wantRedirect: true, wantErr: OAuthErrInvalidRequest},
+		{name: "合法请求", req: AuthorizationRequest{ClientID: clientID, ResponseType: "code", Scope: "openid email unknown", CodeChallenge: pkceChallenge(verifier), CodeChallengeMethod: "S256"}, wantRedirect: true},
+	}
+
+	for _, tt := range authorizeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			redirectURI, err := uc.ValidateAuthorization(ctx, &tt.req)
+			if (redirectURI != "") != tt.wantRedirect {
+				t.Errorf("redirectURI = %q, wantRedirect %v", redirectURI, tt.wantRedirect)
+			}
+			var oauthErr *OAuthError
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (!errors.As(err, &oauthErr) || oauthErr.Code != tt.wantErr) {
+				t.Errorf("ValidateAuthorization() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	req := &AuthorizationRequest{ClientID: clientID, ResponseType: "code", Scope: "openid email", Nonce: "n-1", CodeChallenge: pkceChallenge(verifier), CodeChallengeMethod: "S256"}
+	if _, err := uc.ValidateAuthorization(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	code, err := uc.IssueCode(ctx, req, users.user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchangeTests := []struct {
+		name    string
+		req     TokenRequest
+		wantErr string
+	}{
+		{name: "不支持的 grant_type", req: TokenRequest{GrantType: "password"}, wantErr: OAuthErrUnsupportedGrantType},
+		{name: "密钥错误", req: TokenRequest{GrantType: "authorization_code", ClientID: clientID, ClientSecret: "wrong", Code: code}, wantErr: OAuthErrInvalidClient},
+		{name: "code_verifier 错误", req: TokenRequest{GrantType: "authorization_code", ClientID: clientID, ClientSecret: created.ClientSecret, Code: code, RedirectURI: req.RedirectURI, CodeVerifier: strings.Repeat("x", 43)}, wantErr: OAuthErrInvalidGrant},
+		// 授权码读取后即删除，校验失败的授权码也不能再次使用
+		{name: "授权码已被使用", req: TokenRequest{GrantType: "authorization_code", ClientID: clientID, ClientSecret: created.ClientSecret, Code: code, RedirectURI: req.RedirectURI, CodeVerifier: verifier}, wantErr: OAuthErrInvalidGrant},
+	}
+
+	for _, tt := range exchangeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Exchange(ctx, &tt.req)
+			var oauthErr *OAuthError
+			if !errors.As(err, &oauthErr) || oauthErr.Code != tt.wantErr {
+				t.Errorf("Exchange() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	code, err = uc.IssueCode(ctx, req, users.user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := uc.Exchange(ctx, &TokenRequest{GrantType: "authorization_code", ClientID: clientID, ClientSecret: created.ClientSecret, Code: code, RedirectURI: req.RedirectURI, CodeVerifier: verifier})
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+
+	var claims jwt.IDTokenClaims
+	if _, err := jwtv5.ParseWithClaims(token.IDToken, &claims, func(*jwtv5.Token) (any, error) { return []byte("test-secret"), nil }); err != nil {
+		t.Fatalf("解析 ID Token 失败: %v", err)
+	}
+	if claims.Subject != "7" || claims.Nonce != "n-1" || claims.Email != "test@example.com" || claims.PreferredUsername != "" {
+		t.Errorf("ID Token claims = %+v", claims)
+	}
+	if aud, _ := claims.GetAudience(); len(aud) != 1 || aud[0] != clientID {
+		t.Errorf("aud = %v, want %s", aud, clientID)
+	}
+
+	user, err := uc.UserInfo(ctx, token.AccessToken)
+	if err != nil || user.ID != 7 {
+		t.Errorf("UserInfo() = %v, %v", user, err)
+	}
+	if _, err := uc.UserInfo(ctx, token.IDToken); err == nil {
+		t.Error("ID Token 不能用于访问 userinfo")
+	}
+
+	other, err := uc.CreateClient(ctx, 1, "图书馆系统", []string{"https://lib.example.com/callback"}, false)
+	if err != nil || !other.Success {
+		t.Fatalf("CreateClient() = %v, %v", other, err)
+	}
+	login, err := userUC.startSession(ctx, users.user, ClientInfo{})
+	if err != nil || !login.Success {
+		t.Fatalf("startSession() = %v, %v", login, err)
+	}
+	refreshed, err := uc.Exchange(ctx, &TokenRequest{GrantType: "refresh_token", ClientID: clientID, ClientSecret: created.ClientSecret, RefreshToken: token.RefreshToken})
+	if err != nil {
+		t.Fatalf("Exchange() refresh error = %v", err)
+	}
+
+	refreshTests := []struct {
+		name    string
+		req     TokenRequest
+		wantErr string
+	}{
+		{name: "其他客户端使用刷新令牌", req: TokenRequest{GrantType: "refresh_token", ClientID: other.Client.ClientID, ClientSecret: other.ClientSecret, RefreshToken: refreshed.RefreshToken}, wantErr: OAuthErrInvalidGrant},
+		// 被其他客户端提交过的刷新令牌不能再使用
+		{name: "刷新令牌已被其他客户端提交", req: TokenRequest{GrantType: "refresh_token", ClientID: clientID, ClientSecret: created.ClientSecret, RefreshToken: refreshed.RefreshToken}, wantErr: OAuthErrInvalidGrant},
+		{name: "非单点登录签发的刷新令牌", req: TokenRequest{GrantType: "refresh_token", ClientID: clientID, ClientSecret: created.ClientSecret, RefreshToken: login.RefreshToken}, wantErr: OAuthErrInvalidGrant},
+	}
+
+	for _, tt := range refreshTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Exchange(ctx, &tt.req)
+			var oauthErr *OAuthError
+			if !errors.As(err, &oauthErr) || oauthErr.Code != tt.wantErr {
+				t.Errorf("Exchange() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOIDCUsecase_ClientPermissions(t *testing.T) {
+	ctx := context.Background()
+	roles := &fakeRoleRepo{permissions: map[string][][]string{
+		"1": {{"admin", OAuthClientResource, "GET"}, {"admin", OAuthClientResource, "POST"}, {"admin", OAuthClientResource + "/*", "DELETE"}},
+		"2": {{"teacher", "/v1/students*", "*"}},
+	}}
+	userUC := NewUserUsecase(nil, nil, nil, nil, nil, NewRBACUsecase(roles, log.DefaultLogger, nil), nil, nil, log.DefaultLogger)
+	clients := &fakeOAuthClientRepo{clients: map[string]*OAuthClient{}}
+	uc := NewOIDCUsecase(clients, nil, userUC, nil, nil, log.DefaultLogger)
+
+	// 没有权限或没有登录时不依赖 RBAC 中间件，直接拒绝
+	for _, userID := range []uint{0, 2} {
+		if _, err := uc.CreateClient(ctx, userID, "教务系统", []string{"https://app.example.com/callback"}, true); err == nil {
+			t.Errorf("CreateClient(%d) 应返回错误", userID)
+		}
+		if _, err := uc.ListClients(ctx, userID); err == nil {
+			t.Errorf("ListClients(%d) 应返回错误", userID)
+		}
+		if _, err := uc.DeleteClient(ctx, userID, "client"); err == nil {
+			t.Errorf("DeleteClient(%d) 应返回错误", userID)
+		}
+	}
+	if len(clients.clients) != 0 {
+		t.Fatalf("clients = %v", clients.clients)
+	}
+
+	created, err := uc.CreateClient(ctx, 1, "教务系统", []string{"https://app.example.com/callback"}, true)
+	if err != nil || !created.Success {
+		t.Fatalf("CreateClient() = %v, %v", created, err)
+	}
+	if list, err := uc.ListClients(ctx, 1); err != nil || len(list) != 1 {
+		t.Errorf("ListClients() = %v, %v", list, err)
+	}
+	if deleted, err := uc.DeleteClient(ctx, 1, created.Client.ClientID); err != nil || !deleted {
+		t.Errorf("DeleteClient() = %v, %v", deleted, err)
+	}
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"student/internal/conf"
 	"time"
 
@@ -15,6 +16,7 @@ type Role struct {
 	Name        string
 	Description string
 	Status      int
+	Version     uint            `gorm:"column:version;default:1" json:"version"` // 版本号，每次修改加一，用于乐观锁
 	CreatedAt   *time.Time      `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt   *time.Time      `gorm:"column:updated_at" json:"updated_at"`
 	DeletedAt   *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
@@ -42,6 +44,7 @@ type Permission struct {
 	Action      string
 	Description string
 	Status      int
+	Version     uint            `gorm:"column:version;default:1" json:"version"` // 版本号，每次修改加一，用于乐观锁
 	CreatedAt   *time.Time      `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt   *time.Time      `gorm:"column:updated_at" json:"updated_at"`
 	DeletedAt   *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
@@ -90,6 +93,8 @@ type RoleForm struct {
 	Name        string
 	Description string
 	Status      int
+	// 读取时的版本号，更新时必须提供
+	Version uint
 }
 
 // PermissionForm 权限表单
@@ -99,6 +104,8 @@ type PermissionForm struct {
 	Action      string
 	Description string
 	Status      int
+	// 读取时的版本号，更新时必须提供
+	Version uint
 }
 
 // UserRoleForm 用户角色表单
@@ -118,17 +125,19 @@ type RBACRepo interface {
 	// 角色相关
 	GetRole(ctx context.Context, id int32) (*Role, error)
 	CreateRole(ctx context.Context, r *RoleForm) (*Role, error)
-	UpdateRole(ctx context.Context, id int32, r *RoleForm) (*Role, error)
-	DeleteRole(ctx context.Context, id int32) error
-	ListRoles(ctx context.Context, page int32, pageSize int32, name string) ([]*Role, int32, error)
+	// 版本号与 r.Version 不一致时返回 ErrorVersionConflict
+	UpdateRole(ctx context.Context, id int32, r *RoleForm, columns []string) (*Role, error)
+	DeleteRole(ctx context.Context, id int32, version uint) error
+	ListRoles(ctx context.Context, q *ListQuery) ([]*Role, string, int32, error)
 	GetRoleByName(ctx context.Context, name string) (*Role, error)
 
 	// 权限相关
 	GetPermission(ctx context.Context, id int32) (*Permission, error)
 	CreatePermission(ctx context.Context, p *PermissionForm) (*Permission, error)
-	UpdatePermission(ctx context.Context, id int32, p *PermissionForm) (*Permission, error)
-	DeletePermission(ctx context.Context, id int32) error
-	ListPermissions(ctx context.Context, page int32, pageSize int32, name, resource string) ([]*Permission, int32, error)
+	// 版本号与 p.Version 不一致时返回 ErrorVersionConflict
+	UpdatePermission(ctx context.Context, id int32, p *PermissionForm, columns []string) (*Permission, error)
+	DeletePermission(ctx context.Context, id int32, version uint) error
+	ListPermissions(ctx context.Context, q *ListQuery) ([]*Permission, string, int32, error)
 	GetPermissionByResourceAction(ctx context.Context, resource, action string) (*Permission, error)
 
 	// 用户角色相关
@@ -152,6 +161,16 @@ type RBACRepo interface {
 	RemoveRoleForUser(ctx context.Context, user, role string) error
 	GetRolesForUser(ctx context.Context, user string) ([]string, error)
 	GetPermissionsForUser(ctx context.Context, user string) ([][]string, error)
+
+	// 回收站相关，彻底删除时同时删除关联和 Casbin 策略
+	ListDeletedRoles(ctx context.Context, page, pageSize int32) ([]*Role, int32, error)
+	RestoreRole(ctx context.Context, id int32) (*Role, error)
+	PurgeRole(ctx context.Context, id int32) error
+	ListDeletedRoleIDsBefore(ctx context.Context, before time.Time) ([]uint, error)
+	ListDeletedPermissions(ctx context.Context, page, pageSize int32) ([]*Permission, int32, error)
+	RestorePermission(ctx context.Context, id int32) (*Permission, error)
+	PurgePermission(ctx context.Context, id int32) error
+	ListDeletedPermissionIDsBefore(ctx context.Context, before time.Time) ([]uint, error)
 }
 
 type RBACUsecase struct {
@@ -178,24 +197,54 @@ func (uc *RBACUsecase) CreateRole(ctx context.Context, r *RoleForm) (*Role, erro
 	return uc.repo.CreateRole(ctx, r)
 }
 
-func (uc *RBACUsecase) UpdateRole(ctx context.Context, id int32, r *RoleForm) (*Role, error) {
-	uc.log.Info("update role", id, r)
-	return uc.repo.UpdateRole(ctx, id, r)
+// 角色可以更新的字段
+var roleUpdateFields = UpdateFields{"name": "name", "description": "description", "status": "status"}
+
+// paths 为 update_mask，为空时更新所有字段，r.Version 为读取时的版本号
+func (uc *RBACUsecase) UpdateRole(ctx context.Context, id int32, r *RoleForm, paths []string) (*Role, error) {
+	uc.log.Info("update role", id, r, paths)
+	if err := requireVersion(r.Version); err != nil {
+		return nil, err
+	}
+	columns, err := roleUpdateFields.Resolve(paths)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateRole(ctx, id, r, columns)
 }
 
-func (uc *RBACUsecase) DeleteRole(ctx context.Context, id int32) error {
+// version 为读取时的版本号
+func (uc *RBACUsecase) DeleteRole(ctx context.Context, id int32, version uint) error {
 	uc.log.Info("delete role", id)
-	return uc.repo.DeleteRole(ctx, id)
+	if err := requireVersion(version); err != nil {
+		return err
+	}
+	return uc.repo.DeleteRole(ctx, id, version)
+}
+
+func (uc *RBACUsecase) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	uc.log.Info("get role by name", name)
+	return uc.repo.GetRoleByName(ctx, name)
 }
 
-func (uc *RBACUsecase) ListRoles(ctx context.Context, page int32, pageSize int32, name string) ([]*Role, int32, error) {
-	if page == 0 {
-		page = 1
+// 角色列表可以过滤和排序的字段
+var RoleListSchema = ListSchema{
+	"id":         {Column: "id", Type: ListFieldInt},
+	"name":       {Column: "name", Type: ListFieldString},
+	"status":     {Column: "status", Type: ListFieldInt},
+	"created_at": {Column: "created_at", Type: ListFieldTime, Nullable: true},
+}
+
+func (uc *RBACUsecase) ListRoles(ctx context.Context, req ListRequest, name string) ([]*Role, string, int32, error) {
+	var extra []Filter
+	if name != "" {
+		extra = append(extra, Filter{Field: "name", Op: OpContains, Values: []any{name}})
 	}
-	if pageSize == 0 {
-		pageSize = 10
+	q, err := RoleListSchema.Parse(req, extra...)
+	if err != nil {
+		return nil, "", 0, err
 	}
-	return uc.repo.ListRoles(ctx, page, pageSize, name)
+	return uc.repo.ListRoles(ctx, q)
 }
 
 // 权限相关方法
@@ -209,24 +258,60 @@ func (uc *RBACUsecase) CreatePermission(ctx context.Context, p *PermissionForm)
 	return uc.repo.CreatePermission(ctx, p)
 }
 
-func (uc *RBACUsecase) UpdatePermission(ctx context.Context, id int32, p *PermissionForm) (*Permission, error) {
-	uc.log.Info("update permission", id, p)
-	return uc.repo.UpdatePermission(ctx, id, p)
+// 权限可以更新的字段
+var permissionUpdateFields = UpdateFields{
+	"name":        "name",
+	"resource":    "resource",
+	"action":      "action",
+	"description": "description",
+	"status":      "status",
 }
 
-func (uc *RBACUsecase) DeletePermission(ctx context.Context, id int32) error {
+// paths 为 update_mask，为空时更新所有字段，p.Version 为读取时的版本号
+func (uc *RBACUsecase) UpdatePermission(ctx context.Context, id int32, p *PermissionForm, paths []string) (*Permission, error) {
+	uc.log.Info("update permission", id, p, paths)
+	if err := requireVersion(p.Version); err != nil {
+		return nil, err
+	}
+	columns, err := permissionUpdateFields.Resolve(paths)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdatePermission(ctx, id, p, columns)
+}
+
+// version 为读取时的版本号
+func (uc *RBACUsecase) DeletePermission(ctx context.Context, id int32, version uint) error {
 	uc.log.Info("delete permission", id)
-	return uc.repo.DeletePermission(ctx, id)
+	if err := requireVersion(version); err != nil {
+		return err
+	}
+	return uc.repo.DeletePermission(ctx, id, version)
+}
+
+// 权限列表可以过滤和排序的字段
+var PermissionListSchema = ListSchema{
+	"id":         {Column: "id", Type: ListFieldInt},
+	"name":       {Column: "name", Type: ListFieldString},
+	"resource":   {Column: "resource", Type: ListFieldString},
+	"action":     {Column: "action", Type: ListFieldString},
+	"status":     {Column: "status", Type: ListFieldInt},
+	"created_at": {Column: "created_at", Type: ListFieldTime, Nullable: true},
 }
 
-func (uc *RBACUsecase) ListPermissions(ctx context.Context, page int32, pageSize int32, name, resource string) ([]*Permission, int32, error) {
-	if page == 0 {
-		page = 1
+func (uc *RBACUsecase) ListPermissions(ctx context.Context, req ListRequest, name, resource string) ([]*Permission, string, int32, error) {
+	var extra []Filter
+	if name != "" {
+		extra = append(extra, Filter{Field: "name", Op: OpContains, Values: []any{name}})
 	}
-	if pageSize == 0 {
-		pageSize = 10
+	if resource != "" {
+		extra = append(extra, Filter{Field: "resource", Op: OpContains, Values: []any{resource}})
 	}
-	return uc.repo.ListPermissions(ctx, page, pageSize, name, resource)
+	q, err := PermissionListSchema.Parse(req, extra...)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return uc.repo.ListPermissions(ctx, q)
 }
 
 // 用户角色相关方法
@@ -323,7 +408,7 @@ func (uc *RBACUsecase) CheckPermission(ctx context.Context, user string, obj str
 	for _, permission := range permissions {
 		if len(permission) >= 3 {
 			// 检查资源匹配（支持通配符）
-			if uc.matchResource(permission[1], obj) && (permission[2] == act || permission[2] == "*") {
+			if uc.matchResource(permission[1], obj) && matchAction(permission[2], act) {
 				return true, nil
 			}
 		}
@@ -331,13 +416,38 @@ func (uc *RBACUsecase) CheckPermission(ctx context.Context, user string, obj str
 	return false, nil
 }
 
+// 部分更新（PATCH）与整体更新（PUT）使用相同的权限
+func matchAction(pattern, action string) bool {
+	return pattern == action || pattern == "*" || (action == "PATCH" && pattern == "PUT")
+}
+
 // 简单的资源匹配方法（支持通配符）
 func (uc *RBACUsecase) matchResource(pattern, resource string) bool {
-	// 这里可以实现更复杂的匹配逻辑
-	// 目前简单实现：如果pattern以*结尾，则匹配前缀
-	if len(pattern) > 1 && pattern[len(pattern)-1] == '*' {
-		prefix := pattern[:len(pattern)-1]
-		return len(resource) >= len(prefix) && resource[:len(prefix)] == prefix
+	// pattern以*结尾时匹配前缀，路径中间的 /*/ 匹配一个路径段，如 /v1/student/*/guardians*
+	// 单独的 * 只匹配 *
+	if len(pattern) <= 1 {
+		return pattern == resource
+	}
+	for {
+		star := strings.IndexByte(pattern, '*')
+		if star < 0 {
+			return pattern == resource
+		}
+		if !strings.HasPrefix(resource, pattern[:star]) {
+			return false
+		}
+		if star == len(pattern)-1 {
+			return true
+		}
+		if pattern[star+1] != '/' || (star > 0 && pattern[star-1] != '/') {
+			return pattern == resource
+		}
+		// 跳过一个非空路径段
+		resource = resource[star:]
+		end := strings.IndexByte(resource, '/')
+		if end <= 0 {
+			return false
+		}
+		pattern, resource = pattern[star+1:], resource[end:]
 	}
-	return pattern == resource
 }
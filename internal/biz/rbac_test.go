@@ -0,0 +1,38 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRBACUsecase_matchResource(t *testing.T) {
+	uc := NewRBACUsecase(nil, log.DefaultLogger, nil)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		resource string
+		want     bool
+	}{
+		{name: "完全匹配", pattern: "/v1/students", resource: "/v1/students", want: true},
+		{name: "前缀匹配", pattern: "/v1/courses*", resource: "/v1/courses/1", want: true},
+		{name: "前缀不匹配", pattern: "/v1/courses*", resource: "/v1/classes/1"},
+		{name: "单独的星号只匹配星号", pattern: "*", resource: "/v1/students"},
+		{name: "中间的星号匹配一个路径段", pattern: "/v1/student/*/guardians*", resource: "/v1/student/5/guardians/3", want: true},
+		{name: "中间的星号不匹配其他子路径", pattern: "/v1/student/*/guardians*", resource: "/v1/student/5/transcript"},
+		{name: "中间的星号不匹配多个路径段", pattern: "/v1/student/*/guardians", resource: "/v1/student/5/x/guardians"},
+		{name: "中间的星号不匹配空路径段", pattern: "/v1/student/*/guardians", resource: "/v1/student//guardians"},
+		{name: "学生ID不按前缀匹配", pattern: "/v1/student/5/*", resource: "/v1/student/50/transcript"},
+		{name: "中间的星号后为完整路径", pattern: "/v1/student/*/status", resource: "/v1/student/5/status", want: true},
+		{name: "变更学籍的权限不包含其他写接口", pattern: "/v1/student/*/status", resource: "/v1/student/5/guardians"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.matchResource(tt.pattern, tt.resource); got != tt.want {
+				t.Errorf("matchResource(%q, %q) = %v, want %v", tt.pattern, tt.resource, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 回收站接口的前缀，recycle_bin:manage 权限授予 /v1/recycle-bin*
+const RecycleBinResource = "/v1/recycle-bin"
+
+// 操作人没有管理回收站的权限
+func ErrorRecycleBinForbidden() error {
+	return errors.Forbidden("FORBIDDEN", "没有管理回收站的权限")
+}
+
+// RecycleBinUsecase 回收站，查看、恢复和彻底删除软删除的学生、用户、角色和权限
+type RecycleBinUsecase struct {
+	students StudentRepo
+	users    UserRepo
+	rbac     RBACRepo
+	rbacUC   *RBACUsecase
+	index    SearchIndex
+	// 删除超过 retention 的数据由 PurgeExpired 彻底删除，为 0 时不自动清理
+	retention time.Duration
+	log       *log.Helper
+	now       func() time.Time
+}
+
+func NewRecycleBinUsecase(students StudentRepo, users UserRepo, rbac RBACRepo, rbacUC *RBACUsecase, index SearchIndex, c *conf.RecycleBin, logger log.Logger) *RecycleBinUsecase {
+	uc := &RecycleBinUsecase{
+		students: students,
+		users:    users,
+		rbac:     rbac,
+		rbacUC:   rbacUC,
+		index:    index,
+		log:      log.NewHelper(logger),
+		now:      time.Now,
+	}
+	if c != nil && c.Retention != nil {
+		uc.retention = c.Retention.AsDuration()
+	}
+	return uc
+}
+
+// DeletedAtStr 格式化删除时间，没有删除时返回空字符串
+func DeletedAtStr(d *gorm.DeletedAt) string {
+	if d == nil || !d.Valid {
+		return ""
+	}
+	return d.Time.Format(TimeFormat)
+}
+
+// 检查操作人是否有权限管理回收站中的 kind 数据，不依赖请求头中的 RBAC 中间件
+// 后台清理任务没有操作人，直接调用未导出的 purge 方法
+func (uc *RecycleBinUsecase) authorize(ctx context.Context, operatorID uint, kind, action string) error {
+	if operatorID == 0 {
+		return ErrorRecycleBinForbidden()
+	}
+	allowed, err := uc.rbacUC.CheckPermission(ctx, strconv.Itoa(int(operatorID)), RecycleBinResource+"/"+kind, action)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrorRecycleBinForbidden()
+	}
+	return nil
+}
+
+func recyclePage(page, pageSize int32) (int32, int32) {
+	page, pageSize = normalizePage(page, pageSize)
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	return page, pageSize
+}
+
+func (uc *RecycleBinUsecase) ListDeletedStudents(ctx context.Context, operatorID uint, page, pageSize int32) ([]*Student, int32, error) {
+	if err := uc.authorize(ctx, operatorID, "students", "GET"); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = recyclePage(page, pageSize)
+	return uc.students.ListDeletedStudents(ctx, page, pageSize)
+}
+
+// 恢复学生并重新加入搜索索引
+func (uc *RecycleBinUsecase) RestoreStudent(ctx context.Context, operatorID uint, id int32) (*Student, error) {
+	if err := uc.authorize(ctx, operatorID, "students", "POST"); err != nil {
+		return nil, err
+	}
+	stu, err := uc.students.RestoreStudent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.index.IndexStudents(ctx, []*Student{stu}); err != nil {
+		uc.log.WithContext(ctx).Errorf("index restored student %d failed: %v", id, err)
+	}
+	return stu, nil
+}
+
+func (uc *RecycleBinUsecase) PurgeStudent(ctx context.Context, operatorID uint, id int32) error {
+	if err := uc.authorize(ctx, operatorID, "students", "DELETE"); err != nil {
+		return err
+	}
+	return uc.purgeStudent(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) purgeStudent(ctx context.Context, id int32) error {
+	uc.log.WithContext(ctx).Info("purge student", id)
+	return uc.students.PurgeStudent(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) ListDeletedUsers(ctx context.Context, operatorID uint, page, pageSize int32) ([]*User, int32, error) {
+	if err := uc.authorize(ctx, operatorID, "users", "GET"); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = recyclePage(page, pageSize)
+	return uc.users.ListDeletedUsers(ctx, page, pageSize)
+}
+
+func (uc *RecycleBinUsecase) RestoreUser(ctx context.Context, operatorID uint, id int32) (*User, error) {
+	if err := uc.authorize(ctx, operatorID, "users", "POST"); err != nil {
+		return nil, err
+	}
+	return uc.users.RestoreUser(ctx, id)
+}
+
+// 彻底删除用户，并移除 Casbin 中该用户的角色
+func (uc *RecycleBinUsecase) PurgeUser(ctx context.Context, operatorID uint, id int32) error {
+	if err := uc.authorize(ctx, operatorID, "users", "DELETE"); err != nil {
+		return err
+	}
+	return uc.purgeUser(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) purgeUser(ctx context.Context, id int32) error {
+	uc.log.WithContext(ctx).Info("purge user", id)
+	if err := uc.users.PurgeUser(ctx, id); err != nil {
+		return err
+	}
+	sub := strconv.Itoa(int(id))
+	roles, err := uc.rbac.GetRolesForUser(ctx, sub)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if err := uc.rbac.RemoveRoleForUser(ctx, sub, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *RecycleBinUsecase) ListDeletedRoles(ctx context.Context, operatorID uint, page, pageSize int32) ([]*Role, int32, error) {
+	if err := uc.authorize(ctx, operatorID, "roles", "GET"); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = recyclePage(page, pageSize)
+	return uc.rbac.ListDeletedRoles(ctx, page, pageSize)
+}
+
+func (uc *RecycleBinUsecase) RestoreRole(ctx context.Context, operatorID uint, id int32) (*Role, error) {
+	if err := uc.authorize(ctx, operatorID, "roles", "POST"); err != nil {
+		return nil, err
+	}
+	return uc.rbac.RestoreRole(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) PurgeRole(ctx context.Context, operatorID uint, id int32) error {
+	if err := uc.authorize(ctx, operatorID, "roles", "DELETE"); err != nil {
+		return err
+	}
+	return uc.purgeRole(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) purgeRole(ctx context.Context, id int32) error {
+	uc.log.WithContext(ctx).Info("purge role", id)
+	return uc.rbac.PurgeRole(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) ListDeletedPermissions(ctx context.Context, operatorID uint, page, pageSize int32) ([]*Permission, int32, error) {
+	if err := uc.authorize(ctx, operatorID, "permissions", "GET"); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = recyclePage(page, pageSize)
+	return uc.rbac.ListDeletedPermissions(ctx, page, pageSize)
+}
+
+func (uc *RecycleBinUsecase) RestorePermission(ctx context.Context, operatorID uint, id int32) (*Permission, error) {
+	if err := uc.authorize(ctx, operatorID, "permissions", "POST"); err != nil {
+		return nil, err
+	}
+	return uc.rbac.RestorePermission(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) PurgePermission(ctx context.Context, operatorID uint, id int32) error {
+	if err := uc.authorize(ctx, operatorID, "permissions", "DELETE"); err != nil {
+		return err
+	}
+	return uc.purgePermission(ctx, id)
+}
+
+func (uc *RecycleBinUsecase) purgePermission(ctx context.Context, id int32) error {
+	uc.log.WithContext(ctx).Info("purge permission", id)
+	return uc.rbac.PurgePermission(ctx, id)
+}
+
+// PurgeExpired 彻底删除删除时间超过保留期限的数据，返回删除的条数
+// 单条删除失败时记录日志并继续，返回最后一个错误
+func (uc *RecycleBinUsecase) PurgeExpired(ctx context.Context) (int, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+	before := uc.now().Add(-uc.retention)
+
+	kinds := []struct {
+		name  string
+		ids   func(context.Context, time.Time) ([]uint, error)
+		purge func(context.Context, int32) error
+	}{
+		{"student", uc.students.ListDeletedStudentIDsBefore, uc.purgeStudent},
+		{"user", uc.users.ListDeletedUserIDsBefore, uc.purgeUser},
+		{"role", uc.rbac.ListDeletedRoleIDsBefore, uc.purgeRole},
+		{"permission", uc.rbac.ListDeletedPermissionIDsBefore, uc.purgePermission},
+	}
+	var purged int
+	var lastErr error
+	for _, kind := range kinds {
+		ids, err := kind.ids(ctx, before)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("list expired %s failed: %v", kind.name, err)
+			lastErr = err
+			continue
+		}
+		for _, id := range ids {
+			if err := kind.purge(ctx, int32(id)); err != nil {
+				uc.log.WithContext(ctx).Errorf("purge %s %d failed: %v", kind.name, id, err)
+				lastErr = err
+				continue
+			}
+			purged++
+		}
+	}
+	return purged, lastErr
+}
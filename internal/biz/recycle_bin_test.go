@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 按删除时间保存回收站中的数据
+type fakeRecycleBin struct {
+	deleted map[uint]time.Time
+	purged  []int32
+}
+
+func (b *fakeRecycleBin) idsBefore(before time.Time) []uint {
+	var ids []uint
+	for id := uint(1); id <= uint(len(b.deleted))+1; id++ {
+		if at, ok := b.deleted[id]; ok && at.Before(before) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (b *fakeRecycleBin) purge(id int32) {
+	delete(b.deleted, uint(id))
+	b.purged = append(b.purged, id)
+}
+
+type fakeRecycleStudentRepo struct {
+	StudentRepo
+	fakeRecycleBin
+}
+
+func (r *fakeRecycleStudentRepo) ListDeletedStudentIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return r.idsBefore(before), nil
+}
+
+func (r *fakeRecycleStudentRepo) PurgeStudent(ctx context.Context, id int32) error {
+	r.purge(id)
+	return nil
+}
+
+type fakeRecycleUserRepo struct {
+	UserRepo
+	fakeRecycleBin
+}
+
+func (r *fakeRecycleUserRepo) ListDeletedUserIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return r.idsBefore(before), nil
+}
+
+func (r *fakeRecycleUserRepo) PurgeUser(ctx context.Context, id int32) error {
+	r.purge(id)
+	return nil
+}
+
+type fakeRecycleRBACRepo struct {
+	RBACRepo
+	roles fakeRecycleBin
+	// Casbin 中用户的角色
+	userRoles map[string][]string
+	// Casbin 中用户的权限
+	permissions map[string][][]string
+}
+
+func (r *fakeRecycleRBACRepo) ListDeletedRoleIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return r.roles.idsBefore(before), nil
+}
+
+func (r *fakeRecycleRBACRepo) PurgeRole(ctx context.Context, id int32) error {
+	r.roles.purge(id)
+	return nil
+}
+
+func (r *fakeRecycleRBACRepo) ListDeletedPermissionIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return nil, nil
+}
+
+func (r *fakeRecycleRBACRepo) GetRolesForUser(ctx context.Context, user string) ([]string, error) {
+	return r.userRoles[user], nil
+}
+
+func (r *fakeRecycleRBACRepo) GetPermissionsForUser(ctx context.Context, user string) ([][]string, error) {
+	return r.permissions[user], nil
+}
+
+func (r *fakeRecycleRBACRepo) RemoveRoleForUser(ctx context.Context, user, role string) error {
+	delete(r.userRoles, user)
+	return nil
+}
+
+func TestRecycleBinUsecase_PurgeExpired(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.Local)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		retention    time.Duration
+		wantPurged   int
+		wantStudents []int32
+		wantUsers    []int32
+		wantRoles    []int32
+	}{
+		{name: "未配置保留期限时不清理", retention: 0},
+		{name: "只清理超过保留期限的数据", retention: 30 * day, wantPurged: 3, wantStudents: []int32{1}, wantUsers: []int32{2}, wantRoles: []int32{1}},
+		{name: "保留期限较短时清理更多", retention: day, wantPurged: 5, wantStudents: []int32{1, 2}, wantUsers: []int32{1, 2}, wantRoles: []int32{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			students := &fakeRecycleStudentRepo{fakeRecycleBin: fakeRecycleBin{deleted: map[uint]time.Time{
+				1: now.Add(-40 * day),
+				2: now.Add(-2 * day),
+				3: now.Add(-time.Hour),
+			}}}
+			users := &fakeRecycleUserRepo{fakeRecycleBin: fakeRecycleBin{deleted: map[uint]time.Time{
+				1: now.Add(-3 * day),
+				2: now.Add(-31 * day),
+			}}}
+			rbac := &fakeRecycleRBACRepo{
+				roles:     fakeRecycleBin{deleted: map[uint]time.Time{1: now.Add(-60 * day)}},
+				userRoles: map[string][]string{"1": {"teacher"}, "2": {"admin"}, "3": {"student"}},
+			}
+			uc := NewRecycleBinUsecase(students, users, rbac, NewRBACUsecase(rbac, log.DefaultLogger, nil), &fakeSearchIndex{}, &conf.RecycleBin{Retention: durationpb.New(tt.retention)}, log.DefaultLogger)
+			uc.now = func() time.Time { return now }
+
+			purged, err := uc.PurgeExpired(context.Background())
+			if err != nil {
+				t.Fatalf("PurgeExpired() error = %v", err)
+			}
+			if purged != tt.wantPurged {
+				t.Errorf("purged = %d, want %d", purged, tt.wantPurged)
+			}
+			if !reflect.DeepEqual(students.purged, tt.wantStudents) {
+				t.Errorf("students = %v, want %v", students.purged, tt.wantStudents)
+			}
+			if !reflect.DeepEqual(users.purged, tt.wantUsers) {
+				t.Errorf("users = %v, want %v", users.purged, tt.wantUsers)
+			}
+			if !reflect.DeepEqual(rbac.roles.purged, tt.wantRoles) {
+				t.Errorf("roles = %v, want %v", rbac.roles.purged, tt.wantRoles)
+			}
+			// 彻底删除的用户不再保留 Casbin 中的角色
+			for _, id := range users.purged {
+				if roles := rbac.userRoles[strconv.Itoa(int(id))]; len(roles) > 0 {
+					t.Errorf("用户 %d 仍有角色 %v", id, roles)
+				}
+			}
+			if _, ok := rbac.userRoles["3"]; !ok {
+				t.Error("未删除用户的角色被移除")
+			}
+		})
+	}
+}
+
+func TestRecycleBinUsecase_Authorize(t *testing.T) {
+	ctx := context.Background()
+	students := &fakeRecycleStudentRepo{fakeRecycleBin: fakeRecycleBin{deleted: map[uint]time.Time{1: time.Now()}}}
+	rbac := &fakeRecycleRBACRepo{permissions: map[string][][]string{
+		"1": {{"admin", "/v1/recycle-bin*", "*"}},
+		"2": {{"teacher", "/v1/students*", "*"}},
+	}}
+	uc := NewRecycleBinUsecase(students, &fakeRecycleUserRepo{}, rbac, NewRBACUsecase(rbac, log.DefaultLogger, nil), &fakeSearchIndex{}, nil, log.DefaultLogger)
+
+	tests := []struct {
+		name       string
+		operatorID uint
+		wantErr    bool
+	}{
+		{name: "没有登录用户", operatorID: 0, wantErr: true},
+		{name: "没有回收站权限", operatorID: 2, wantErr: true},
+		{name: "有回收站权限", operatorID: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.PurgeStudent(ctx, tt.operatorID, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PurgeStudent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if !reflect.DeepEqual(students.purged, []int32{1}) {
+		t.Errorf("students = %v, want [1]", students.purged)
+	}
+}
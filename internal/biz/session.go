@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"student/internal/pkg/jwt"
+)
+
+// 会话最后活跃时间的更新间隔，避免每个请求都写数据库
+const sessionTouchInterval = time.Minute
+
+// ClientInfo 发起登录的客户端信息
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	// 通过单点登录签发令牌时为 OAuth 客户端ID，刷新令牌只能由该客户端使用
+	OAuthClientID string
+}
+
+// Session 登录会话，ID 与令牌族ID一致，写入访问令牌的 sid
+type Session struct {
+	ID         string     `gorm:"column:id;primaryKey"`
+	UserID     uint       `gorm:"column:user_id"`
+	UserAgent  string     `gorm:"column:user_agent"`
+	IP         string     `gorm:"column:ip"`
+	CreatedAt  *time.Time `gorm:"column:created_at" json:"created_at"`
+	LastSeenAt *time.Time `gorm:"column:last_seen_at" json:"last_seen_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at" json:"revoked_at"`
+
+	// 是否为发起请求的当前会话
+	Current bool `gorm:"-" json:"current"`
+}
+
+// TableName 指定表名
+func (Session) TableName() string {
+	return "user_sessions"
+}
+
+// 定义 Session 的操作接口
+type SessionRepo interface {
+	CreateSession(ctx context.Context, session *Session) error
+	// 获取用户未撤销的会话
+	ListSessions(ctx context.Context, userID uint) ([]*Session, error)
+	// 标记会话已撤销，返回是否存在该会话
+	RevokeSession(ctx context.Context, userID uint, sessionID string, revokedAt time.Time) (bool, error)
+	// 标记用户除 keepSessionID 以外的会话已撤销，keepSessionID 为空时全部撤销
+	RevokeUserSessions(ctx context.Context, userID uint, keepSessionID string, revokedAt time.Time) error
+	// 更新最后活跃时间，interval 内重复调用会被忽略
+	TouchSession(ctx context.Context, sessionID string, seenAt time.Time, interval time.Duration) error
+}
+
+// 记录新会话，失败时不影响登录
+func (uc *UserUsecase) recordSession(ctx context.Context, sessionID string, userID uint, client ClientInfo) {
+	now := uc.now()
+	err := uc.sessionRepo.CreateSession(ctx, &Session{
+		ID:         sessionID,
+		UserID:     userID,
+		UserAgent:  truncate(client.UserAgent, 255),
+		IP:         client.IP,
+		CreatedAt:  &now,
+		LastSeenAt: &now,
+	})
+	if err != nil {
+		uc.log.Error("记录登录会话失败", err)
+	}
+}
+
+// 获取用户当前有效的会话，currentSessionID 对应的会话会被标记为当前会话
+func (uc *UserUsecase) ListSessions(ctx context.Context, userID uint, currentSessionID string) ([]*Session, error) {
+	uc.log.Info("list sessions", userID)
+
+	sessions, err := uc.sessionRepo.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 令牌族过期或被撤销的会话不再展示
+	active := make([]*Session, 0, len(sessions))
+	for _, s := range sessions {
+		ok, err := uc.tokenRepo.IsTokenFamilyActive(ctx, s.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		s.Current = s.ID == currentSessionID
+		active = append(active, s)
+	}
+	return active, nil
+}
+
+// 撤销用户的指定会话，会话下的访问令牌和刷新令牌立即失效
+func (uc *UserUsecase) RevokeSession(ctx context.Context, userID uint, sessionID string) (bool, error) {
+	uc.log.Info("revoke session", userID, sessionID)
+
+	ok, err := uc.sessionRepo.RevokeSession(ctx, userID, sessionID, uc.now())
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := uc.tokenRepo.RevokeTokenFamily(ctx, sessionID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// TouchSession 更新会话最后活跃时间，由认证中间件调用
+func (uc *UserUsecase) TouchSession(ctx context.Context, claims *jwt.Claims) {
+	if claims.SessionID == "" {
+		return
+	}
+	if err := uc.sessionRepo.TouchSession(ctx, claims.SessionID, uc.now(), sessionTouchInterval); err != nil {
+		uc.log.Error("更新会话活跃时间失败", err)
+	}
+}
+
+// 标记会话已撤销，失败时只记录日志
+func (uc *UserUsecase) markSessionsRevoked(ctx context.Context, userID uint, keepSessionID string) {
+	if err := uc.sessionRepo.RevokeUserSessions(ctx, userID, keepSessionID, uc.now()); err != nil {
+		uc.log.Error("标记会话撤销失败", err)
+	}
+}
+
+// 按字节截断字符串，并去掉被截断的不完整字符
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeSessionRepo struct {
+	SessionRepo
+	sessions map[string]*Session
+	touched  int
+}
+
+func newFakeSessionRepo() *fakeSessionRepo {
+	return &fakeSessionRepo{sessions: map[string]*Session{}}
+}
+
+func (r *fakeSessionRepo) CreateSession(ctx context.Context, session *Session) error {
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func (r *fakeSessionRepo) ListSessions(ctx context.Context, userID uint) ([]*Session, error) {
+	var sessions []*Session
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.RevokedAt == nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *fakeSessionRepo) RevokeSession(ctx context.Context, userID uint, sessionID string, revokedAt time.Time) (bool, error) {
+	s, ok := r.sessions[sessionID]
+	if !ok || s.UserID != userID || s.RevokedAt != nil {
+		return false, nil
+	}
+	s.RevokedAt = &revokedAt
+	return true, nil
+}
+
+func (r *fakeSessionRepo) RevokeUserSessions(ctx context.Context, userID uint, keepSessionID string, revokedAt time.Time) error {
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.ID != keepSessionID && s.RevokedAt == nil {
+			s.RevokedAt = &revokedAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeSessionRepo) TouchSession(ctx context.Context, sessionID string, seenAt time.Time, interval time.Duration) error {
+	r.touched++
+	return nil
+}
+
+type fakeFamilyTokenRepo struct {
+	TokenRepo
+	revoked map[string]bool
+}
+
+func (r *fakeFamilyTokenRepo) IsTokenFamilyActive(ctx context.Context, familyID string) (bool, error) {
+	return !r.revoked[familyID], nil
+}
+
+func (r *fakeFamilyTokenRepo) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	r.revoked[familyID] = true
+	return nil
+}
+
+func TestUserUsecase_Sessions(t *testing.T) {
+	ctx := context.Background()
+	sessions := newFakeSessionRepo()
+	tokens := &fakeFamilyTokenRepo{revoked: map[string]bool{}}
+	uc := NewUserUsecase(nil, tokens, nil, sessions, nil, nil, nil, nil, log.DefaultLogger)
+
+	uc.recordSession(ctx, "s1", 1, ClientInfo{IP: "10.0.0.1", UserAgent: "curl/8.0"})
+	uc.recordSession(ctx, "s2", 1, ClientInfo{IP: "10.0.0.2", UserAgent: "Mozilla/5.0"})
+	uc.recordSession(ctx, "s3", 2, ClientInfo{IP: "10.0.0.3"})
+	uc.recordSession(ctx, "expired", 1, ClientInfo{})
+	tokens.revoked["expired"] = true
+
+	list, err := uc.ListSessions(ctx, 1, "s1")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("会话数量 = %d, want 2", len(list))
+	}
+	for _, s := range list {
+		if s.Current != (s.ID == "s1") {
+			t.Errorf("会话 %s Current = %v", s.ID, s.Current)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		userID    uint
+		sessionID string
+		want      bool
+	}{
+		{name: "撤销其他用户的会话", userID: 1, sessionID: "s3", want: false},
+		{name: "撤销不存在的会话", userID: 1, sessionID: "missing", want: false},
+		{name: "撤销自己的会话", userID: 1, sessionID: "s2", want: true},
+		{name: "重复撤销", userID: 1, sessionID: "s2", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := uc.RevokeSession(ctx, tt.userID, tt.sessionID)
+			if err != nil {
+				t.Fatalf("RevokeSession() error = %v", err)
+			}
+			if ok != tt.want {
+				t.Errorf("RevokeSession(%q) = %v, want %v", tt.sessionID, ok, tt.want)
+			}
+		})
+	}
+
+	if !tokens.revoked["s2"] || tokens.revoked["s3"] {
+		t.Error("只应撤销 s2 的令牌族")
+	}
+
+	uc.TouchSession(ctx, &jwt.Claims{SessionID: "s1"})
+	uc.TouchSession(ctx, &jwt.Claims{})
+	if sessions.touched != 1 {
+		t.Errorf("TouchSession 调用次数 = %d, want 1", sessions.touched)
+	}
+}
@@ -2,8 +2,10 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
@@ -13,6 +15,62 @@ const (
 	TimeFormat = "2006-01-02 15:04:05"
 )
 
+// 学籍状态
+const (
+	// 申请中，新建学生的默认状态
+	StudentStatusApplicant = 0
+	// 在读
+	StudentStatusEnrolled = 1
+	// 休学
+	StudentStatusSuspended = 2
+	// 毕业
+	StudentStatusGraduated = 3
+	// 退学
+	StudentStatusWithdrawn = 4
+)
+
+// 学籍状态允许的变动，毕业和退学为终态
+var studentStatusTransitions = map[int][]int{
+	StudentStatusApplicant: {StudentStatusEnrolled, StudentStatusWithdrawn},
+	StudentStatusEnrolled:  {StudentStatusSuspended, StudentStatusGraduated, StudentStatusWithdrawn},
+	StudentStatusSuspended: {StudentStatusEnrolled, StudentStatusWithdrawn},
+}
+
+// 错误原因，与错误码 2016 对应
+const ReasonInvalidStatusTransition = "INVALID_STATUS_TRANSITION"
+
+// 学籍状态不允许从 from 变为 to
+func ErrorInvalidStatusTransition(from, to int) error {
+	return errors.Conflict(ReasonInvalidStatusTransition, "学籍状态不能从"+StudentStatusName(from)+"变为"+StudentStatusName(to))
+}
+
+// StudentStatusName 学籍状态名称
+func StudentStatusName(status int) string {
+	switch status {
+	case StudentStatusApplicant:
+		return "申请中"
+	case StudentStatusEnrolled:
+		return "在读"
+	case StudentStatusSuspended:
+		return "休学"
+	case StudentStatusGraduated:
+		return "毕业"
+	case StudentStatusWithdrawn:
+		return "退学"
+	}
+	return "未知状态"
+}
+
+// CanTransitionStudentStatus 学籍状态能否从 from 变为 to
+func CanTransitionStudentStatus(from, to int) bool {
+	for _, next := range studentStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
 // Student is a Student model.
 type Student struct {
 	// MODEL
@@ -21,6 +79,7 @@ type Student struct {
 	Info      string
 	Status    int
 	Age       int
+	Version   uint            `gorm:"column:version;default:1" json:"version"` // 版本号，每次修改加一，用于乐观锁
 	CreatedAt *time.Time      `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt *time.Time      `gorm:"column:updated_at" json:"updated_at"`
 	DeletedAt *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
@@ -53,9 +112,38 @@ type StudentForm struct {
 	Status int
 	Age    int
 	ID     uint
+	// 读取时的版本号，更新时必须提供
+	Version uint
 	// 其他字段...
 }
 
+// StudentStatusChange 学籍变动记录
+type StudentStatusChange struct {
+	ID         uint
+	StudentID  uint `gorm:"column:student_id"`
+	FromStatus int  `gorm:"column:from_status"`
+	ToStatus   int  `gorm:"column:to_status"`
+	TermID     uint `gorm:"column:term_id"`
+	Reason     string
+	// 操作人的用户ID
+	OperatorID uint       `gorm:"column:operator_id"`
+	CreatedAt  *time.Time `gorm:"column:created_at" json:"created_at"`
+
+	Term *AcademicTerm `gorm:"foreignKey:TermID" json:"term,omitempty"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (StudentStatusChange) TableName() string {
+	return "student_status_changes"
+}
+
+// FormatTimeFields 格式化时间字段
+func (c *StudentStatusChange) FormatTimeFields() {
+	c.CreatedAtStr, _ = formatTimes(c.CreatedAt, nil)
+}
+
 type CreateStudentMessage struct {
 	ID      int32
 	Message string
@@ -63,6 +151,8 @@ type CreateStudentMessage struct {
 
 type UpdateStudentMessage struct {
 	Message string
+	// 更新后的版本号
+	Version uint
 }
 
 type DeleteStudentMessage struct {
@@ -73,21 +163,46 @@ type DeleteStudentMessage struct {
 type StudentRepo interface {
 	GetStudent(ctx context.Context, id int32) (*Student, error)
 	CreateStudent(ctx context.Context, s *StudentForm) (*CreateStudentMessage, error)
-	UpdateStudent(ctx context.Context, id int32, s *StudentForm) (*UpdateStudentMessage, error)
-	DeleteStudent(ctx context.Context, id int32) (*DeleteStudentMessage, error)
-	ListStudents(ctx context.Context, page int32, pageSize int32, name string) ([]*Student, int32, error)
+	// 只更新 columns 中的列
+	// 版本号与 s.Version 一致时只更新 columns 中的列并把版本号加一，否则返回 ErrorVersionConflict
+	UpdateStudent(ctx context.Context, id int32, s *StudentForm, columns []string) (*UpdateStudentMessage, error)
+	// 版本号与 version 一致时删除学生，否则返回 ErrorVersionConflict
+	DeleteStudent(ctx context.Context, id int32, version uint) (*DeleteStudentMessage, error)
+	// 返回一页学生、下一页的令牌和符合条件的总数
+	ListStudents(ctx context.Context, q *ListQuery) ([]*Student, string, int32, error)
+	// 按与 ListStudents 相同的条件逐行读取所有学生，fn 返回错误时停止
+	ExportStudents(ctx context.Context, name string, fn func(*Student) error) error
+	// 学生状态仍为 change.FromStatus 时更新状态、版本号加一并写入变动记录，状态已被修改时返回 ErrorInvalidStatusTransition
+	ChangeStudentStatus(ctx context.Context, change *StudentStatusChange) error
+	// 在一个事务中分批创建学生，任意一批失败时全部回滚，返回创建的学生
+	CreateStudents(ctx context.Context, students []*StudentForm) ([]*Student, error)
+	// 按 ID 批量获取学生，不存在的 ID 忽略
+	GetStudentsByIDs(ctx context.Context, ids []uint) ([]*Student, error)
+	ListStatusChanges(ctx context.Context, studentID uint) ([]*StudentStatusChange, error)
+
+	// 回收站：按删除时间倒序返回已删除的学生和总数
+	ListDeletedStudents(ctx context.Context, page, pageSize int32) ([]*Student, int32, error)
+	// 清空删除时间，学生不在回收站中时返回 404
+	RestoreStudent(ctx context.Context, id int32) (*Student, error)
+	// 在一个事务中删除回收站中的学生及其选课、成绩、考勤、监护关系和学籍变动记录
+	PurgeStudent(ctx context.Context, id int32) error
+	ListDeletedStudentIDsBefore(ctx context.Context, before time.Time) ([]uint, error)
 }
 
 type StudentUsecase struct {
-	repo StudentRepo
-	log  *log.Helper
+	repo  StudentRepo
+	terms AcademicTermRepo
+	index SearchIndex
+	log   *log.Helper
 }
 
 // 初始化 StudentUsecase
-func NewStudentUsecase(repo StudentRepo, logger log.Logger) *StudentUsecase {
+func NewStudentUsecase(repo StudentRepo, terms AcademicTermRepo, index SearchIndex, logger log.Logger) *StudentUsecase {
 	return &StudentUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:  repo,
+		terms: terms,
+		index: index,
+		log:   log.NewHelper(logger),
 	}
 }
 
@@ -97,29 +212,167 @@ func (uc *StudentUsecase) Get(ctx context.Context, id int32) (*Student, error) {
 	return uc.repo.GetStudent(ctx, id)
 }
 
-// create student
+// 校验新学生，创建和批量导入使用相同的规则
+func validateNewStudent(s *StudentForm) error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "学生姓名不能为空")
+	}
+	if s.Age < 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "年龄不能为负数")
+	}
+	if s.Status != StudentStatusApplicant && s.Status != StudentStatusEnrolled {
+		return errors.BadRequest("INVALID_ARGUMENT", "新学生的学籍状态只能是申请中或在读")
+	}
+	return nil
+}
+
+// create student，新学生只能是申请中或在读
 func (uc *StudentUsecase) Create(ctx context.Context, s *StudentForm) (*CreateStudentMessage, error) {
 	uc.log.Info("create student", s)
-	return uc.repo.CreateStudent(ctx, s)
+	if err := validateNewStudent(s); err != nil {
+		return nil, err
+	}
+	msg, err := uc.repo.CreateStudent(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	uc.indexStudents(ctx, &Student{ID: uint(msg.ID), Name: s.Name, Info: s.Info, Status: s.Status, Age: s.Age})
+	return msg, nil
 }
 
-// update student
-func (uc *StudentUsecase) Update(ctx context.Context, id int32, s *StudentForm) (*UpdateStudentMessage, error) {
-	return uc.repo.UpdateStudent(ctx, id, s)
+// 学生可以更新的字段，status 只用于校验，不会写入
+var studentUpdateFields = UpdateFields{"name": "name", "age": "age", "info": "info", "status": "status"}
+
+// update student，paths 为 update_mask，为空时更新所有字段；学籍状态只能通过 ChangeStatus 修改
+// s.Version 为读取时的版本号，学生已被其他请求修改时返回 ErrorVersionConflict
+func (uc *StudentUsecase) Update(ctx context.Context, id int32, s *StudentForm, paths []string) (*UpdateStudentMessage, error) {
+	if err := requireVersion(s.Version); err != nil {
+		return nil, err
+	}
+	columns, err := studentUpdateFields.Resolve(paths)
+	if err != nil {
+		return nil, err
+	}
+	if hasColumn(columns, "name") && strings.TrimSpace(s.Name) == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "学生姓名不能为空")
+	}
+	if hasColumn(columns, "age") && s.Age < 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "年龄不能为负数")
+	}
+	current, err := uc.repo.GetStudent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Version != s.Version {
+		return nil, ErrorVersionConflict()
+	}
+	if hasColumn(columns, "status") && s.Status != current.Status {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "学籍状态请通过学籍变动接口修改")
+	}
+	columns = withoutColumn(columns, "status")
+	if len(columns) == 0 {
+		return &UpdateStudentMessage{Message: "Update student success", Version: current.Version}, nil
+	}
+	msg, err := uc.repo.UpdateStudent(ctx, id, s, columns)
+	if err != nil {
+		return nil, err
+	}
+	if hasColumn(columns, "name") {
+		current.Name = s.Name
+	}
+	if hasColumn(columns, "info") {
+		current.Info = s.Info
+	}
+	if hasColumn(columns, "age") {
+		current.Age = s.Age
+	}
+	uc.indexStudents(ctx, current)
+	return msg, nil
 }
 
-// delete student
-func (uc *StudentUsecase) Delete(ctx context.Context, id int32) (*DeleteStudentMessage, error) {
-	return uc.repo.DeleteStudent(ctx, id)
+// 变更学籍状态，必须填写原因，termCode 为空时使用当前学期
+func (uc *StudentUsecase) ChangeStatus(ctx context.Context, id int32, status int, reason, termCode string, operatorID uint) (*StudentStatusChange, error) {
+	uc.log.Info("change student status", id, status, operatorID)
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "请填写学籍变动原因")
+	}
+	student, err := uc.repo.GetStudent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !CanTransitionStudentStatus(student.Status, status) {
+		return nil, ErrorInvalidStatusTransition(student.Status, status)
+	}
+	term, err := resolveTerm(ctx, uc.terms, termCode, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	change := &StudentStatusChange{
+		StudentID:  student.ID,
+		FromStatus: student.Status,
+		ToStatus:   status,
+		TermID:     term.ID,
+		Reason:     reason,
+		OperatorID: operatorID,
+	}
+	if err := uc.repo.ChangeStudentStatus(ctx, change); err != nil {
+		return nil, err
+	}
+	change.Term = term
+	change.FormatTimeFields()
+	return change, nil
+}
+
+// 获取学生的学籍变动记录
+func (uc *StudentUsecase) ListStatusChanges(ctx context.Context, id int32) ([]*StudentStatusChange, error) {
+	if _, err := uc.repo.GetStudent(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListStatusChanges(ctx, uint(id))
 }
 
-// get list student
-func (uc *StudentUsecase) List(ctx context.Context, page int32, pageSize int32, name string) ([]*Student, int32, error) {
-	if page == 0 {
-		page = 1
+// delete student，version 为读取时的版本号
+func (uc *StudentUsecase) Delete(ctx context.Context, id int32, version uint) (*DeleteStudentMessage, error) {
+	if err := requireVersion(version); err != nil {
+		return nil, err
 	}
-	if pageSize == 0 {
-		pageSize = 10
+	msg, err := uc.repo.DeleteStudent(ctx, id, version)
+	if err != nil {
+		return nil, err
 	}
-	return uc.repo.ListStudents(ctx, page, pageSize, name)
+	if err := uc.index.DeleteStudent(ctx, uint(id)); err != nil {
+		uc.log.WithContext(ctx).Errorf("delete student %d from index failed: %v", id, err)
+	}
+	return msg, nil
+}
+
+// 学生列表可以过滤和排序的字段
+var StudentListSchema = ListSchema{
+	"id":         {Column: "id", Type: ListFieldInt},
+	"name":       {Column: "name", Type: ListFieldString},
+	"age":        {Column: "age", Type: ListFieldInt},
+	"status":     {Column: "status", Type: ListFieldInt},
+	"created_at": {Column: "created_at", Type: ListFieldTime, Nullable: true},
+	"updated_at": {Column: "updated_at", Type: ListFieldTime, Nullable: true},
+}
+
+// get list student，name 为旧的模糊查询参数
+func (uc *StudentUsecase) List(ctx context.Context, req ListRequest, name string) ([]*Student, string, int32, error) {
+	var extra []Filter
+	if name != "" {
+		extra = append(extra, Filter{Field: "name", Op: OpContains, Values: []any{name}})
+	}
+	q, err := StudentListSchema.Parse(req, extra...)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return uc.repo.ListStudents(ctx, q)
+}
+
+// 导出学生，逐行回调，不一次性加载所有数据
+func (uc *StudentUsecase) Export(ctx context.Context, name string, fn func(*Student) error) error {
+	uc.log.Info("export students", name)
+	return uc.repo.ExportStudents(ctx, name, fn)
 }
@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// StudentImportMaxRows 单次导入的最大行数，不含表头
+const StudentImportMaxRows = 5000
+
+// 导入文件的表头，支持英文和中文列名
+var studentImportColumns = map[string]string{
+	"name":   "name",
+	"姓名":     "name",
+	"age":    "age",
+	"年龄":     "age",
+	"status": "status",
+	"状态":     "status",
+	"学籍状态":   "status",
+	"info":   "info",
+	"备注":     "info",
+}
+
+// StudentImportError 导入时某一行的错误，Row 为文件中的行号，从 1 开始
+type StudentImportError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+// StudentImportResult 导入结果，DryRun 时只校验不写入
+type StudentImportResult struct {
+	DryRun bool
+	// 数据行数，不含表头和空行
+	Total    int
+	Valid    int
+	Imported int
+	Errors   []*StudentImportError
+}
+
+// 解析学籍状态，支持数字和状态名称，为空时为申请中
+func parseImportStatus(value string) (int, bool) {
+	if value == "" {
+		return StudentStatusApplicant, true
+	}
+	if status, err := strconv.Atoi(value); err == nil {
+		return status, true
+	}
+	for status := StudentStatusApplicant; status <= StudentStatusWithdrawn; status++ {
+		if StudentStatusName(status) == value {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// 解析一行数据，columns 为每列对应的字段
+func parseImportRow(row int, columns []string, cells []string) (*StudentForm, []*StudentImportError) {
+	form := &StudentForm{}
+	var rowErrors []*StudentImportError
+	for i, column := range columns {
+		if i >= len(cells) || column == "" {
+			continue
+		}
+		value := strings.TrimSpace(cells[i])
+		switch column {
+		case "name":
+			form.Name = value
+		case "info":
+			form.Info = value
+		case "age":
+			if value == "" {
+				continue
+			}
+			age, err := strconv.Atoi(value)
+			if err != nil {
+				rowErrors = append(rowErrors, &StudentImportError{Row: row, Column: column, Message: "年龄必须是整数"})
+				continue
+			}
+			form.Age = age
+		case "status":
+			status, ok := parseImportStatus(value)
+			if !ok {
+				rowErrors = append(rowErrors, &StudentImportError{Row: row, Column: column, Message: "无法识别的学籍状态 " + value})
+				continue
+			}
+			form.Status = status
+		}
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+	if err := validateNewStudent(form); err != nil {
+		return nil, []*StudentImportError{{Row: row, Message: errors.FromError(err).Message}}
+	}
+	return form, nil
+}
+
+// 空行
+func isBlankRow(cells []string) bool {
+	for _, c := range cells {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// 批量导入学生，第一个非空行为表头；有错误的行不导入，其余行在一个事务中写入
+func (uc *StudentUsecase) Import(ctx context.Context, rows [][]string, dryRun bool) (*StudentImportResult, error) {
+	header := 0
+	for header < len(rows) && isBlankRow(rows[header]) {
+		header++
+	}
+	if header == len(rows) {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "导入文件为空")
+	}
+
+	columns := make([]string, len(rows[header]))
+	seen := make(map[string]bool)
+	for i, name := range rows[header] {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		column, ok := studentImportColumns[strings.ToLower(name)]
+		if !ok {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "无法识别的列 "+name+"，支持的列为 name、age、status、info")
+		}
+		if seen[column] {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "重复的列 "+name)
+		}
+		seen[column] = true
+		columns[i] = column
+	}
+	if !seen["name"] {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "导入文件缺少 name 列")
+	}
+
+	result := &StudentImportResult{DryRun: dryRun}
+	var students []*StudentForm
+	for i := header + 1; i < len(rows); i++ {
+		if isBlankRow(rows[i]) {
+			continue
+		}
+		result.Total++
+		if result.Total > StudentImportMaxRows {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "单次最多导入 "+strconv.Itoa(StudentImportMaxRows)+" 行")
+		}
+		form, rowErrors := parseImportRow(i+1, columns, rows[i])
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		students = append(students, form)
+	}
+	if result.Total == 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "导入文件没有数据行")
+	}
+	result.Valid = len(students)
+	uc.log.Info("import students", result.Total, result.Valid, dryRun)
+
+	if dryRun || len(students) == 0 {
+		return result, nil
+	}
+	imported, err := uc.repo.CreateStudents(ctx, students)
+	if err != nil {
+		return nil, err
+	}
+	uc.indexStudents(ctx, imported...)
+	result.Imported = len(imported)
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func (r *fakeStudentRepo) CreateStudents(ctx context.Context, forms []*StudentForm) ([]*Student, error) {
+	r.imported = append(r.imported, forms...)
+	students := make([]*Student, 0, len(forms))
+	for i, s := range forms {
+		id := uint(len(r.imported) - len(forms) + i + 1)
+		students = append(students, &Student{ID: id, Name: s.Name, Info: s.Info, Status: s.Status, Age: s.Age})
+	}
+	return students, nil
+}
+
+func TestStudentUsecase_Import(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		rows         [][]string
+		dryRun       bool
+		wantCode     int
+		wantTotal    int
+		wantValid    int
+		wantImported int
+		wantErrRows  []int
+	}{
+		{name: "空文件", rows: [][]string{{"", ""}}, wantCode: 400},
+		{name: "缺少姓名列", rows: [][]string{{"age"}, {"18"}}, wantCode: 400},
+		{name: "无法识别的列", rows: [][]string{{"name", "grade"}, {"张三", "3"}}, wantCode: 400},
+		{name: "没有数据行", rows: [][]string{{"name"}, {""}}, wantCode: 400},
+		{
+			name:         "中文表头和状态名称",
+			rows:         [][]string{{"姓名", "年龄", "学籍状态", "备注"}, {"张三", "18", "在读", "一班"}, {"李四", "", "", ""}},
+			wantTotal:    2,
+			wantValid:    2,
+			wantImported: 2,
+		},
+		{
+			name: "有错误的行不导入",
+			rows: [][]string{
+				{"name", "age", "status"},
+				{"张三", "18", "1"},
+				{"", "18", "1"},
+				{},
+				{"王五", "十八", "毕业"},
+				{"赵六", "20", "3"},
+			},
+			wantTotal:    4,
+			wantValid:    1,
+			wantImported: 1,
+			wantErrRows:  []int{3, 5, 6},
+		},
+		{
+			name:        "试运行不写入",
+			rows:        [][]string{{"name", "age"}, {"张三", "18"}, {"李四", "-1"}},
+			dryRun:      true,
+			wantTotal:   2,
+			wantValid:   1,
+			wantErrRows: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeStudentRepo{}
+			index := &fakeSearchIndex{}
+			uc := NewStudentUsecase(repo, &fakeAcademicTermRepo{}, index, log.DefaultLogger)
+			result, err := uc.Import(ctx, tt.rows, tt.dryRun)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("Import() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			if result.Total != tt.wantTotal || result.Valid != tt.wantValid || result.Imported != tt.wantImported || len(repo.imported) != tt.wantImported {
+				t.Errorf("result = %+v, imported = %d", result, len(repo.imported))
+			}
+			if len(index.docs) != tt.wantImported {
+				t.Errorf("indexed = %d, want %d", len(index.docs), tt.wantImported)
+			}
+			if len(result.Errors) != len(tt.wantErrRows) {
+				t.Fatalf("errors = %d, want %d", len(result.Errors), len(tt.wantErrRows))
+			}
+			for i, e := range result.Errors {
+				if e.Row != tt.wantErrRows[i] || e.Message == "" {
+					t.Errorf("errors[%d] = %+v, want row %d", i, e, tt.wantErrRows[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 搜索关键词的最大长度
+const maxSearchQueryLength = 100
+
+// SearchQuery 学生搜索条件
+type SearchQuery struct {
+	Query  string
+	Offset int
+	Limit  int
+}
+
+// SearchHit 一条搜索结果，Highlights 的键为字段名，值为带 <mark> 标记的片段
+type SearchHit struct {
+	ID         uint
+	Score      float64
+	Highlights map[string][]string
+}
+
+// SearchResult 搜索结果，按相关度从高到低排列
+type SearchResult struct {
+	Total int
+	Hits  []*SearchHit
+}
+
+// StudentSearchHit 搜索到的学生
+type StudentSearchHit struct {
+	Student    *Student
+	Score      float64
+	Highlights map[string][]string
+}
+
+// 定义 学生搜索索引 的操作接口，按姓名和备注全文检索
+// 学生的增删改通过 StudentUsecase 同步到索引，由数据库自行维护的实现可以忽略同步
+type SearchIndex interface {
+	IndexStudents(ctx context.Context, students []*Student) error
+	DeleteStudent(ctx context.Context, id uint) error
+	SearchStudents(ctx context.Context, q *SearchQuery) (*SearchResult, error)
+}
+
+// 同步学生到搜索索引，数据库已经写入成功，同步失败只记录日志，重建索引后恢复一致
+func (uc *StudentUsecase) indexStudents(ctx context.Context, students ...*Student) {
+	if err := uc.index.IndexStudents(ctx, students); err != nil {
+		uc.log.WithContext(ctx).Errorf("index students failed: %v", err)
+	}
+}
+
+// 全文搜索学生，结果按相关度排序，学生信息从数据库读取
+func (uc *StudentUsecase) Search(ctx context.Context, query string, page, pageSize int32) ([]*StudentSearchHit, int32, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, errors.BadRequest("INVALID_ARGUMENT", "搜索关键词不能为空")
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, 0, errors.BadRequest("INVALID_ARGUMENT", "搜索关键词过长")
+	}
+	page, pageSize = normalizePage(page, pageSize)
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	result, err := uc.index.SearchStudents(ctx, &SearchQuery{
+		Query:  query,
+		Offset: int((page - 1) * pageSize),
+		Limit:  int(pageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(result.Hits) == 0 {
+		return nil, int32(result.Total), nil
+	}
+
+	ids := make([]uint, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+	students, err := uc.repo.GetStudentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]*Student, len(students))
+	for _, s := range students {
+		byID[s.ID] = s
+	}
+	hits := make([]*StudentSearchHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		// 索引尚未同步删除的学生直接跳过
+		s, ok := byID[hit.ID]
+		if !ok {
+			continue
+		}
+		hits = append(hits, &StudentSearchHit{Student: s, Score: hit.Score, Highlights: hit.Highlights})
+	}
+	return hits, int32(result.Total), nil
+}
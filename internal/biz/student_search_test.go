@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 在内存中按姓名子串匹配的搜索索引
+type fakeSearchIndex struct {
+	docs  map[uint]*Student
+	query *SearchQuery
+}
+
+func (f *fakeSearchIndex) IndexStudents(ctx context.Context, students []*Student) error {
+	if f.docs == nil {
+		f.docs = make(map[uint]*Student)
+	}
+	for _, s := range students {
+		f.docs[s.ID] = s
+	}
+	return nil
+}
+
+func (f *fakeSearchIndex) DeleteStudent(ctx context.Context, id uint) error {
+	delete(f.docs, id)
+	return nil
+}
+
+func (f *fakeSearchIndex) SearchStudents(ctx context.Context, q *SearchQuery) (*SearchResult, error) {
+	f.query = q
+	result := &SearchResult{}
+	for id := uint(1); id <= uint(len(f.docs))+1; id++ {
+		if s, ok := f.docs[id]; ok && strings.Contains(s.Name, q.Query) {
+			result.Hits = append(result.Hits, &SearchHit{ID: id, Score: float64(id), Highlights: map[string][]string{"name": {"<mark>" + s.Name + "</mark>"}}})
+		}
+	}
+	result.Total = len(result.Hits)
+	return result, nil
+}
+
+func (r *fakeStudentRepo) CreateStudent(ctx context.Context, s *StudentForm) (*CreateStudentMessage, error) {
+	if r.students == nil {
+		r.students = make(map[int32]*Student)
+	}
+	id := int32(len(r.students) + 1)
+	r.students[id] = &Student{ID: uint(id), Name: s.Name, Info: s.Info, Status: s.Status, Age: s.Age, Version: 1}
+	return &CreateStudentMessage{ID: id}, nil
+}
+
+func (r *fakeStudentRepo) DeleteStudent(ctx context.Context, id int32, version uint) (*DeleteStudentMessage, error) {
+	delete(r.students, id)
+	return &DeleteStudentMessage{}, nil
+}
+
+func (r *fakeStudentRepo) GetStudentsByIDs(ctx context.Context, ids []uint) ([]*Student, error) {
+	var students []*Student
+	for _, id := range ids {
+		if s, ok := r.students[int32(id)]; ok {
+			students = append(students, s)
+		}
+	}
+	return students, nil
+}
+
+func TestStudentUsecase_Search(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeStudentRepo{}
+	index := &fakeSearchIndex{}
+	uc := NewStudentUsecase(repo, &fakeAcademicTermRepo{}, index, log.DefaultLogger)
+	for _, name := range []string{"张三", "李四", "张三丰"} {
+		if _, err := uc.Create(ctx, &StudentForm{Name: name, Status: StudentStatusEnrolled}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := uc.Update(ctx, 2, &StudentForm{Name: "张四", Version: 1}, []string{"name"}); err != nil {
+		t.Fatal(err)
+	}
+	// 索引中残留已删除学生时不返回
+	delete(repo.students, 3)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantIDs  []uint
+		wantCode int
+	}{
+		{name: "创建和更新后可以搜索到", query: "张", wantIDs: []uint{1, 2}},
+		{name: "更新前的姓名搜索不到", query: "李四"},
+		{name: "关键词为空", query: "  ", wantCode: 400},
+		{name: "关键词过长", query: strings.Repeat("张", maxSearchQueryLength+1), wantCode: 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, _, err := uc.Search(ctx, tt.query, 0, 1000)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("Search() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			var ids []uint
+			for _, hit := range hits {
+				ids = append(ids, hit.Student.ID)
+				if len(hit.Highlights["name"]) == 0 {
+					t.Errorf("hit %d 没有高亮片段", hit.Student.ID)
+				}
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if index.query.Limit != MaxPageSize || index.query.Offset != 0 {
+				t.Errorf("query = %+v", index.query)
+			}
+		})
+	}
+
+	if _, err := uc.Delete(ctx, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := index.docs[1]; ok {
+		t.Error("删除的学生仍在索引中")
+	}
+}
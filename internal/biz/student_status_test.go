@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeStudentRepo struct {
+	StudentRepo
+	students map[int32]*Student
+	changes  []*StudentStatusChange
+	updated  *StudentForm
+	columns  []string
+	imported []*StudentForm
+}
+
+func (r *fakeStudentRepo) GetStudent(ctx context.Context, id int32) (*Student, error) {
+	if s, ok := r.students[id]; ok {
+		copied := *s
+		return &copied, nil
+	}
+	return nil, errors.NotFound("NOT_FOUND", "学生不存在")
+}
+
+func (r *fakeStudentRepo) UpdateStudent(ctx context.Context, id int32, s *StudentForm, columns []string) (*UpdateStudentMessage, error) {
+	r.updated = s
+	r.columns = columns
+	return &UpdateStudentMessage{Message: "Update student success"}, nil
+}
+
+func (r *fakeStudentRepo) ChangeStudentStatus(ctx context.Context, change *StudentStatusChange) error {
+	s := r.students[int32(change.StudentID)]
+	if s.Status != change.FromStatus {
+		return ErrorInvalidStatusTransition(change.FromStatus, change.ToStatus)
+	}
+	s.Status = change.ToStatus
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func TestCanTransitionStudentStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		from int
+		to   int
+		want bool
+	}{
+		{name: "申请中入学", from: StudentStatusApplicant, to: StudentStatusEnrolled, want: true},
+		{name: "申请中不能直接毕业", from: StudentStatusApplicant, to: StudentStatusGraduated},
+		{name: "在读休学", from: StudentStatusEnrolled, to: StudentStatusSuspended, want: true},
+		{name: "在读毕业", from: StudentStatusEnrolled, to: StudentStatusGraduated, want: true},
+		{name: "休学复学", from: StudentStatusSuspended, to: StudentStatusEnrolled, want: true},
+		{name: "休学不能直接毕业", from: StudentStatusSuspended, to: StudentStatusGraduated},
+		{name: "毕业为终态", from: StudentStatusGraduated, to: StudentStatusEnrolled},
+		{name: "退学为终态", from: StudentStatusWithdrawn, to: StudentStatusEnrolled},
+		{name: "状态不变", from: StudentStatusEnrolled, to: StudentStatusEnrolled},
+		{name: "未知状态", from: StudentStatusEnrolled, to: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanTransitionStudentStatus(tt.from, tt.to); got != tt.want {
+				t.Errorf("CanTransitionStudentStatus(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentUsecase_ChangeStatus(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now()
+	terms := &fakeAcademicTermRepo{terms: []*AcademicTerm{
+		{ID: 1, Code: "2024-2025-2", StartDate: today.AddDate(-1, 0, 0), EndDate: today.AddDate(0, -6, 0)},
+		{ID: 2, Code: "2025-2026-1", StartDate: today.AddDate(0, -1, 0), EndDate: today.AddDate(0, 3, 0)},
+	}}
+
+	tests := []struct {
+		name       string
+		status     int
+		to         int
+		reason     string
+		term       string
+		wantReason string
+		wantTermID uint
+	}{
+		{name: "缺少原因", status: StudentStatusEnrolled, to: StudentStatusSuspended, reason: " ", wantReason: "INVALID_ARGUMENT"},
+		{name: "不允许的变动", status: StudentStatusGraduated, to: StudentStatusEnrolled, reason: "误操作", wantReason: ReasonInvalidStatusTransition},
+		{name: "学期不存在", status: StudentStatusEnrolled, to: StudentStatusSuspended, reason: "病假", term: "2030-2031-1", wantReason: "NOT_FOUND"},
+		{name: "默认使用当前学期", status: StudentStatusEnrolled, to: StudentStatusSuspended, reason: "病假", wantTermID: 2},
+		{name: "指定学期", status: StudentStatusEnrolled, to: StudentStatusGraduated, reason: "完成学业", term: "2024-2025-2", wantTermID: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeStudentRepo{students: map[int32]*Student{1: {ID: 1, Name: "测试学生", Status: tt.status}}}
+			uc := NewStudentUsecase(repo, terms, &fakeSearchIndex{}, log.DefaultLogger)
+			change, err := uc.ChangeStatus(ctx, 1, tt.to, tt.reason, tt.term, 7)
+			if tt.wantReason != "" {
+				if errors.Reason(err) != tt.wantReason {
+					t.Errorf("ChangeStatus() error = %v, want reason %s", err, tt.wantReason)
+				}
+				if len(repo.changes) != 0 || repo.students[1].Status != tt.status {
+					t.Error("失败时不应修改学籍状态")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ChangeStatus() error = %v", err)
+			}
+			if repo.students[1].Status != tt.to || len(repo.changes) != 1 {
+				t.Fatalf("status = %d, changes = %d", repo.students[1].Status, len(repo.changes))
+			}
+			if change.FromStatus != tt.status || change.TermID != tt.wantTermID || change.OperatorID != 7 || change.Reason != tt.reason {
+				t.Errorf("change = %+v", change)
+			}
+		})
+	}
+}
+
+func TestStudentUsecase_UpdateKeepsStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeStudentRepo{students: map[int32]*Student{1: {ID: 1, Name: "测试学生", Status: StudentStatusEnrolled, Version: 1}}}
+	uc := NewStudentUsecase(repo, &fakeAcademicTermRepo{}, &fakeSearchIndex{}, log.DefaultLogger)
+
+	if _, err := uc.Update(ctx, 1, &StudentForm{Name: "新名字", Status: StudentStatusGraduated, Version: 1}, nil); err == nil {
+		t.Error("Update() 不应允许修改学籍状态")
+	}
+	if _, err := uc.Update(ctx, 1, &StudentForm{Name: "新名字", Status: StudentStatusEnrolled, Version: 1}, nil); err != nil || repo.updated == nil {
+		t.Errorf("Update() error = %v", err)
+	}
+}
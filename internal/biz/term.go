@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AcademicTerm 学期，班级开课和学籍变动都关联到学期
+type AcademicTerm struct {
+	ID uint
+	// 学期编号，唯一，如 2025-2026-1
+	Code      string
+	Name      string
+	StartDate time.Time  `gorm:"column:start_date;type:date"`
+	EndDate   time.Time  `gorm:"column:end_date;type:date"`
+	CreatedAt *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt *time.Time `gorm:"column:updated_at" json:"updated_at"`
+
+	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"`
+	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"`
+}
+
+// TableName 指定表名
+func (AcademicTerm) TableName() string {
+	return "academic_terms"
+}
+
+// FormatTimeFields 格式化时间字段
+func (t *AcademicTerm) FormatTimeFields() {
+	t.CreatedAtStr, t.UpdatedAtStr = formatTimes(t.CreatedAt, t.UpdatedAt)
+}
+
+// Contains 日期是否在学期内，起止日期都包含在内
+func (t *AcademicTerm) Contains(date time.Time) bool {
+	day := date.Format(DateFormat)
+	return day >= t.StartDate.Format(DateFormat) && day <= t.EndDate.Format(DateFormat)
+}
+
+type AcademicTermForm struct {
+	Code      string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// 定义 AcademicTerm 的操作接口
+type AcademicTermRepo interface {
+	GetTerm(ctx context.Context, id uint) (*AcademicTerm, error)
+	GetTermByCode(ctx context.Context, code string) (*AcademicTerm, error)
+	// 获取包含指定日期的学期，没有时返回 404
+	GetTermByDate(ctx context.Context, date time.Time) (*AcademicTerm, error)
+	CreateTerm(ctx context.Context, t *AcademicTermForm) (*AcademicTerm, error)
+	UpdateTerm(ctx context.Context, id uint, t *AcademicTermForm) (*AcademicTerm, error)
+	ListTerms(ctx context.Context, page, pageSize int32) ([]*AcademicTerm, int32, error)
+	// 统计与日期范围重叠的学期数量，excludeID 为更新时排除的学期
+	CountOverlappingTerms(ctx context.Context, start, end time.Time, excludeID uint) (int64, error)
+}
+
+type AcademicTermUsecase struct {
+	repo AcademicTermRepo
+	log  *log.Helper
+}
+
+// 初始化 AcademicTermUsecase
+func NewAcademicTermUsecase(repo AcademicTermRepo, logger log.Logger) *AcademicTermUsecase {
+	return &AcademicTermUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 通过 id 获取学期
+func (uc *AcademicTermUsecase) GetTerm(ctx context.Context, id uint) (*AcademicTerm, error) {
+	return uc.repo.GetTerm(ctx, id)
+}
+
+// 创建学期，学期之间的日期不能重叠
+func (uc *AcademicTermUsecase) CreateTerm(ctx context.Context, t *AcademicTermForm) (*AcademicTerm, error) {
+	uc.log.Info("create academic term", t.Code)
+	if err := uc.validateTerm(ctx, t, 0); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateTerm(ctx, t)
+}
+
+// 更新学期
+func (uc *AcademicTermUsecase) UpdateTerm(ctx context.Context, id uint, t *AcademicTermForm) (*AcademicTerm, error) {
+	uc.log.Info("update academic term", id)
+	if err := uc.validateTerm(ctx, t, id); err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateTerm(ctx, id, t)
+}
+
+// 获取学期列表
+func (uc *AcademicTermUsecase) ListTerms(ctx context.Context, page, pageSize int32) ([]*AcademicTerm, int32, error) {
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListTerms(ctx, page, pageSize)
+}
+
+func (uc *AcademicTermUsecase) validateTerm(ctx context.Context, t *AcademicTermForm, id uint) error {
+	t.Code = strings.TrimSpace(t.Code)
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Code == "" || t.Name == "" {
+		return errors.BadRequest("INVALID_ARGUMENT", "学期编号和名称不能为空")
+	}
+	if t.StartDate.IsZero() || t.EndDate.IsZero() || t.EndDate.Before(t.StartDate) {
+		return errors.BadRequest("INVALID_ARGUMENT", "学期起止日期无效")
+	}
+	overlapping, err := uc.repo.CountOverlappingTerms(ctx, t.StartDate, t.EndDate, id)
+	if err != nil {
+		return err
+	}
+	if overlapping > 0 {
+		return errors.BadRequest("INVALID_ARGUMENT", "学期日期与其他学期重叠")
+	}
+	return nil
+}
+
+// 解析学期编号，为空时使用包含指定日期的学期
+func resolveTerm(ctx context.Context, repo AcademicTermRepo, code string, at time.Time) (*AcademicTerm, error) {
+	code = strings.TrimSpace(code)
+	if code != "" {
+		return repo.GetTermByCode(ctx, code)
+	}
+	term, err := repo.GetTermByDate(ctx, at)
+	if errors.IsNotFound(err) {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "当前不在任何学期内，请指定学期")
+	}
+	return term, err
+}
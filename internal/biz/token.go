@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshTokenRecord 刷新令牌记录，服务端只保存令牌哈希
+type RefreshTokenRecord struct {
+	UserID   uint
+	FamilyID string
+	// 签发刷新令牌的 OAuth 客户端，通过 /v1/user/login 等接口登录时为空
+	ClientID string
+}
+
+// 定义 Token 的操作接口
+type TokenRepo interface {
+	// 创建令牌族，同一次登录后轮换出的刷新令牌属于同一个令牌族
+	CreateTokenFamily(ctx context.Context, familyID string, userID uint, ttl time.Duration) error
+	// 令牌族是否仍然有效
+	IsTokenFamilyActive(ctx context.Context, familyID string) (bool, error)
+	// 撤销整个令牌族
+	RevokeTokenFamily(ctx context.Context, familyID string) error
+	// 保存刷新令牌
+	SaveRefreshToken(ctx context.Context, tokenHash string, record *RefreshTokenRecord, ttl time.Duration) error
+	// 使用刷新令牌，返回令牌记录以及该令牌此前是否已被使用过
+	UseRefreshToken(ctx context.Context, tokenHash string) (*RefreshTokenRecord, bool, error)
+	// 将访问令牌加入黑名单，ttl 为令牌剩余有效期
+	RevokeAccessToken(ctx context.Context, tokenID string, ttl time.Duration) error
+	// 撤销用户此前签发的全部访问令牌以及全部令牌族
+	RevokeUserTokens(ctx context.Context, userID uint, ttl time.Duration) error
+	// 撤销用户除指定令牌族以外的全部令牌族
+	RevokeOtherTokenFamilies(ctx context.Context, userID uint, keepFamilyID string) error
+	// 访问令牌是否已被撤销
+	IsAccessTokenRevoked(ctx context.Context, tokenID, sessionID string, userID uint, issuedAt time.Time) (bool, error)
+}
@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 在内存中保存刷新令牌和令牌族
+type fakeTokenRepo struct {
+	TokenRepo
+	families map[string]bool
+	refresh  map[string]*RefreshTokenRecord
+	used     map[string]bool
+}
+
+func (r *fakeTokenRepo) CreateTokenFamily(ctx context.Context, familyID string, userID uint, ttl time.Duration) error {
+	if r.families == nil {
+		r.families = map[string]bool{}
+	}
+	r.families[familyID] = true
+	return nil
+}
+
+func (r *fakeTokenRepo) SaveRefreshToken(ctx context.Context, tokenHash string, record *RefreshTokenRecord, ttl time.Duration) error {
+	if r.refresh == nil {
+		r.refresh = map[string]*RefreshTokenRecord{}
+		r.used = map[string]bool{}
+	}
+	r.refresh[tokenHash] = record
+	return nil
+}
+
+func (r *fakeTokenRepo) UseRefreshToken(ctx context.Context, tokenHash string) (*RefreshTokenRecord, bool, error) {
+	record, ok := r.refresh[tokenHash]
+	if !ok {
+		return nil, false, errors.New("not found")
+	}
+	reused := r.used[tokenHash]
+	r.used[tokenHash] = true
+	return record, reused, nil
+}
+
+func (r *fakeTokenRepo) IsTokenFamilyActive(ctx context.Context, familyID string) (bool, error) {
+	return r.families[familyID], nil
+}
+
+func (r *fakeTokenRepo) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	delete(r.families, familyID)
+	return nil
+}
+
+func (r *fakeTokenRepo) IsAccessTokenRevoked(ctx context.Context, tokenID, sessionID string, userID uint, issuedAt time.Time) (bool, error) {
+	return false, nil
+}
+
+func TestUserUsecase_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour, RefreshExpire: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		// 先使用一次刷新令牌，再次提交同一个令牌
+		replay      bool
+		wantSuccess bool
+		// 令牌族是否被撤销
+		wantRevoked bool
+	}{
+		{name: "刷新后轮换刷新令牌", status: 1, wantSuccess: true},
+		{name: "重放已使用的刷新令牌撤销令牌族", status: 1, replay: true, wantRevoked: true},
+		{name: "用户已被禁用", status: 0, wantRevoked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeAccountUserRepo{user: &User{ID: 7, Username: "testuser", Status: 1}}
+			tokens := &fakeTokenRepo{}
+			uc := NewUserUsecase(users, tokens, nil, newFakeSessionRepo(), nil, NewRBACUsecase(&fakeRoleRepo{}, log.DefaultLogger, nil), jwtUtil, nil, log.DefaultLogger)
+
+			login, err := uc.startSession(ctx, users.user, ClientInfo{})
+			if err != nil || !login.Success {
+				t.Fatalf("startSession() = %v, %v", login, err)
+			}
+			claims, err := jwtUtil.ValidateToken(login.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			users.user.Status = tt.status
+
+			var rotated string
+			if tt.replay {
+				first, err := uc.RefreshToken(ctx, login.RefreshToken)
+				if err != nil || !first.Success {
+					t.Fatalf("RefreshToken() = %v, %v", first, err)
+				}
+				rotated = first.RefreshToken
+			}
+
+			result, err := uc.RefreshToken(ctx, login.RefreshToken)
+			if err != nil {
+				t.Fatalf("RefreshToken() error = %v", err)
+			}
+			if result.Success != tt.wantSuccess {
+				t.Fatalf("RefreshToken() = %+v, wantSuccess %v", result, tt.wantSuccess)
+			}
+			if revoked := !tokens.families[claims.SessionID]; revoked != tt.wantRevoked {
+				t.Errorf("令牌族撤销 = %v, want %v", revoked, tt.wantRevoked)
+			}
+
+			if tt.wantSuccess {
+				if result.RefreshToken == "" || result.RefreshToken == login.RefreshToken {
+					t.Error("刷新后应签发新的刷新令牌")
+				}
+				refreshed, err := jwtUtil.ValidateToken(result.Token)
+				if err != nil || refreshed.SessionID != claims.SessionID {
+					t.Errorf("新的访问令牌应属于同一会话: %v, %v", refreshed, err)
+				}
+			}
+			// 令牌族撤销后，轮换得到的新刷新令牌同样失效
+			if rotated != "" {
+				if again, _ := uc.RefreshToken(ctx, rotated); again.Success {
+					t.Error("令牌族撤销后刷新令牌仍然可用")
+				}
+			}
+		})
+	}
+
+	users := &fakeAccountUserRepo{user: &User{ID: 7, Username: "testuser", Status: 1}}
+	uc := NewUserUsecase(users, &fakeTokenRepo{}, nil, newFakeSessionRepo(), nil, NewRBACUsecase(&fakeRoleRepo{}, log.DefaultLogger, nil), jwtUtil, nil, log.DefaultLogger)
+	for _, token := range []string{"", "unknown"} {
+		if result, err := uc.RefreshToken(ctx, token); err != nil || result.Success {
+			t.Errorf("RefreshToken(%q) = %v, %v", token, result, err)
+		}
+	}
+}
+
+func TestUserUsecase_RevokeUserSessions(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles := &fakeRoleRepo{permissions: map[string][][]string{
+		"1": {{"admin", "/v1/user/*/sessions/revoke", "POST"}},
+		"2": {{"user", "/v1/users", "GET"}},
+	}}
+	tokens := &fakeRevokeTokenRepo{}
+	uc := NewUserUsecase(nil, tokens, nil, newFakeSessionRepo(), nil, NewRBACUsecase(roles, log.DefaultLogger, nil), jwtUtil, nil, log.DefaultLogger)
+
+	tests := []struct {
+		name       string
+		operatorID uint
+		wantErr    bool
+	}{
+		{name: "未登录", operatorID: 0, wantErr: true},
+		{name: "没有权限", operatorID: 2, wantErr: true},
+		{name: "管理员", operatorID: 1, wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.RevokeUserSessions(ctx, tt.operatorID, 3)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RevokeUserSessions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if len(tokens.revoked) != 1 || tokens.revoked[0] != 3 {
+		t.Errorf("revoked = %v, want [3]", tokens.revoked)
+	}
+}
@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// UpdateFields 可以部分更新的字段，键为 update_mask 中的字段名，值为数据库列名
+type UpdateFields map[string]string
+
+// Resolve 把 update_mask 转换为需要更新的列，mask 为空时返回所有列，兼容整体更新
+// 不支持的字段返回 INVALID_ARGUMENT
+func (f UpdateFields) Resolve(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		columns := make([]string, 0, len(f))
+		for _, column := range f {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		return columns, nil
+	}
+	var columns []string
+	for _, path := range paths {
+		column, ok := f[strings.TrimSpace(path)]
+		if !ok {
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "update_mask 中不支持的字段: "+path)
+		}
+		if !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	return columns, nil
+}
+
+// 需要更新的列中是否包含 column
+func hasColumn(columns []string, column string) bool {
+	return slices.Contains(columns, column)
+}
+
+// 去掉不写入数据库的列
+func withoutColumn(columns []string, column string) []string {
+	return slices.DeleteFunc(slices.Clone(columns), func(c string) bool { return c == column })
+}
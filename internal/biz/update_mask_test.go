@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"student/internal/pkg/jwt"
+	"student/internal/pkg/password"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestStudentUsecase_UpdateMask(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		form        *StudentForm
+		paths       []string
+		wantColumns []string
+		wantCode    int
+	}{
+		{name: "未指定时更新所有字段", form: &StudentForm{Name: "新名字", Status: StudentStatusEnrolled, Version: 3}, wantColumns: []string{"age", "info", "name"}},
+		{name: "只更新备注", form: &StudentForm{Info: "转入", Version: 3}, paths: []string{"info"}, wantColumns: []string{"info"}},
+		{name: "重复的字段只更新一次", form: &StudentForm{Age: 0, Version: 3}, paths: []string{"age", "age"}, wantColumns: []string{"age"}},
+		{name: "状态与当前一致时不写入", form: &StudentForm{Status: StudentStatusEnrolled, Version: 3}, paths: []string{"status"}},
+		{name: "不能通过更新修改状态", form: &StudentForm{Status: StudentStatusGraduated, Version: 3}, paths: []string{"status"}, wantCode: 400},
+		{name: "不支持的字段", form: &StudentForm{Version: 3}, paths: []string{"id"}, wantCode: 400},
+		{name: "姓名不能为空", form: &StudentForm{Version: 3}, paths: []string{"name"}, wantCode: 400},
+		{name: "没有提供版本号", form: &StudentForm{Info: "转入"}, paths: []string{"info"}, wantCode: 428},
+		{name: "版本号已变化", form: &StudentForm{Info: "转入", Version: 2}, paths: []string{"info"}, wantCode: 409},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeStudentRepo{students: map[int32]*Student{1: {ID: 1, Name: "张三", Info: "备注", Age: 18, Status: StudentStatusEnrolled, Version: 3}}}
+			uc := NewStudentUsecase(repo, &fakeAcademicTermRepo{}, &fakeSearchIndex{}, log.DefaultLogger)
+			_, err := uc.Update(ctx, 1, tt.form, tt.paths)
+			if tt.wantCode != 0 {
+				if errors.Code(err) != tt.wantCode {
+					t.Errorf("Update() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if !reflect.DeepEqual(repo.columns, tt.wantColumns) {
+				t.Errorf("columns = %v, want %v", repo.columns, tt.wantColumns)
+			}
+		})
+	}
+}
+
+func (r *fakeAccountUserRepo) UpdateUser(ctx context.Context, id int32, u *UserForm, columns []string) (*UpdateUserMessage, error) {
+	r.columns = columns
+	r.form = u
+	return &UpdateUserMessage{Message: "Update user success"}, nil
+}
+
+func TestUserUsecase_UpdateMask(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwords, err := password.NewManager(&password.Config{BcryptCost: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		form        *UserForm
+		paths       []string
+		wantColumns []string
+		wantHashed  bool
+		wantErr     bool
+	}{
+		{name: "整体更新时空密码不修改", form: &UserForm{Username: "testuser", Status: 1, Version: 1}, wantColumns: []string{"age", "avatar", "email", "phone", "status", "username"}},
+		{name: "只修改邮箱", form: &UserForm{Email: "new@example.com", Version: 1}, paths: []string{"email"}, wantColumns: []string{"email"}},
+		{name: "只修改密码", form: &UserForm{Password: "Another-pass1", Version: 1}, paths: []string{"password"}, wantColumns: []string{"password"}, wantHashed: true},
+		{name: "修改密码时按当前用户名校验", form: &UserForm{Password: "testuser", Version: 1}, paths: []string{"password"}, wantErr: true},
+		{name: "update_mask 包含空密码", form: &UserForm{Version: 1}, paths: []string{"password"}, wantErr: true},
+		{name: "不支持的字段", form: &UserForm{Version: 1}, paths: []string{"mfa_secret"}, wantErr: true},
+		{name: "没有提供版本号", form: &UserForm{Email: "new@example.com"}, paths: []string{"email"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeAccountUserRepo{user: &User{ID: 1, Username: "testuser", Email: "test@example.com", Status: 1}}
+			uc := NewUserUsecase(users, &fakeRevokeTokenRepo{}, nil, newFakeSessionRepo(), nil, nil, jwtUtil, passwords, log.DefaultLogger)
+			_, err := uc.Update(ctx, 1, tt.form, tt.paths)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Update() 应返回错误")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if !reflect.DeepEqual(users.columns, tt.wantColumns) {
+				t.Errorf("columns = %v, want %v", users.columns, tt.wantColumns)
+			}
+			if password.IsHashed(users.form.Password) != tt.wantHashed {
+				t.Errorf("password = %q", users.form.Password)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_PreHashedPassword(t *testing.T) {
+	ctx := context.Background()
+	jwtUtil, err := jwt.NewJWTUtil(&jwt.Config{SecretKey: "test-secret", Expire: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwords, err := password.NewManager(&password.Config{BcryptCost: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 外部生成的哈希值应作为明文重新加密
+	hashed, err := passwords.Hash("Another-pass1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := &fakeAccountUserRepo{user: &User{ID: 1, Username: "testuser", Email: "test@example.com", Status: 1}}
+	uc := NewUserUsecase(users, &fakeRevokeTokenRepo{}, nil, newFakeSessionRepo(), nil, nil, jwtUtil, passwords, log.DefaultLogger)
+	if _, err := uc.Update(ctx, 1, &UserForm{Password: hashed, Version: 1}, []string{"password"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if users.form.Password == hashed {
+		t.Error("不应直接保存传入的哈希值")
+	}
+	if !passwords.Check(hashed, users.form.Password) {
+		t.Error("保存的应是传入字符串的哈希")
+	}
+}
@@ -2,15 +2,26 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
 	"student/internal/pkg/jwt"
 	"student/internal/pkg/password"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
+// 错误原因，与错误码 2005 对应
+const ReasonPasswordTooWeak = "PASSWORD_TOO_WEAK"
+
+// 密码不满足强度策略
+func ErrorPasswordTooWeak(reason string) error {
+	return errors.BadRequest(ReasonPasswordTooWeak, reason)
+}
+
 // User 用户模型
 type User struct {
 	ID        uint
@@ -21,6 +32,7 @@ type User struct {
 	Status    int
 	Age       int
 	Avatar    string
+	Version   uint            `gorm:"column:version;default:1" json:"version"` // 版本号，每次修改加一，用于乐观锁
 	CreatedAt *time.Time      `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt *time.Time      `gorm:"column:updated_at" json:"updated_at"`
 	DeletedAt *gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
@@ -29,6 +41,14 @@ type User struct {
 	CreatedAtStr string `gorm:"-" json:"created_at_str,omitempty"` // gorm:"-" 表示不映射到数据库
 	UpdatedAtStr string `gorm:"-" json:"updated_at_str,omitempty"` // gorm:"-" 表示不映射到数据库
 
+	// 两步验证
+	MFAEnabled       bool   `gorm:"column:mfa_enabled" json:"mfa_enabled"`
+	MFASecret        string `gorm:"column:mfa_secret" json:"-"`
+	MFARecoveryCodes string `gorm:"column:mfa_recovery_codes" json:"-"`
+
+	// 邮箱验证时间，为空表示未验证
+	EmailVerifiedAt *time.Time `gorm:"column:email_verified_at" json:"email_verified_at"`
+
 	// 角色信息
 	Roles []string `gorm:"-" json:"roles,omitempty"`
 }
@@ -59,6 +79,8 @@ type UserForm struct {
 	Status   int
 	Age      int
 	Avatar   string
+	// 读取时的版本号，更新时必须提供
+	Version uint
 }
 
 // HashPassword 加密密码
@@ -87,6 +109,8 @@ type CreateUserMessage struct {
 // UpdateUserMessage 更新用户消息
 type UpdateUserMessage struct {
 	Message string
+	// 更新后的版本号
+	Version uint
 }
 
 // DeleteUserMessage 删除用户消息
@@ -98,42 +122,82 @@ type DeleteUserMessage struct {
 type UserRepo interface {
 	GetUser(ctx context.Context, id int32) (*User, error)
 	CreateUser(ctx context.Context, u *UserForm) (*CreateUserMessage, error)
-	UpdateUser(ctx context.Context, id int32, u *UserForm) (*UpdateUserMessage, error)
-	DeleteUser(ctx context.Context, id int32) (*DeleteUserMessage, error)
-	ListUsers(ctx context.Context, page int32, pageSize int32, username, email string) ([]*User, int32, error)
+	// 只更新 columns 中的列，包含 email 且邮箱变化时清除验证状态；版本号与 u.Version 不一致时返回 ErrorVersionConflict
+	UpdateUser(ctx context.Context, id int32, u *UserForm, columns []string) (*UpdateUserMessage, error)
+	// 版本号与 version 一致时删除用户，否则返回 ErrorVersionConflict
+	DeleteUser(ctx context.Context, id int32, version uint) (*DeleteUserMessage, error)
+	// 返回一页用户、下一页的令牌和符合条件的总数
+	ListUsers(ctx context.Context, q *ListQuery) ([]*User, string, int32, error)
+	// 按与 ListUsers 相同的条件逐行读取所有用户，不读取密码和两步验证密钥，fn 返回错误时停止
+	ExportUsers(ctx context.Context, username, email string, fn func(*User) error) error
 	GetUserByUsername(ctx context.Context, username string) (*User, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	RegisterUser(ctx context.Context, u *RegisterForm) (*RegisterMessage, error)
+	UpdateMFA(ctx context.Context, id uint, settings *MFASettings) error
+	UpdatePassword(ctx context.Context, id uint, hashedPassword string) error
+	MarkEmailVerified(ctx context.Context, id uint, verifiedAt time.Time) error
+	UpdateProfile(ctx context.Context, id uint, p *ProfileForm, columns []string) error
+
+	// 回收站：按删除时间倒序返回已删除的用户和总数
+	ListDeletedUsers(ctx context.Context, page, pageSize int32) ([]*User, int32, error)
+	// 清空删除时间，用户不在回收站中时返回 404
+	RestoreUser(ctx context.Context, id int32) (*User, error)
+	// 在一个事务中删除回收站中的用户及其会话、API 密钥、第三方账号绑定和用户角色
+	PurgeUser(ctx context.Context, id int32) error
+	ListDeletedUserIDsBefore(ctx context.Context, before time.Time) ([]uint, error)
 }
 
 // LoginForm 登录表单
 type LoginForm struct {
 	Username string
 	Password string
+	// 客户端IP，用于登录限流
+	IP string
+	// 客户端 User-Agent，用于会话列表展示
+	UserAgent string
 }
 
 // LoginMessage 登录消息
 type LoginMessage struct {
-	User    *User
-	Message string
-	Success bool
-	Token   string
+	User         *User
+	Message      string
+	Success      bool
+	Token        string
+	RefreshToken string
+	ExpiresIn    int64
+	// 需要两步验证时返回挑战令牌，而不是最终的访问令牌
+	MFARequired bool
+	MFAToken    string
 }
 
 type UserUsecase struct {
-	repo    UserRepo
-	rbacUC  *RBACUsecase
-	log     *log.Helper
-	jwtUtil *jwt.JWTUtil
+	repo      UserRepo
+	tokenRepo TokenRepo
+	mfaRepo   MFARepo
+	// 登录会话记录
+	sessionRepo SessionRepo
+	limiter     *LoginLimiter
+	rbacUC      *RBACUsecase
+	log         *log.Helper
+	jwtUtil     *jwt.JWTUtil
+	passwords   *password.Manager
+	// 当前时间，测试时可替换为固定时钟
+	now func() time.Time
 }
 
 // 初始化 UserUsecase
-func NewUserUsecase(repo UserRepo, rbacUC *RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *UserUsecase {
+func NewUserUsecase(repo UserRepo, tokenRepo TokenRepo, mfaRepo MFARepo, sessionRepo SessionRepo, limiter *LoginLimiter, rbacUC *RBACUsecase, jwtUtil *jwt.JWTUtil, passwords *password.Manager, logger log.Logger) *UserUsecase {
 	return &UserUsecase{
-		repo:    repo,
-		rbacUC:  rbacUC,
-		log:     log.NewHelper(logger),
-		jwtUtil: jwtUtil,
+		repo:        repo,
+		tokenRepo:   tokenRepo,
+		mfaRepo:     mfaRepo,
+		sessionRepo: sessionRepo,
+		limiter:     limiter,
+		rbacUC:      rbacUC,
+		log:         log.NewHelper(logger),
+		jwtUtil:     jwtUtil,
+		passwords:   passwords,
+		now:         time.Now,
 	}
 }
 
@@ -145,31 +209,119 @@ func (uc *UserUsecase) Get(ctx context.Context, id int32) (*User, error) {
 
 // 创建用户
 func (uc *UserUsecase) Create(ctx context.Context, u *UserForm) (*CreateUserMessage, error) {
-	uc.log.Info("create user", u)
+	uc.log.Info("create user", u.Username)
+	if err := uc.preparePassword(&u.Password, u.Username); err != nil {
+		return nil, err
+	}
 	return uc.repo.CreateUser(ctx, u)
 }
 
-// 更新用户
-func (uc *UserUsecase) Update(ctx context.Context, id int32, u *UserForm) (*UpdateUserMessage, error) {
-	uc.log.Info("update user", id, u)
-	return uc.repo.UpdateUser(ctx, id, u)
+// 用户可以更新的字段
+var userUpdateFields = UpdateFields{
+	"username": "username",
+	"email":    "email",
+	"phone":    "phone",
+	"status":   "status",
+	"age":      "age",
+	"avatar":   "avatar",
+	"password": "password",
 }
 
-// 删除用户
-func (uc *UserUsecase) Delete(ctx context.Context, id int32) (*DeleteUserMessage, error) {
+// 更新用户，paths 为 update_mask，为空时更新所有字段，密码为空时不修改
+// u.Version 为读取时的版本号，用户已被其他请求修改时返回 ErrorVersionConflict
+func (uc *UserUsecase) Update(ctx context.Context, id int32, u *UserForm, paths []string) (*UpdateUserMessage, error) {
+	uc.log.Info("update user", id, u.Username, paths)
+	if err := requireVersion(u.Version); err != nil {
+		return nil, err
+	}
+	columns, err := userUpdateFields.Resolve(paths)
+	if err != nil {
+		return nil, err
+	}
+	if hasColumn(columns, "password") {
+		switch {
+		case u.Password != "":
+			username := u.Username
+			if !hasColumn(columns, "username") {
+				current, err := uc.repo.GetUser(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				username = current.Username
+			}
+			if err := uc.preparePassword(&u.Password, username); err != nil {
+				return nil, err
+			}
+		case len(paths) > 0:
+			return nil, errors.BadRequest("INVALID_ARGUMENT", "密码不能为空")
+		default:
+			// 整体更新时密码为空表示不修改
+			columns = withoutColumn(columns, "password")
+		}
+	}
+	result, err := uc.repo.UpdateUser(ctx, id, u, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户被禁用后立即使其现有会话失效
+	if hasColumn(columns, "status") && u.Status != 1 {
+		if err := uc.RevokeAllSessions(ctx, uint(id)); err != nil {
+			uc.log.Error("撤销用户会话失败", err)
+		}
+	}
+	return result, nil
+}
+
+// 删除用户，version 为读取时的版本号
+func (uc *UserUsecase) Delete(ctx context.Context, id int32, version uint) (*DeleteUserMessage, error) {
 	uc.log.Info("delete user", id)
-	return uc.repo.DeleteUser(ctx, id)
+	if err := requireVersion(version); err != nil {
+		return nil, err
+	}
+	result, err := uc.repo.DeleteUser(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户被删除后立即使其现有会话失效
+	if err := uc.RevokeAllSessions(ctx, uint(id)); err != nil {
+		uc.log.Error("撤销用户会话失败", err)
+	}
+	return result, nil
 }
 
-// 获取用户列表
-func (uc *UserUsecase) List(ctx context.Context, page int32, pageSize int32, username, email string) ([]*User, int32, error) {
-	if page == 0 {
-		page = 1
+// 用户列表可以过滤和排序的字段
+var UserListSchema = ListSchema{
+	"id":         {Column: "id", Type: ListFieldInt},
+	"username":   {Column: "username", Type: ListFieldString},
+	"email":      {Column: "email", Type: ListFieldString},
+	"age":        {Column: "age", Type: ListFieldInt},
+	"status":     {Column: "status", Type: ListFieldInt},
+	"created_at": {Column: "created_at", Type: ListFieldTime, Nullable: true},
+	"updated_at": {Column: "updated_at", Type: ListFieldTime, Nullable: true},
+}
+
+// 获取用户列表，username 和 email 为旧的模糊查询参数
+func (uc *UserUsecase) List(ctx context.Context, req ListRequest, username, email string) ([]*User, string, int32, error) {
+	var extra []Filter
+	if username != "" {
+		extra = append(extra, Filter{Field: "username", Op: OpContains, Values: []any{username}})
+	}
+	if email != "" {
+		extra = append(extra, Filter{Field: "email", Op: OpContains, Values: []any{email}})
 	}
-	if pageSize == 0 {
-		pageSize = 10
+	q, err := UserListSchema.Parse(req, extra...)
+	if err != nil {
+		return nil, "", 0, err
 	}
-	return uc.repo.ListUsers(ctx, page, pageSize, username, email)
+	return uc.repo.ListUsers(ctx, q)
+}
+
+// 导出用户，逐行回调，不一次性加载所有数据
+func (uc *UserUsecase) Export(ctx context.Context, username, email string, fn func(*User) error) error {
+	uc.log.Info("export users", username, email)
+	return uc.repo.ExportUsers(ctx, username, email, fn)
 }
 
 // 通过用户名获取用户信息
@@ -188,31 +340,59 @@ func (uc *UserUsecase) GetByEmail(ctx context.Context, email string) (*User, err
 func (uc *UserUsecase) Login(ctx context.Context, loginForm *LoginForm) (*LoginMessage, error) {
 	uc.log.Info("user login", loginForm.Username)
 
+	user, failed, err := uc.checkCredentials(ctx, loginForm)
+	if err != nil || failed != nil {
+		return failed, err
+	}
+
+	// 已启用两步验证时，先返回待验证的挑战令牌
+	if user.MFAEnabled {
+		return uc.createMFAChallenge(ctx, user)
+	}
+
+	return uc.startSession(ctx, user, ClientInfo{IP: loginForm.IP, UserAgent: loginForm.UserAgent})
+}
+
+// 校验用户名和密码，失败时返回登录消息，成功时返回已启用的用户
+func (uc *UserUsecase) checkCredentials(ctx context.Context, loginForm *LoginForm) (*User, *LoginMessage, error) {
+	// 检查账户是否被锁定或IP是否被限流
+	if err := uc.limiter.Check(ctx, loginForm.Username, loginForm.IP); err != nil {
+		return nil, nil, err
+	}
+
 	// 通过用户名获取用户
 	user, err := uc.repo.GetUserByUsername(ctx, loginForm.Username)
 	if err != nil {
-		return &LoginMessage{
-			Message: "用户名或密码错误",
-			Success: false,
-		}, nil
+		failed, err := uc.loginFailed(ctx, loginForm)
+		return nil, failed, err
 	}
 
 	// 验证密码
-	if !password.CheckPassword(loginForm.Password, user.Password) {
-		return &LoginMessage{
-			Message: "用户名或密码错误",
-			Success: false,
-		}, nil
+	if !uc.passwords.Check(loginForm.Password, user.Password) {
+		failed, err := uc.loginFailed(ctx, loginForm)
+		return nil, failed, err
 	}
 
+	// 清除失败记录
+	if err := uc.limiter.Succeed(ctx, loginForm.Username); err != nil {
+		uc.log.Error("清除登录失败记录失败", err)
+	}
+
+	// 已存储的哈希弱于当前配置时，使用明文密码重新加密
+	uc.upgradePasswordHash(ctx, user, loginForm.Password)
+
 	// 检查用户状态
 	if user.Status != 1 {
-		return &LoginMessage{
+		return nil, &LoginMessage{
 			Message: "用户已被禁用",
 			Success: false,
 		}, nil
 	}
+	return user, nil, nil
+}
 
+// 创建新会话，签发访问令牌和刷新令牌
+func (uc *UserUsecase) startSession(ctx context.Context, user *User, client ClientInfo) (*LoginMessage, error) {
 	// 获取用户角色
 	roles, err := uc.rbacUC.GetUserRoleNames(ctx, int32(user.ID))
 	if err != nil {
@@ -226,8 +406,22 @@ func (uc *UserUsecase) Login(ctx context.Context, loginForm *LoginForm) (*LoginM
 	// 设置用户角色信息
 	user.Roles = roles
 
-	// 生成JWT token
-	token, err := uc.jwtUtil.GenerateToken(user.ID, user.Username, user.Email)
+	// 创建令牌族
+	familyID, err := jwt.NewTokenID()
+	if err == nil {
+		err = uc.tokenRepo.CreateTokenFamily(ctx, familyID, user.ID, uc.jwtUtil.RefreshExpire())
+	}
+	if err != nil {
+		uc.log.Error("创建令牌族失败", err)
+		return &LoginMessage{
+			Message: "登录失败，请稍后重试",
+			Success: false,
+		}, nil
+	}
+	uc.recordSession(ctx, familyID, user.ID, client)
+
+	// 签发访问令牌和刷新令牌
+	result, err := uc.issueTokens(ctx, user, familyID, client.OAuthClientID)
 	if err != nil {
 		uc.log.Error("生成JWT token失败", err)
 		return &LoginMessage{
@@ -236,13 +430,233 @@ func (uc *UserUsecase) Login(ctx context.Context, loginForm *LoginForm) (*LoginM
 		}, nil
 	}
 
-	uc.log.Info("JWT token生成成功", "token_length", len(token))
+	uc.log.Info("JWT token生成成功", "token_length", len(result.Token))
 
+	result.Message = "登录成功"
+	return result, nil
+}
+
+// 校验密码强度并按当前配置加密
+// 接口传入的密码一律视为明文，即使形如哈希值也重新加密，不能直接写入外部生成的哈希
+func (uc *UserUsecase) preparePassword(pwd *string, username string) error {
+	if err := uc.passwords.Validate(*pwd, username); err != nil {
+		return ErrorPasswordTooWeak(err.Error())
+	}
+	hashed, err := uc.passwords.Hash(*pwd)
+	if err != nil {
+		return err
+	}
+	*pwd = hashed
+	return nil
+}
+
+// 升级密码哈希，失败时不影响登录
+func (uc *UserUsecase) upgradePasswordHash(ctx context.Context, user *User, plain string) {
+	if !uc.passwords.NeedsRehash(user.Password) {
+		return
+	}
+	hashed, err := uc.passwords.Hash(plain)
+	if err == nil {
+		err = uc.repo.UpdatePassword(ctx, user.ID, hashed)
+	}
+	if err != nil {
+		uc.log.Error("升级密码哈希失败", err)
+		return
+	}
+	user.Password = hashed
+	uc.log.Info("密码哈希已升级", "user_id", user.ID)
+}
+
+// 记录登录失败，达到阈值时返回账户锁定错误
+func (uc *UserUsecase) loginFailed(ctx context.Context, loginForm *LoginForm) (*LoginMessage, error) {
+	if err := uc.limiter.Fail(ctx, loginForm.Username, loginForm.IP); err != nil {
+		return nil, err
+	}
 	return &LoginMessage{
-		User:    user,
-		Message: "登录成功",
-		Success: true,
-		Token:   token,
+		Message: "用户名或密码错误",
+		Success: false,
+	}, nil
+}
+
+// 解除账户锁定，在用例中检查操作人的权限，不依赖请求头中的 RBAC 中间件
+func (uc *UserUsecase) Unlock(ctx context.Context, operatorID uint, id int32) error {
+	uc.log.Info("unlock user", id)
+	if err := uc.authorize(ctx, operatorID, fmt.Sprintf("/v1/user/%d/unlock", id), "POST"); err != nil {
+		return err
+	}
+	user, err := uc.repo.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.limiter.Unlock(ctx, user.Username)
+}
+
+// 使用刷新令牌换取新的访问令牌，刷新令牌每次使用后轮换
+func (uc *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (*LoginMessage, error) {
+	return uc.refreshToken(ctx, refreshToken, "")
+}
+
+// 刷新令牌只能由签发时的客户端使用，clientID 为空表示通过 /v1/user/refresh 刷新
+func (uc *UserUsecase) refreshToken(ctx context.Context, refreshToken, clientID string) (*LoginMessage, error) {
+	invalid := &LoginMessage{
+		Message: "刷新令牌无效或已过期，请重新登录",
+		Success: false,
+	}
+	if refreshToken == "" {
+		return invalid, nil
+	}
+
+	record, reused, err := uc.tokenRepo.UseRefreshToken(ctx, jwt.HashRefreshToken(refreshToken))
+	if err != nil {
+		return invalid, nil
+	}
+
+	// 已使用过的刷新令牌被再次提交，视为令牌泄露，撤销整个令牌族
+	if reused {
+		uc.log.Warn("检测到刷新令牌重放，撤销令牌族", "user_id", record.UserID, "family_id", record.FamilyID)
+		if err := uc.tokenRepo.RevokeTokenFamily(ctx, record.FamilyID); err != nil {
+			uc.log.Error("撤销令牌族失败", err)
+		}
+		return invalid, nil
+	}
+
+	// 其他客户端提交的刷新令牌视为已泄露，撤销整个令牌族
+	if record.ClientID != clientID {
+		uc.log.Warn("刷新令牌与客户端不匹配，撤销令牌族", "user_id", record.UserID, "family_id", record.FamilyID, "client_id", clientID)
+		if err := uc.tokenRepo.RevokeTokenFamily(ctx, record.FamilyID); err != nil {
+			uc.log.Error("撤销令牌族失败", err)
+		}
+		return invalid, nil
+	}
+
+	active, err := uc.tokenRepo.IsTokenFamilyActive(ctx, record.FamilyID)
+	if err != nil || !active {
+		return invalid, nil
+	}
+
+	user, err := uc.repo.GetUser(ctx, int32(record.UserID))
+	if err != nil || user.Status != 1 {
+		if err := uc.tokenRepo.RevokeTokenFamily(ctx, record.FamilyID); err != nil {
+			uc.log.Error("撤销令牌族失败", err)
+		}
+		return invalid, nil
+	}
+
+	result, err := uc.issueTokens(ctx, user, record.FamilyID, record.ClientID)
+	if err != nil {
+		uc.log.Error("刷新JWT token失败", err)
+		return &LoginMessage{
+			Message: "刷新令牌失败，请稍后重试",
+			Success: false,
+		}, nil
+	}
+
+	result.Message = "刷新令牌成功"
+	return result, nil
+}
+
+// 退出登录，撤销当前访问令牌及其所属会话
+func (uc *UserUsecase) Logout(ctx context.Context, claims *jwt.Claims) error {
+	uc.log.Info("user logout", claims.UserID)
+
+	if err := uc.tokenRepo.RevokeAccessToken(ctx, claims.ID, claims.RemainingLifetime()); err != nil {
+		return err
+	}
+	if claims.SessionID != "" {
+		if _, err := uc.sessionRepo.RevokeSession(ctx, claims.UserID, claims.SessionID, uc.now()); err != nil {
+			uc.log.Error("标记会话撤销失败", err)
+		}
+		return uc.tokenRepo.RevokeTokenFamily(ctx, claims.SessionID)
+	}
+	return nil
+}
+
+// 撤销用户的全部会话，包括已签发的访问令牌和刷新令牌
+func (uc *UserUsecase) RevokeAllSessions(ctx context.Context, userID uint) error {
+	uc.log.Info("revoke all sessions", userID)
+	if err := uc.tokenRepo.RevokeUserTokens(ctx, userID, uc.jwtUtil.AccessExpire()); err != nil {
+		return err
+	}
+	uc.markSessionsRevoked(ctx, userID, "")
+	return nil
+}
+
+// 管理员撤销指定用户的全部会话，在用例中检查操作人的权限，不依赖请求头中的 RBAC 中间件
+func (uc *UserUsecase) RevokeUserSessions(ctx context.Context, operatorID, userID uint) error {
+	if err := uc.authorize(ctx, operatorID, fmt.Sprintf("/v1/user/%d/sessions/revoke", userID), "POST"); err != nil {
+		return err
+	}
+	return uc.RevokeAllSessions(ctx, userID)
+}
+
+// 操作人没有管理该用户的权限
+func ErrorUserForbidden() error {
+	return errors.Forbidden("FORBIDDEN", "没有管理该用户的权限")
+}
+
+// 检查操作人是否有权限访问用户管理接口，未登录时直接拒绝
+func (uc *UserUsecase) authorize(ctx context.Context, operatorID uint, obj, act string) error {
+	if operatorID == 0 {
+		return ErrorUserForbidden()
+	}
+	allowed, err := uc.rbacUC.CheckPermission(ctx, strconv.Itoa(int(operatorID)), obj, act)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrorUserForbidden()
+	}
+	return nil
+}
+
+// 撤销用户除当前会话以外的全部会话，当前令牌不属于任何会话时撤销全部会话
+func (uc *UserUsecase) RevokeOtherSessions(ctx context.Context, userID uint, keepSessionID string) error {
+	uc.log.Info("revoke other sessions", userID)
+	if keepSessionID == "" {
+		return uc.RevokeAllSessions(ctx, userID)
+	}
+	if err := uc.tokenRepo.RevokeOtherTokenFamilies(ctx, userID, keepSessionID); err != nil {
+		return err
+	}
+	uc.markSessionsRevoked(ctx, userID, keepSessionID)
+	return nil
+}
+
+// 检查访问令牌是否已被撤销
+func (uc *UserUsecase) IsTokenRevoked(ctx context.Context, claims *jwt.Claims) (bool, error) {
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+	return uc.tokenRepo.IsAccessTokenRevoked(ctx, claims.ID, claims.SessionID, claims.UserID, issuedAt)
+}
+
+// 签发访问令牌，并在令牌族中生成新的刷新令牌，刷新令牌绑定签发的客户端
+func (uc *UserUsecase) issueTokens(ctx context.Context, user *User, familyID, clientID string) (*LoginMessage, error) {
+	token, err := uc.jwtUtil.GenerateSessionToken(user.ID, user.Username, user.Email, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	err = uc.tokenRepo.SaveRefreshToken(ctx, jwt.HashRefreshToken(refreshToken), &RefreshTokenRecord{
+		UserID:   user.ID,
+		FamilyID: familyID,
+		ClientID: clientID,
+	}, uc.jwtUtil.RefreshExpire())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginMessage{
+		User:         user,
+		Success:      true,
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(uc.jwtUtil.AccessExpire().Seconds()),
 	}, nil
 }
 
@@ -339,6 +753,19 @@ func (uc *UserUsecase) Register(ctx context.Context, registerForm *RegisterForm)
 		}
 	}
 
+	// 校验密码强度
+	if err := uc.passwords.Validate(registerForm.Password, registerForm.Username); err != nil {
+		return &RegisterMessage{
+			Message: err.Error(),
+			Success: false,
+		}, nil
+	}
+	hashedPassword, err := uc.passwords.Hash(registerForm.Password)
+	if err != nil {
+		return nil, err
+	}
+	registerForm.Password = hashedPassword
+
 	// 调用数据层进行注册
 	result, err := uc.repo.RegisterUser(ctx, registerForm)
 	if err != nil {
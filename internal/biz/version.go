@@ -0,0 +1,31 @@
+package biz
+
+import (
+	v1 "student/api/student/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 乐观锁：学生、用户、角色和权限每次修改后版本号加一，
+// 更新和删除时必须提供读取时的版本号，与当前版本号不一致说明数据已被其他请求修改
+
+// ReasonPreconditionRequired 更新或删除时没有提供版本号
+const ReasonPreconditionRequired = "PRECONDITION_REQUIRED"
+
+// ErrorVersionRequired 更新或删除时没有提供版本号
+func ErrorVersionRequired() error {
+	return errors.New(428, ReasonPreconditionRequired, "请通过 If-Match 请求头或 version 字段提供版本号")
+}
+
+// ErrorVersionConflict 数据已被其他请求修改，需要重新获取后再提交
+func ErrorVersionConflict() error {
+	return v1.ErrorVersionConflict("数据已被其他请求修改，请重新获取后再提交")
+}
+
+// 版本号从 1 开始，0 表示没有提供
+func requireVersion(version uint) error {
+	if version == 0 {
+		return ErrorVersionRequired()
+	}
+	return nil
+}
@@ -23,15 +23,23 @@ const (
 )
 
 type Bootstrap struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Jwt           *JWT                   `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	Rbac          *RBAC                  `protobuf:"bytes,4,opt,name=rbac,proto3" json:"rbac,omitempty"`
-	Nacos         *Nacos                 `protobuf:"bytes,5,opt,name=nacos,proto3" json:"nacos,omitempty"`
-	Services      *Services              `protobuf:"bytes,6,opt,name=services,proto3" json:"services,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Server            *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Data              *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Jwt               *JWT                   `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Rbac              *RBAC                  `protobuf:"bytes,4,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	Nacos             *Nacos                 `protobuf:"bytes,5,opt,name=nacos,proto3" json:"nacos,omitempty"`
+	Services          *Services              `protobuf:"bytes,6,opt,name=services,proto3" json:"services,omitempty"`
+	LoginSecurity     *LoginSecurity         `protobuf:"bytes,7,opt,name=login_security,json=loginSecurity,proto3" json:"login_security,omitempty"`
+	Mail              *Mail                  `protobuf:"bytes,8,opt,name=mail,proto3" json:"mail,omitempty"`
+	Account           *Account               `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
+	Password          *Password              `protobuf:"bytes,10,opt,name=password,proto3" json:"password,omitempty"`
+	Oidc              *OIDC                  `protobuf:"bytes,11,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	ExternalProviders []*ExternalProvider    `protobuf:"bytes,12,rep,name=external_providers,json=externalProviders,proto3" json:"external_providers,omitempty"`
+	Grading           *Grading               `protobuf:"bytes,13,opt,name=grading,proto3" json:"grading,omitempty"`
+	RecycleBin        *RecycleBin            `protobuf:"bytes,14,opt,name=recycle_bin,json=recycleBin,proto3" json:"recycle_bin,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Bootstrap) Reset() {
@@ -106,12 +114,70 @@ func (x *Bootstrap) GetServices() *Services {
 	return nil
 }
 
+func (x *Bootstrap) GetLoginSecurity() *LoginSecurity {
+	if x != nil {
+		return x.LoginSecurity
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetPassword() *Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetOidc() *OIDC {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetExternalProviders() []*ExternalProvider {
+	if x != nil {
+		return x.ExternalProviders
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetGrading() *Grading {
+	if x != nil {
+		return x.Grading
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRecycleBin() *RecycleBin {
+	if x != nil {
+		return x.RecycleBin
+	}
+	return nil
+}
+
 type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Http  *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc  *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// 受信任的反向代理（IP 或 CIDR），只有来自这些地址的请求才使用 X-Forwarded-For 和 X-Real-IP 中的客户端IP
+	TrustedProxies []string `protobuf:"bytes,3,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
@@ -158,10 +224,18 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Search        *Data_Search           `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,10 +284,26 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetSearch() *Data_Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
 type JWT struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SecretKey     string                 `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	Expire        *durationpb.Duration   `protobuf:"bytes,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SecretKey string                 `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	Expire    *durationpb.Duration   `protobuf:"bytes,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	// 刷新令牌有效期，为空时默认 7 天
+	RefreshExpire *durationpb.Duration `protobuf:"bytes,3,opt,name=refresh_expire,json=refreshExpire,proto3" json:"refresh_expire,omitempty"`
+	// 签名算法：HS256（默认）、RS256、EdDSA
+	Algorithm string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// 当前用于签名的密钥 kid
+	ActiveKid string    `protobuf:"bytes,5,opt,name=active_kid,json=activeKid,proto3" json:"active_kid,omitempty"`
+	Keys      []*JWTKey `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	// 远程 JWKS 地址，只验证不签名的服务使用
+	JwksUrl       string `protobuf:"bytes,7,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -262,6 +352,190 @@ func (x *JWT) GetExpire() *durationpb.Duration {
 	return nil
 }
 
+func (x *JWT) GetRefreshExpire() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return nil
+}
+
+func (x *JWT) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *JWT) GetActiveKid() string {
+	if x != nil {
+		return x.ActiveKid
+	}
+	return ""
+}
+
+func (x *JWT) GetKeys() []*JWTKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *JWT) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+type JWTKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Kid            string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	PrivateKeyFile string                 `protobuf:"bytes,2,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"`
+	PublicKeyFile  string                 `protobuf:"bytes,3,opt,name=public_key_file,json=publicKeyFile,proto3" json:"public_key_file,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JWTKey) Reset() {
+	*x = JWTKey{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTKey) ProtoMessage() {}
+
+func (x *JWTKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTKey.ProtoReflect.Descriptor instead.
+func (*JWTKey) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JWTKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPublicKeyFile() string {
+	if x != nil {
+		return x.PublicKeyFile
+	}
+	return ""
+}
+
+type LoginSecurity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 窗口期内同一用户名允许的最大失败次数，超过后锁定账户
+	MaxFailures int32 `protobuf:"varint,1,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// 失败次数统计窗口
+	FailureWindow *durationpb.Duration `protobuf:"bytes,2,opt,name=failure_window,json=failureWindow,proto3" json:"failure_window,omitempty"`
+	// 账户锁定时长
+	LockoutDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=lockout_duration,json=lockoutDuration,proto3" json:"lockout_duration,omitempty"`
+	// 窗口期内同一IP允许的最大失败次数
+	MaxIpFailures int32 `protobuf:"varint,4,opt,name=max_ip_failures,json=maxIpFailures,proto3" json:"max_ip_failures,omitempty"`
+	// 渐进退避的初始间隔与最大间隔
+	BaseDelay     *durationpb.Duration `protobuf:"bytes,5,opt,name=base_delay,json=baseDelay,proto3" json:"base_delay,omitempty"`
+	MaxDelay      *durationpb.Duration `protobuf:"bytes,6,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginSecurity) Reset() {
+	*x = LoginSecurity{}
+	mi := &file_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginSecurity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginSecurity) ProtoMessage() {}
+
+func (x *LoginSecurity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginSecurity.ProtoReflect.Descriptor instead.
+func (*LoginSecurity) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LoginSecurity) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *LoginSecurity) GetFailureWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FailureWindow
+	}
+	return nil
+}
+
+func (x *LoginSecurity) GetLockoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockoutDuration
+	}
+	return nil
+}
+
+func (x *LoginSecurity) GetMaxIpFailures() int32 {
+	if x != nil {
+		return x.MaxIpFailures
+	}
+	return 0
+}
+
+func (x *LoginSecurity) GetBaseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.BaseDelay
+	}
+	return nil
+}
+
+func (x *LoginSecurity) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
 type RBAC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ModelPath     string                 `protobuf:"bytes,1,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"`
@@ -272,7 +546,7 @@ type RBAC struct {
 
 func (x *RBAC) Reset() {
 	*x = RBAC{}
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +558,7 @@ func (x *RBAC) String() string {
 func (*RBAC) ProtoMessage() {}
 
 func (x *RBAC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +571,7 @@ func (x *RBAC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RBAC.ProtoReflect.Descriptor instead.
 func (*RBAC) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{4}
+	return file_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RBAC) GetModelPath() string {
@@ -324,7 +598,7 @@ type Nacos struct {
 
 func (x *Nacos) Reset() {
 	*x = Nacos{}
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +610,7 @@ func (x *Nacos) String() string {
 func (*Nacos) ProtoMessage() {}
 
 func (x *Nacos) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +623,7 @@ func (x *Nacos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Nacos.ProtoReflect.Descriptor instead.
 func (*Nacos) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Nacos) GetDiscovery() *Discovery {
@@ -381,7 +655,7 @@ type Discovery struct {
 
 func (x *Discovery) Reset() {
 	*x = Discovery{}
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -393,7 +667,7 @@ func (x *Discovery) String() string {
 func (*Discovery) ProtoMessage() {}
 
 func (x *Discovery) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +680,7 @@ func (x *Discovery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discovery.ProtoReflect.Descriptor instead.
 func (*Discovery) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{6}
+	return file_conf_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Discovery) GetIp() string {
@@ -473,7 +747,7 @@ type Config struct {
 
 func (x *Config) Reset() {
 	*x = Config{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +759,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +772,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Config) GetIp() string {
@@ -561,7 +835,7 @@ type Services struct {
 
 func (x *Services) Reset() {
 	*x = Services{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +847,7 @@ func (x *Services) String() string {
 func (*Services) ProtoMessage() {}
 
 func (x *Services) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +860,7 @@ func (x *Services) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Services.ProtoReflect.Descriptor instead.
 func (*Services) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{8}
+	return file_conf_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Services) GetUserService() string {
@@ -610,30 +884,37 @@ func (x *Services) GetRbacService() string {
 	return ""
 }
 
-type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+type Mail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发送方式：smtp / file / memory，为空时写入本地文件
+	Driver   string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Host     string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// 发件人地址
+	From string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// file 方式下邮件的保存目录
+	Dir           string `protobuf:"bytes,7,opt,name=dir,proto3" json:"dir,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
-	mi := &file_conf_proto_msgTypes[9]
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Mail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Mail) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,47 +925,534 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Server_HTTP) GetNetwork() string {
+func (x *Mail) GetDriver() string {
 	if x != nil {
-		return x.Network
+		return x.Driver
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetAddr() string {
+func (x *Mail) GetHost() string {
 	if x != nil {
-		return x.Addr
+		return x.Host
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+func (x *Mail) GetPort() int32 {
 	if x != nil {
-		return x.Timeout
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type Server_GRPC struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Mail) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
-	mi := &file_conf_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+func (x *Mail) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Mail) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Account struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 前端地址，用于生成重置密码和邮箱验证链接
+	BaseUrl string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// 重置密码令牌有效期
+	PasswordResetTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=password_reset_ttl,json=passwordResetTtl,proto3" json:"password_reset_ttl,omitempty"`
+	// 邮箱验证令牌有效期
+	EmailVerificationTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=email_verification_ttl,json=emailVerificationTtl,proto3" json:"email_verification_ttl,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Account) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Account) GetPasswordResetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PasswordResetTtl
+	}
+	return nil
+}
+
+func (x *Account) GetEmailVerificationTtl() *durationpb.Duration {
+	if x != nil {
+		return x.EmailVerificationTtl
+	}
+	return nil
+}
+
+type Password struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 哈希算法：bcrypt / argon2id，为空时使用 bcrypt
+	Algorithm     string           `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	BcryptCost    int32            `protobuf:"varint,2,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`
+	Argon2        *Password_Argon2 `protobuf:"bytes,3,opt,name=argon2,proto3" json:"argon2,omitempty"`
+	Policy        *Password_Policy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Password) Reset() {
+	*x = Password{}
+	mi := &file_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Password) ProtoMessage() {}
+
+func (x *Password) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Password.ProtoReflect.Descriptor instead.
+func (*Password) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Password) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Password) GetBcryptCost() int32 {
+	if x != nil {
+		return x.BcryptCost
+	}
+	return 0
+}
+
+func (x *Password) GetArgon2() *Password_Argon2 {
+	if x != nil {
+		return x.Argon2
+	}
+	return nil
+}
+
+func (x *Password) GetPolicy() *Password_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type OIDC struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 签发方地址，需与客户端访问本服务的外部地址一致
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// 授权码有效期
+	CodeTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`
+	// ID Token 有效期
+	IdTokenTtl    *durationpb.Duration `protobuf:"bytes,3,opt,name=id_token_ttl,json=idTokenTtl,proto3" json:"id_token_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDC) Reset() {
+	*x = OIDC{}
+	mi := &file_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDC) ProtoMessage() {}
+
+func (x *OIDC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDC.ProtoReflect.Descriptor instead.
+func (*OIDC) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OIDC) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDC) GetCodeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CodeTtl
+	}
+	return nil
+}
+
+func (x *OIDC) GetIdTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.IdTokenTtl
+	}
+	return nil
+}
+
+type ExternalProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 提供方名称，对应登录地址 /v1/user/oauth/{name}/start
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer       string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// 在提供方注册的回调地址，通常为前端页面，前端再把 code 和 state 转交给 callback 接口
+	RedirectUrl string `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// 为空时请求 openid profile email
+	Scopes []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// 首次登录自动创建用户，为 false 时只允许已关联的用户登录
+	AutoCreate bool `protobuf:"varint,7,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"`
+	// 自动创建的用户分配的角色名称
+	DefaultRole   string `protobuf:"bytes,8,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalProvider) Reset() {
+	*x = ExternalProvider{}
+	mi := &file_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalProvider) ProtoMessage() {}
+
+func (x *ExternalProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalProvider.ProtoReflect.Descriptor instead.
+func (*ExternalProvider) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExternalProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *ExternalProvider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ExternalProvider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *ExternalProvider) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *ExternalProvider) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ExternalProvider) GetAutoCreate() bool {
+	if x != nil {
+		return x.AutoCreate
+	}
+	return false
+}
+
+func (x *ExternalProvider) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+type Grading struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评分等级，为空时使用默认的五级制（A/B/C/D/F）
+	Scale         []*Grading_Level `protobuf:"bytes,1,rep,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grading) Reset() {
+	*x = Grading{}
+	mi := &file_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grading) ProtoMessage() {}
+
+func (x *Grading) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grading.ProtoReflect.Descriptor instead.
+func (*Grading) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Grading) GetScale() []*Grading_Level {
+	if x != nil {
+		return x.Scale
+	}
+	return nil
+}
+
+type RecycleBin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已删除数据的保留时间，超过后由后台任务彻底删除；为 0 时不自动清理
+	Retention *durationpb.Duration `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// 后台清理的间隔，默认 1 小时
+	PurgeInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecycleBin) Reset() {
+	*x = RecycleBin{}
+	mi := &file_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBin) ProtoMessage() {}
+
+func (x *RecycleBin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBin.ProtoReflect.Descriptor instead.
+func (*RecycleBin) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecycleBin) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *RecycleBin) GetPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
 func (x *Server_GRPC) String() string {
 	return protoimpl.X.MessageStringOf(x)
@@ -693,7 +1461,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1511,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +1523,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1588,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -832,7 +1600,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,23 +1658,298 @@ func (x *Data_Redis) GetDialTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Search struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学生搜索索引：mysql 使用 FULLTEXT 索引（默认），bleve 使用内嵌索引，适合本地开发
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// bleve 索引目录，为空时只保存在内存中，每次启动从数据库重建
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Search) Reset() {
+	*x = Data_Search{}
+	mi := &file_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Search) ProtoMessage() {}
+
+func (x *Data_Search) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Search.ProtoReflect.Descriptor instead.
+func (*Data_Search) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Search) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Search) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type Password_Argon2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 内存开销（KiB）
+	Memory        uint32 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	Iterations    uint32 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Parallelism   uint32 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Password_Argon2) Reset() {
+	*x = Password_Argon2{}
+	mi := &file_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Password_Argon2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Password_Argon2) ProtoMessage() {}
+
+func (x *Password_Argon2) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Password_Argon2.ProtoReflect.Descriptor instead.
+func (*Password_Argon2) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *Password_Argon2) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Password_Argon2) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Password_Argon2) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+type Password_Policy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最小长度，为 0 时默认为 8
+	MinLength     int32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireUpper  bool  `protobuf:"varint,2,opt,name=require_upper,json=requireUpper,proto3" json:"require_upper,omitempty"`
+	RequireLower  bool  `protobuf:"varint,3,opt,name=require_lower,json=requireLower,proto3" json:"require_lower,omitempty"`
+	RequireDigit  bool  `protobuf:"varint,4,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSymbol bool  `protobuf:"varint,5,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	// 额外禁止使用的密码
+	DenyList      []string `protobuf:"bytes,6,rep,name=deny_list,json=denyList,proto3" json:"deny_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Password_Policy) Reset() {
+	*x = Password_Policy{}
+	mi := &file_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Password_Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Password_Policy) ProtoMessage() {}
+
+func (x *Password_Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Password_Policy.ProtoReflect.Descriptor instead.
+func (*Password_Policy) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *Password_Policy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Password_Policy) GetRequireUpper() bool {
+	if x != nil {
+		return x.RequireUpper
+	}
+	return false
+}
+
+func (x *Password_Policy) GetRequireLower() bool {
+	if x != nil {
+		return x.RequireLower
+	}
+	return false
+}
+
+func (x *Password_Policy) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *Password_Policy) GetRequireSymbol() bool {
+	if x != nil {
+		return x.RequireSymbol
+	}
+	return false
+}
+
+func (x *Password_Policy) GetDenyList() []string {
+	if x != nil {
+		return x.DenyList
+	}
+	return nil
+}
+
+type Grading_Level struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 等级，如 A、B+
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// 达到该等级的最低分数（含）
+	MinScore float64 `protobuf:"fixed64,2,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	// 绩点
+	Points        float64 `protobuf:"fixed64,3,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grading_Level) Reset() {
+	*x = Grading_Level{}
+	mi := &file_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grading_Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grading_Level) ProtoMessage() {}
+
+func (x *Grading_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grading_Level.ProtoReflect.Descriptor instead.
+func (*Grading_Level) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *Grading_Level) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *Grading_Level) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *Grading_Level) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
 var File_conf_proto protoreflect.FileDescriptor
 
 const file_conf_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x81\x02\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xa5\x05\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
 	"\x03jwt\x18\x03 \x01(\v2\x0f.kratos.api.JWTR\x03jwt\x12$\n" +
 	"\x04rbac\x18\x04 \x01(\v2\x10.kratos.api.RBACR\x04rbac\x12'\n" +
 	"\x05nacos\x18\x05 \x01(\v2\x11.kratos.api.NacosR\x05nacos\x120\n" +
-	"\bservices\x18\x06 \x01(\v2\x14.kratos.api.ServicesR\bservices\"\xb8\x02\n" +
+	"\bservices\x18\x06 \x01(\v2\x14.kratos.api.ServicesR\bservices\x12@\n" +
+	"\x0elogin_security\x18\a \x01(\v2\x19.kratos.api.LoginSecurityR\rloginSecurity\x12$\n" +
+	"\x04mail\x18\b \x01(\v2\x10.kratos.api.MailR\x04mail\x12-\n" +
+	"\aaccount\x18\t \x01(\v2\x13.kratos.api.AccountR\aaccount\x120\n" +
+	"\bpassword\x18\n" +
+	" \x01(\v2\x14.kratos.api.PasswordR\bpassword\x12$\n" +
+	"\x04oidc\x18\v \x01(\v2\x10.kratos.api.OIDCR\x04oidc\x12K\n" +
+	"\x12external_providers\x18\f \x03(\v2\x1c.kratos.api.ExternalProviderR\x11externalProviders\x12-\n" +
+	"\agrading\x18\r \x01(\v2\x13.kratos.api.GradingR\agrading\x127\n" +
+	"\vrecycle_bin\x18\x0e \x01(\v2\x16.kratos.api.RecycleBinR\n" +
+	"recycleBin\"\xe1\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12'\n" +
+	"\x0ftrusted_proxies\x18\x03 \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -914,10 +1957,11 @@ const file_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x9a\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x81\x05\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a\x9c\x01\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12/\n" +
+	"\x06search\x18\x03 \x01(\v2\x17.kratos.api.Data.SearchR\x06search\x1a\x9c\x01\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x14\n" +
@@ -930,11 +1974,32 @@ const file_conf_proto_rawDesc = "" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12<\n" +
 	"\fread_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12<\n" +
-	"\fdial_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\"W\n" +
+	"\fdial_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x1a4\n" +
+	"\x06Search\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\x99\x02\n" +
 	"\x03JWT\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x121\n" +
-	"\x06expire\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06expire\"?\n" +
+	"\x06expire\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06expire\x12@\n" +
+	"\x0erefresh_expire\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rrefreshExpire\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"active_kid\x18\x05 \x01(\tR\tactiveKid\x12&\n" +
+	"\x04keys\x18\x06 \x03(\v2\x12.kratos.api.JWTKeyR\x04keys\x12\x19\n" +
+	"\bjwks_url\x18\a \x01(\tR\ajwksUrl\"l\n" +
+	"\x06JWTKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12(\n" +
+	"\x10private_key_file\x18\x02 \x01(\tR\x0eprivateKeyFile\x12&\n" +
+	"\x0fpublic_key_file\x18\x03 \x01(\tR\rpublicKeyFile\"\xd4\x02\n" +
+	"\rLoginSecurity\x12!\n" +
+	"\fmax_failures\x18\x01 \x01(\x05R\vmaxFailures\x12@\n" +
+	"\x0efailure_window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rfailureWindow\x12D\n" +
+	"\x10lockout_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0flockoutDuration\x12&\n" +
+	"\x0fmax_ip_failures\x18\x04 \x01(\x05R\rmaxIpFailures\x128\n" +
+	"\n" +
+	"base_delay\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tbaseDelay\x126\n" +
+	"\tmax_delay\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\"?\n" +
 	"\x04RBAC\x12\x1d\n" +
 	"\n" +
 	"model_path\x18\x01 \x01(\tR\tmodelPath\x12\x18\n" +
@@ -965,7 +2030,64 @@ const file_conf_proto_rawDesc = "" +
 	"\bServices\x12!\n" +
 	"\fuser_service\x18\x01 \x01(\tR\vuserService\x12'\n" +
 	"\x0fstudent_service\x18\x02 \x01(\tR\x0estudentService\x12!\n" +
-	"\frbac_service\x18\x03 \x01(\tR\vrbacServiceB\x1cZ\x1astudent/internal/conf;confb\x06proto3"
+	"\frbac_service\x18\x03 \x01(\tR\vrbacService\"\xa4\x01\n" +
+	"\x04Mail\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\x12\x10\n" +
+	"\x03dir\x18\a \x01(\tR\x03dir\"\xbe\x01\n" +
+	"\aAccount\x12\x19\n" +
+	"\bbase_url\x18\x01 \x01(\tR\abaseUrl\x12G\n" +
+	"\x12password_reset_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10passwordResetTtl\x12O\n" +
+	"\x16email_verification_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x14emailVerificationTtl\"\xf4\x03\n" +
+	"\bPassword\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1f\n" +
+	"\vbcrypt_cost\x18\x02 \x01(\x05R\n" +
+	"bcryptCost\x123\n" +
+	"\x06argon2\x18\x03 \x01(\v2\x1b.kratos.api.Password.Argon2R\x06argon2\x123\n" +
+	"\x06policy\x18\x04 \x01(\v2\x1b.kratos.api.Password.PolicyR\x06policy\x1ab\n" +
+	"\x06Argon2\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\rR\x06memory\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x02 \x01(\rR\n" +
+	"iterations\x12 \n" +
+	"\vparallelism\x18\x03 \x01(\rR\vparallelism\x1a\xda\x01\n" +
+	"\x06Policy\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12#\n" +
+	"\rrequire_upper\x18\x02 \x01(\bR\frequireUpper\x12#\n" +
+	"\rrequire_lower\x18\x03 \x01(\bR\frequireLower\x12#\n" +
+	"\rrequire_digit\x18\x04 \x01(\bR\frequireDigit\x12%\n" +
+	"\x0erequire_symbol\x18\x05 \x01(\bR\rrequireSymbol\x12\x1b\n" +
+	"\tdeny_list\x18\x06 \x03(\tR\bdenyList\"\x91\x01\n" +
+	"\x04OIDC\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x124\n" +
+	"\bcode_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\acodeTtl\x12;\n" +
+	"\fid_token_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"idTokenTtl\"\xff\x01\n" +
+	"\x10ExternalProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vauto_create\x18\a \x01(\bR\n" +
+	"autoCreate\x12!\n" +
+	"\fdefault_role\x18\b \x01(\tR\vdefaultRole\"\x90\x01\n" +
+	"\aGrading\x12/\n" +
+	"\x05scale\x18\x01 \x03(\v2\x19.kratos.api.Grading.LevelR\x05scale\x1aT\n" +
+	"\x05Level\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12\x1b\n" +
+	"\tmin_score\x18\x02 \x01(\x01R\bminScore\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x01R\x06points\"\x87\x01\n" +
+	"\n" +
+	"RecycleBin\x127\n" +
+	"\tretention\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12@\n" +
+	"\x0epurge_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rpurgeIntervalB\x1cZ\x1astudent/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_proto_rawDescOnce sync.Once
@@ -979,49 +2101,86 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*JWT)(nil),                 // 3: kratos.api.JWT
-	(*RBAC)(nil),                // 4: kratos.api.RBAC
-	(*Nacos)(nil),               // 5: kratos.api.Nacos
-	(*Discovery)(nil),           // 6: kratos.api.Discovery
-	(*Config)(nil),              // 7: kratos.api.Config
-	(*Services)(nil),            // 8: kratos.api.Services
-	(*Server_HTTP)(nil),         // 9: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 10: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 11: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 12: kratos.api.Data.Redis
-	nil,                         // 13: kratos.api.Discovery.MetadataEntry
-	(*durationpb.Duration)(nil), // 14: google.protobuf.Duration
+	(*JWTKey)(nil),              // 4: kratos.api.JWTKey
+	(*LoginSecurity)(nil),       // 5: kratos.api.LoginSecurity
+	(*RBAC)(nil),                // 6: kratos.api.RBAC
+	(*Nacos)(nil),               // 7: kratos.api.Nacos
+	(*Discovery)(nil),           // 8: kratos.api.Discovery
+	(*Config)(nil),              // 9: kratos.api.Config
+	(*Services)(nil),            // 10: kratos.api.Services
+	(*Mail)(nil),                // 11: kratos.api.Mail
+	(*Account)(nil),             // 12: kratos.api.Account
+	(*Password)(nil),            // 13: kratos.api.Password
+	(*OIDC)(nil),                // 14: kratos.api.OIDC
+	(*ExternalProvider)(nil),    // 15: kratos.api.ExternalProvider
+	(*Grading)(nil),             // 16: kratos.api.Grading
+	(*RecycleBin)(nil),          // 17: kratos.api.RecycleBin
+	(*Server_HTTP)(nil),         // 18: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 19: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 20: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 21: kratos.api.Data.Redis
+	(*Data_Search)(nil),         // 22: kratos.api.Data.Search
+	nil,                         // 23: kratos.api.Discovery.MetadataEntry
+	(*Password_Argon2)(nil),     // 24: kratos.api.Password.Argon2
+	(*Password_Policy)(nil),     // 25: kratos.api.Password.Policy
+	(*Grading_Level)(nil),       // 26: kratos.api.Grading.Level
+	(*durationpb.Duration)(nil), // 27: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
-	4,  // 3: kratos.api.Bootstrap.rbac:type_name -> kratos.api.RBAC
-	5,  // 4: kratos.api.Bootstrap.nacos:type_name -> kratos.api.Nacos
-	8,  // 5: kratos.api.Bootstrap.services:type_name -> kratos.api.Services
-	9,  // 6: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	10, // 7: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	11, // 8: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	12, // 9: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	14, // 10: kratos.api.JWT.expire:type_name -> google.protobuf.Duration
-	6,  // 11: kratos.api.Nacos.discovery:type_name -> kratos.api.Discovery
-	7,  // 12: kratos.api.Nacos.config:type_name -> kratos.api.Config
-	13, // 13: kratos.api.Discovery.metadata:type_name -> kratos.api.Discovery.MetadataEntry
-	14, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	14, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	14, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	14, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	14, // 18: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	6,  // 3: kratos.api.Bootstrap.rbac:type_name -> kratos.api.RBAC
+	7,  // 4: kratos.api.Bootstrap.nacos:type_name -> kratos.api.Nacos
+	10, // 5: kratos.api.Bootstrap.services:type_name -> kratos.api.Services
+	5,  // 6: kratos.api.Bootstrap.login_security:type_name -> kratos.api.LoginSecurity
+	11, // 7: kratos.api.Bootstrap.mail:type_name -> kratos.api.Mail
+	12, // 8: kratos.api.Bootstrap.account:type_name -> kratos.api.Account
+	13, // 9: kratos.api.Bootstrap.password:type_name -> kratos.api.Password
+	14, // 10: kratos.api.Bootstrap.oidc:type_name -> kratos.api.OIDC
+	15, // 11: kratos.api.Bootstrap.external_providers:type_name -> kratos.api.ExternalProvider
+	16, // 12: kratos.api.Bootstrap.grading:type_name -> kratos.api.Grading
+	17, // 13: kratos.api.Bootstrap.recycle_bin:type_name -> kratos.api.RecycleBin
+	18, // 14: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	19, // 15: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	20, // 16: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	21, // 17: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	22, // 18: kratos.api.Data.search:type_name -> kratos.api.Data.Search
+	27, // 19: kratos.api.JWT.expire:type_name -> google.protobuf.Duration
+	27, // 20: kratos.api.JWT.refresh_expire:type_name -> google.protobuf.Duration
+	4,  // 21: kratos.api.JWT.keys:type_name -> kratos.api.JWTKey
+	27, // 22: kratos.api.LoginSecurity.failure_window:type_name -> google.protobuf.Duration
+	27, // 23: kratos.api.LoginSecurity.lockout_duration:type_name -> google.protobuf.Duration
+	27, // 24: kratos.api.LoginSecurity.base_delay:type_name -> google.protobuf.Duration
+	27, // 25: kratos.api.LoginSecurity.max_delay:type_name -> google.protobuf.Duration
+	8,  // 26: kratos.api.Nacos.discovery:type_name -> kratos.api.Discovery
+	9,  // 27: kratos.api.Nacos.config:type_name -> kratos.api.Config
+	23, // 28: kratos.api.Discovery.metadata:type_name -> kratos.api.Discovery.MetadataEntry
+	27, // 29: kratos.api.Account.password_reset_ttl:type_name -> google.protobuf.Duration
+	27, // 30: kratos.api.Account.email_verification_ttl:type_name -> google.protobuf.Duration
+	24, // 31: kratos.api.Password.argon2:type_name -> kratos.api.Password.Argon2
+	25, // 32: kratos.api.Password.policy:type_name -> kratos.api.Password.Policy
+	27, // 33: kratos.api.OIDC.code_ttl:type_name -> google.protobuf.Duration
+	27, // 34: kratos.api.OIDC.id_token_ttl:type_name -> google.protobuf.Duration
+	26, // 35: kratos.api.Grading.scale:type_name -> kratos.api.Grading.Level
+	27, // 36: kratos.api.RecycleBin.retention:type_name -> google.protobuf.Duration
+	27, // 37: kratos.api.RecycleBin.purge_interval:type_name -> google.protobuf.Duration
+	27, // 38: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	27, // 39: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	27, // 40: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	27, // 41: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	27, // 42: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -1035,7 +2194,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
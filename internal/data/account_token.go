@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	accountTokenKeyPrefix     = "account_token:"
+	accountUserTokenKeyPrefix = "account_user_token:"
+)
+
+// 保存令牌，并删除该用户同一用途的旧令牌
+var createAccountTokenScript = redis.NewScript(`
+local old = redis.call('GET', KEYS[2])
+if old then
+	redis.call('DEL', ARGV[1] .. old)
+end
+redis.call('HSET', KEYS[1], 'user_id', ARGV[3], 'email', ARGV[4])
+redis.call('PEXPIRE', KEYS[1], ARGV[5])
+redis.call('SET', KEYS[2], ARGV[2], 'PX', ARGV[5])
+return 1
+`)
+
+// 原子地读取并删除令牌，同时清理用户的令牌索引
+var consumeAccountTokenScript = redis.NewScript(`
+local values = redis.call('HMGET', KEYS[1], 'user_id', 'email')
+if not values[1] then
+	return false
+end
+redis.call('DEL', KEYS[1])
+local userKey = ARGV[1] .. values[1]
+if redis.call('GET', userKey) == ARGV[2] then
+	redis.call('DEL', userKey)
+end
+return values
+`)
+
+type accountTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAccountTokenRepo(data *Data, logger log.Logger) biz.AccountTokenRepo {
+	return &accountTokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 在 redis 中保存账户令牌
+func (r *accountTokenRepo) CreateToken(ctx context.Context, purpose, tokenHash string, token *biz.AccountToken, ttl time.Duration) error {
+	userID := strconv.FormatUint(uint64(token.UserID), 10)
+	keys := []string{accountTokenKey(purpose, tokenHash), accountUserTokenKeyPrefix + purpose + ":" + userID}
+	err := createAccountTokenScript.Run(ctx, r.data.redis, keys,
+		accountTokenKeyPrefix+purpose+":", tokenHash, userID, token.Email, ttl.Milliseconds()).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: CreateAccountToken, purpose: ", purpose, ", user_id: ", token.UserID)
+	return nil
+}
+
+// 实现 使用账户令牌
+func (r *accountTokenRepo) ConsumeToken(ctx context.Context, purpose, tokenHash string) (*biz.AccountToken, error) {
+	values, err := consumeAccountTokenScript.Run(ctx, r.data.redis, []string{accountTokenKey(purpose, tokenHash)},
+		accountUserTokenKeyPrefix+purpose+":", tokenHash).StringSlice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+
+	userID, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	return &biz.AccountToken{UserID: uint(userID), Email: values[1]}, nil
+}
+
+func accountTokenKey(purpose, tokenHash string) string {
+	return accountTokenKeyPrefix + purpose + ":" + tokenHash
+}
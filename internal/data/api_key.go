@@ -0,0 +1,80 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAPIKeyRepo(data *Data, logger log.Logger) biz.APIKeyRepo {
+	return &apiKeyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 创建 API Key
+func (r *apiKeyRepo) CreateAPIKey(ctx context.Context, key *biz.APIKey) error {
+	err := r.data.gormDB.WithContext(ctx).Create(key).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateAPIKey, user_id: %d, prefix: %s", key.UserID, key.Prefix)
+	return nil
+}
+
+// 实现 获取用户的 API Key 列表
+func (r *apiKeyRepo) ListAPIKeys(ctx context.Context, userID uint) ([]*biz.APIKey, error) {
+	var keys []*biz.APIKey
+	err := r.data.gormDB.WithContext(ctx).Where("user_id = ?", userID).Order("id desc").Find(&keys).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return keys, nil
+}
+
+// 实现 通过前缀获取 API Key
+func (r *apiKeyRepo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*biz.APIKey, error) {
+	var key biz.APIKey
+	err := r.data.gormDB.WithContext(ctx).Where("prefix = ?", prefix).First(&key).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	return &key, nil
+}
+
+// 实现 撤销 API Key
+func (r *apiKeyRepo) RevokeAPIKey(ctx context.Context, userID, id uint, revokedAt time.Time) (bool, error) {
+	result := r.data.gormDB.WithContext(ctx).Model(&biz.APIKey{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return false, errors.Error400(result.Error)
+	}
+	r.log.WithContext(ctx).Info("gormDB: RevokeAPIKey, user_id: %d, id: %d", userID, id)
+	return result.RowsAffected > 0, nil
+}
+
+// 实现 更新 API Key 最后使用时间
+func (r *apiKeyRepo) TouchAPIKey(ctx context.Context, id uint, usedAt time.Time) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.APIKey{}).Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
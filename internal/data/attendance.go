@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 批量写入出勤记录时每批的条数
+const attendanceBatchSize = 200
+
+type attendanceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAttendanceRepo(data *Data, logger log.Logger) biz.AttendanceRepo {
+	return &attendanceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 在一个事务中写入点名和全部出勤记录
+func (r *attendanceRepo) SubmitRollCall(ctx context.Context, f *biz.RollCallForm) (*biz.AttendanceSession, error) {
+	var session biz.AttendanceSession
+	date := f.Date.Format(biz.DateFormat)
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("date = ? AND group_label = ?", date, f.GroupLabel).
+			First(&session).Error
+		switch {
+		case err == nil:
+			if session.TeacherID != f.TeacherID {
+				return biz.ErrorAttendanceNotOwner()
+			}
+			// 重复提交时以最新名单为准
+			if err := tx.Where("session_id = ?", session.ID).Delete(&biz.AttendanceRecord{}).Error; err != nil {
+				return errors.Error400(err)
+			}
+			if err := tx.Model(&session).Update("updated_at", gorm.Expr("CURRENT_TIMESTAMP")).Error; err != nil {
+				return errors.Error400(err)
+			}
+		case err == gorm.ErrRecordNotFound:
+			session = biz.AttendanceSession{Date: f.Date, GroupLabel: f.GroupLabel, TeacherID: f.TeacherID}
+			if err := tx.Create(&session).Error; err != nil {
+				return errors.Error400(err)
+			}
+		default:
+			return errors.Error400(err)
+		}
+
+		studentIDs := make([]uint, 0, len(f.Records))
+		for _, record := range f.Records {
+			studentIDs = append(studentIDs, record.StudentID)
+		}
+		var found int64
+		if err := tx.Model(&biz.Student{}).Where("id IN ?", studentIDs).Count(&found).Error; err != nil {
+			return errors.Error400(err)
+		}
+		if int(found) != len(studentIDs) {
+			return errors.Error404()
+		}
+
+		records := make([]*biz.AttendanceRecord, 0, len(f.Records))
+		for _, record := range f.Records {
+			records = append(records, &biz.AttendanceRecord{
+				SessionID: session.ID,
+				StudentID: record.StudentID,
+				Status:    record.Status,
+				Note:      record.Note,
+			})
+		}
+		if err := tx.CreateInBatches(records, attendanceBatchSize).Error; err != nil {
+			return errors.Error400(err)
+		}
+		session.Records = records
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: SubmitRollCall, session_id: %d, records: %d", session.ID, len(session.Records))
+	session.FormatTimeFields()
+	return &session, nil
+}
+
+// 实现 从 gormDB 中获取点名及其出勤记录
+func (r *attendanceRepo) GetSession(ctx context.Context, id uint) (*biz.AttendanceSession, error) {
+	var session biz.AttendanceSession
+	err := r.data.gormDB.WithContext(ctx).Preload("Records", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("student_id asc")
+	}).First(&session, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	session.FormatTimeFields()
+	return &session, nil
+}
+
+// 实现 从 gormDB 中获取点名列表
+func (r *attendanceRepo) ListSessions(ctx context.Context, page, pageSize int32, f *biz.AttendanceFilter) ([]*biz.AttendanceSession, int32, error) {
+	query := applyAttendanceFilter(r.data.gormDB.WithContext(ctx).Model(&biz.AttendanceSession{}), f, "")
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var sessions []*biz.AttendanceSession
+	err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("date desc, id desc").Find(&sessions).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	for _, s := range sessions {
+		s.FormatTimeFields()
+	}
+	return sessions, int32(total), nil
+}
+
+// 实现 按学生统计日期范围内的出勤状态
+func (r *attendanceRepo) ListAbsenceRates(ctx context.Context, f *biz.AttendanceFilter) ([]*biz.AbsenceRate, error) {
+	query := r.data.gormDB.WithContext(ctx).
+		Table("attendance_records AS r").
+		Select("r.student_id, s.name AS student_name, COUNT(*) AS total, "+
+			"SUM(CASE WHEN r.status = ? THEN 1 ELSE 0 END) AS absent, "+
+			"SUM(CASE WHEN r.status = ? THEN 1 ELSE 0 END) AS late, "+
+			"SUM(CASE WHEN r.status = ? THEN 1 ELSE 0 END) AS excused",
+			biz.AttendanceAbsent, biz.AttendanceLate, biz.AttendanceExcused).
+		Joins("JOIN attendance_sessions AS a ON a.id = r.session_id").
+		Joins("LEFT JOIN students AS s ON s.id = r.student_id")
+	query = applyAttendanceFilter(query, f, "a.")
+
+	var rates []*biz.AbsenceRate
+	err := query.Group("r.student_id, s.name").Order("r.student_id asc").Scan(&rates).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return rates, nil
+}
+
+func applyAttendanceFilter(query *gorm.DB, f *biz.AttendanceFilter, prefix string) *gorm.DB {
+	if !f.From.IsZero() {
+		query = query.Where(prefix+"date >= ?", f.From.Format(biz.DateFormat))
+	}
+	if !f.To.IsZero() {
+		query = query.Where(prefix+"date <= ?", f.To.Format(biz.DateFormat))
+	}
+	if f.GroupLabel != "" {
+		query = query.Where(prefix+"group_label = ?", f.GroupLabel)
+	}
+	if f.TeacherID != 0 {
+		query = query.Where(prefix+"teacher_id = ?", f.TeacherID)
+	}
+	return query
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type auditLogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditLogRepo(data *Data, logger log.Logger) biz.AuditLogRepo {
+	return &auditLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 写入审计日志
+func (r *auditLogRepo) CreateAuditLog(ctx context.Context, entry *biz.AuditLog) error {
+	err := r.data.gormDB.WithContext(ctx).Create(entry).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
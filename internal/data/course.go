@@ -0,0 +1,354 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type courseRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCourseRepo(data *Data, logger log.Logger) biz.CourseRepo {
+	return &courseRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 从 gormDB 中获取课程
+func (r *courseRepo) GetCourse(ctx context.Context, id uint) (*biz.Course, error) {
+	var course biz.Course
+	err := r.data.gormDB.WithContext(ctx).First(&course, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	course.FormatTimeFields()
+	return &course, nil
+}
+
+// 实现 从 gormDB 中创建课程
+func (r *courseRepo) CreateCourse(ctx context.Context, c *biz.CourseForm) (*biz.Course, error) {
+	course := biz.Course{
+		Code:        c.Code,
+		Name:        c.Name,
+		Description: c.Description,
+		Credits:     c.Credits,
+		Status:      c.Status,
+	}
+	err := r.data.gormDB.WithContext(ctx).Create(&course).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateCourse, course: %v", course)
+	course.FormatTimeFields()
+	return &course, nil
+}
+
+// 实现 从 gormDB 中更新课程
+func (r *courseRepo) UpdateCourse(ctx context.Context, id uint, c *biz.CourseForm) (*biz.Course, error) {
+	var course biz.Course
+	err := r.data.gormDB.WithContext(ctx).First(&course, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	course.Code = c.Code
+	course.Name = c.Name
+	course.Description = c.Description
+	course.Credits = c.Credits
+	course.Status = c.Status
+	err = r.data.gormDB.WithContext(ctx).Save(&course).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateCourse, id: %d, course: %v", id, course)
+	course.FormatTimeFields()
+	return &course, nil
+}
+
+// 实现 从 gormDB 中删除课程
+func (r *courseRepo) DeleteCourse(ctx context.Context, id uint) error {
+	return r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var course biz.Course
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&course, id).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		var classes int64
+		if err := tx.Model(&biz.Class{}).Where("course_id = ?", id).Count(&classes).Error; err != nil {
+			return errors.Error400(err)
+		}
+		if classes > 0 {
+			return biz.ErrorCourseInUse("课程下还有班级，不能删除")
+		}
+		if err := tx.Delete(&course).Error; err != nil {
+			return errors.Error400(err)
+		}
+		r.log.WithContext(ctx).Info("gormDB: DeleteCourse, id: %d", id)
+		return nil
+	})
+}
+
+// 实现 从 gormDB 中获取课程列表
+func (r *courseRepo) ListCourses(ctx context.Context, page, pageSize int32, name string) ([]*biz.Course, int32, error) {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Course{})
+	if name != "" {
+		query = query.Where("name LIKE ? OR code LIKE ?", "%"+name+"%", "%"+name+"%")
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var courses []*biz.Course
+	err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&courses).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	for _, c := range courses {
+		c.FormatTimeFields()
+	}
+	return courses, int32(total), nil
+}
+
+// 实现 从 gormDB 中获取班级
+func (r *courseRepo) GetClass(ctx context.Context, id uint) (*biz.Class, error) {
+	var class biz.Class
+	err := r.data.gormDB.WithContext(ctx).First(&class, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	class.FormatTimeFields()
+	return &class, nil
+}
+
+// 实现 从 gormDB 中创建班级
+func (r *courseRepo) CreateClass(ctx context.Context, c *biz.ClassForm) (*biz.Class, error) {
+	class := biz.Class{
+		CourseID: c.CourseID,
+		Term:     c.Term,
+		Teacher:  c.Teacher,
+		Capacity: c.Capacity,
+		Status:   c.Status,
+	}
+	err := r.data.gormDB.WithContext(ctx).Create(&class).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateClass, class: %v", class)
+	class.FormatTimeFields()
+	return &class, nil
+}
+
+// 实现 从 gormDB 中更新班级，锁定班级后检查容量，避免与选课并发时容量小于已选人数
+func (r *courseRepo) UpdateClass(ctx context.Context, id uint, c *biz.ClassForm) (*biz.Class, error) {
+	var class biz.Class
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, id).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		if c.Capacity < class.Enrolled {
+			return biz.ErrorCapacityTooSmall()
+		}
+		class.Term = c.Term
+		class.Teacher = c.Teacher
+		class.Capacity = c.Capacity
+		class.Status = c.Status
+		if err := tx.Save(&class).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateClass, id: %d, class: %v", id, class)
+	class.FormatTimeFields()
+	return &class, nil
+}
+
+// 实现 从 gormDB 中删除班级
+func (r *courseRepo) DeleteClass(ctx context.Context, id uint) error {
+	return r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var class biz.Class
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, id).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		if class.Enrolled > 0 {
+			return biz.ErrorCourseInUse("班级还有选课学生，不能删除")
+		}
+		if err := tx.Delete(&class).Error; err != nil {
+			return errors.Error400(err)
+		}
+		r.log.WithContext(ctx).Info("gormDB: DeleteClass, id: %d", id)
+		return nil
+	})
+}
+
+// 实现 从 gormDB 中获取班级列表
+func (r *courseRepo) ListClasses(ctx context.Context, page, pageSize int32, courseID uint, term string) ([]*biz.Class, int32, error) {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Class{})
+	if courseID != 0 {
+		query = query.Where("course_id = ?", courseID)
+	}
+	if term != "" {
+		query = query.Where("term = ?", term)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var classes []*biz.Class
+	err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&classes).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	for _, c := range classes {
+		c.FormatTimeFields()
+	}
+	return classes, int32(total), nil
+}
+
+type enrollmentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewEnrollmentRepo(data *Data, logger log.Logger) biz.EnrollmentRepo {
+	return &enrollmentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 学生选课，班级行锁保证并发选课时不会超过容量
+func (r *enrollmentRepo) EnrollStudent(ctx context.Context, classID, studentID uint) (*biz.Enrollment, error) {
+	var enrollment biz.Enrollment
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var class biz.Class
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, classID).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		var student biz.Student
+		if err := tx.Select("id").First(&student, studentID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+
+		// 退课后重新选课时复用原记录
+		var existing *biz.Enrollment
+		err = tx.Where("class_id = ? AND student_id = ?", classID, studentID).First(&enrollment).Error
+		if err == nil {
+			existing = &enrollment
+		} else if err != gorm.ErrRecordNotFound {
+			return errors.Error400(err)
+		}
+		if err := class.CheckEnroll(existing); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		enrollment.ClassID = classID
+		enrollment.StudentID = studentID
+		enrollment.Status = biz.EnrollmentStatusEnrolled
+		enrollment.EnrolledAt = &now
+		enrollment.DroppedAt = nil
+		if err := tx.Save(&enrollment).Error; err != nil {
+			return errors.Error400(err)
+		}
+		err = tx.Model(&class).Update("enrolled", gorm.Expr("enrolled + 1")).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: EnrollStudent, class_id: %d, student_id: %d", classID, studentID)
+	return &enrollment, nil
+}
+
+// 实现 学生退课
+func (r *enrollmentRepo) DropStudent(ctx context.Context, classID, studentID uint) (*biz.Enrollment, error) {
+	var enrollment biz.Enrollment
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var class biz.Class
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, classID).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		err = tx.Where("class_id = ? AND student_id = ? AND status = ?", classID, studentID, biz.EnrollmentStatusEnrolled).
+			First(&enrollment).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrorNotEnrolled()
+			}
+			return errors.Error400(err)
+		}
+
+		now := time.Now()
+		enrollment.Status = biz.EnrollmentStatusDropped
+		enrollment.DroppedAt = &now
+		if err := tx.Save(&enrollment).Error; err != nil {
+			return errors.Error400(err)
+		}
+		err = tx.Model(&class).Update("enrolled", gorm.Expr("enrolled - 1")).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: DropStudent, class_id: %d, student_id: %d", classID, studentID)
+	return &enrollment, nil
+}
+
+// 实现 获取班级的选课记录
+func (r *enrollmentRepo) ListEnrollments(ctx context.Context, classID uint) ([]*biz.Enrollment, error) {
+	var enrollments []*biz.Enrollment
+	err := r.data.gormDB.WithContext(ctx).Where("class_id = ?", classID).Order("id asc").Find(&enrollments).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return enrollments, nil
+}
@@ -4,6 +4,8 @@ import (
 	stdlog "log"
 	"student/internal/conf"
 	"student/internal/pkg/jwt"
+	"student/internal/pkg/mailer"
+	"student/internal/pkg/password"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -12,7 +14,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewGormDB, NewData, NewRedis, NewStudentRepo, NewUserRepo, NewRBACRepo, NewErrorRepo, NewJWTConfig, NewRBACConfig, NewRBACModelPath)
+var ProviderSet = wire.NewSet(NewGormDB, NewData, NewRedis, NewStudentRepo, NewUserRepo, NewTokenRepo, NewMFARepo, NewLoginAttemptRepo, NewRBACRepo, NewErrorRepo, NewJWTConfig, NewRBACConfig, NewRBACModelPath, NewLoginSecurityConfig, NewAccountTokenRepo, NewMailConfig, NewAccountConfig, NewPasswordConfig, NewAPIKeyRepo, NewSessionRepo, NewOIDCConfig, NewOAuthClientRepo, NewAuthorizationCodeRepo, NewExternalProviderConfigs, NewExternalIdentityRepo, NewExternalLoginStateRepo, NewAuditLogRepo, NewCourseRepo, NewEnrollmentRepo, NewGradingConfig, NewGradeRepo, NewAttendanceRepo, NewAcademicTermRepo, NewGuardianRepo, NewSearchIndex, NewRecycleBinConfig)
 
 // Data
 type Data struct {
@@ -33,8 +35,19 @@ func NewData(logger log.Logger, db *gorm.DB, redis *redis.Client) (*Data, func()
 // NewJWTConfig 创建JWT配置
 func NewJWTConfig(c *conf.Bootstrap) *jwt.Config {
 	config := &jwt.Config{
-		SecretKey: c.Jwt.SecretKey,
-		Expire:    c.Jwt.Expire.AsDuration(),
+		SecretKey:     c.Jwt.SecretKey,
+		Expire:        c.Jwt.Expire.AsDuration(),
+		RefreshExpire: c.Jwt.RefreshExpire.AsDuration(),
+		Algorithm:     c.Jwt.Algorithm,
+		ActiveKid:     c.Jwt.ActiveKid,
+		JWKSURL:       c.Jwt.JwksUrl,
+	}
+	for _, k := range c.Jwt.Keys {
+		config.Keys = append(config.Keys, jwt.KeyConfig{
+			Kid:            k.Kid,
+			PrivateKeyFile: k.PrivateKeyFile,
+			PublicKeyFile:  k.PublicKeyFile,
+		})
 	}
 
 	// 添加调试日志
@@ -49,6 +62,82 @@ func NewRBACConfig(c *conf.Bootstrap) *conf.RBAC {
 	return c.Rbac
 }
 
+// NewLoginSecurityConfig 获取登录安全配置
+func NewLoginSecurityConfig(c *conf.Bootstrap) *conf.LoginSecurity {
+	return c.LoginSecurity
+}
+
+// NewMailConfig 创建邮件发送配置
+func NewMailConfig(c *conf.Bootstrap) *mailer.Config {
+	if c.Mail == nil {
+		return &mailer.Config{}
+	}
+	return &mailer.Config{
+		Driver:   c.Mail.Driver,
+		Host:     c.Mail.Host,
+		Port:     int(c.Mail.Port),
+		Username: c.Mail.Username,
+		Password: c.Mail.Password,
+		From:     c.Mail.From,
+		Dir:      c.Mail.Dir,
+	}
+}
+
+// NewAccountConfig 获取账户相关配置
+func NewAccountConfig(c *conf.Bootstrap) *conf.Account {
+	return c.Account
+}
+
+// NewOIDCConfig 获取 OIDC 提供方配置
+func NewOIDCConfig(c *conf.Bootstrap) *conf.OIDC {
+	return c.Oidc
+}
+
+// NewGradingConfig 获取成绩评分等级配置
+func NewGradingConfig(c *conf.Bootstrap) *conf.Grading {
+	return c.Grading
+}
+
+// NewRecycleBinConfig 获取回收站配置
+func NewRecycleBinConfig(c *conf.Bootstrap) *conf.RecycleBin {
+	return c.RecycleBin
+}
+
+// NewExternalProviderConfigs 获取外部身份提供方配置
+func NewExternalProviderConfigs(c *conf.Bootstrap) []*conf.ExternalProvider {
+	return c.ExternalProviders
+}
+
+// NewPasswordConfig 创建密码策略和加密配置
+func NewPasswordConfig(c *conf.Bootstrap) *password.Config {
+	p := c.Password
+	if p == nil {
+		return &password.Config{}
+	}
+	config := &password.Config{
+		Algorithm:  p.Algorithm,
+		BcryptCost: int(p.BcryptCost),
+	}
+	if p.Argon2 != nil {
+		config.Argon2 = password.Argon2Params{
+			Memory:      p.Argon2.Memory,
+			Iterations:  p.Argon2.Iterations,
+			Parallelism: uint8(p.Argon2.Parallelism),
+		}
+	}
+	if p.Policy != nil {
+		config.Policy = password.Policy{
+			MinLength:     int(p.Policy.MinLength),
+			RequireUpper:  p.Policy.RequireUpper,
+			RequireLower:  p.Policy.RequireLower,
+			RequireDigit:  p.Policy.RequireDigit,
+			RequireSymbol: p.Policy.RequireSymbol,
+			DenyList:      p.Policy.DenyList,
+		}
+	}
+	return config
+}
+
 // NewRBACModelPath 获取RBAC模型路径
 func NewRBACModelPath(c *conf.Bootstrap) string {
 	return c.Rbac.ModelPath
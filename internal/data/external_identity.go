@@ -0,0 +1,103 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const externalLoginStateKeyPrefix = "external_login_state:"
+
+type externalIdentityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewExternalIdentityRepo(data *Data, logger log.Logger) biz.ExternalIdentityRepo {
+	return &externalIdentityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 通过提供方和 sub 获取外部账号关联
+func (r *externalIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*biz.ExternalIdentity, error) {
+	var identity biz.ExternalIdentity
+	err := r.data.gormDB.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, errors.Error400(err)
+	}
+	return &identity, nil
+}
+
+// 实现 创建外部账号关联
+func (r *externalIdentityRepo) CreateIdentity(ctx context.Context, identity *biz.ExternalIdentity) error {
+	err := r.data.gormDB.WithContext(ctx).Create(identity).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateExternalIdentity, provider: %s, user_id: %d", identity.Provider, identity.UserID)
+	return nil
+}
+
+// 实现 更新外部账号的最后登录时间
+func (r *externalIdentityRepo) TouchIdentity(ctx context.Context, id uint, email string, at time.Time) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.ExternalIdentity{}).Where("id = ?", id).
+		Updates(map[string]any{"email": email, "last_login_at": at}).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+type externalLoginStateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewExternalLoginStateRepo(data *Data, logger log.Logger) biz.ExternalLoginStateRepo {
+	return &externalLoginStateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 在 redis 中保存外部登录状态
+func (r *externalLoginStateRepo) SaveState(ctx context.Context, stateHash string, state *biz.ExternalLoginState, ttl time.Duration) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return errors.Error400(err)
+	}
+	if err := r.data.redis.Set(ctx, externalLoginStateKeyPrefix+stateHash, value, ttl).Err(); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 使用外部登录状态，读取后立即删除
+func (r *externalLoginStateRepo) ConsumeState(ctx context.Context, stateHash string) (*biz.ExternalLoginState, error) {
+	value, err := r.data.redis.GetDel(ctx, externalLoginStateKeyPrefix+stateHash).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+
+	var state biz.ExternalLoginState
+	if err := json.Unmarshal(value, &state); err != nil {
+		return nil, nil
+	}
+	return &state, nil
+}
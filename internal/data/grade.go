@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gradeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewGradeRepo(data *Data, logger log.Logger) biz.GradeRepo {
+	return &gradeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 预加载成绩对应的课程，已删除的课程也需要显示在成绩单中
+func preloadGradeCourse(db *gorm.DB) *gorm.DB {
+	return db.Preload("Course", func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped()
+	})
+}
+
+// 实现 从 gormDB 中获取成绩
+func (r *gradeRepo) GetGrade(ctx context.Context, id uint) (*biz.Grade, error) {
+	var grade biz.Grade
+	err := preloadGradeCourse(r.data.gormDB.WithContext(ctx)).First(&grade, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	grade.FormatTimeFields()
+	return &grade, nil
+}
+
+// 实现 按学生、课程和学期写入成绩，依赖唯一索引在并发录入时只保留一条记录
+func (r *gradeRepo) SaveGrade(ctx context.Context, g *biz.GradeForm) (*biz.Grade, error) {
+	grade := biz.Grade{
+		StudentID: g.StudentID,
+		CourseID:  g.CourseID,
+		Term:      g.Term,
+		Score:     g.Score,
+		Letter:    g.Letter,
+		Points:    g.Points,
+	}
+	err := r.data.gormDB.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"score", "letter", "points", "updated_at"}),
+	}).Create(&grade).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: SaveGrade, student_id: %d, course_id: %d, term: %s", g.StudentID, g.CourseID, g.Term)
+
+	var saved biz.Grade
+	err = preloadGradeCourse(r.data.gormDB.WithContext(ctx)).
+		Where("student_id = ? AND course_id = ? AND term = ?", g.StudentID, g.CourseID, g.Term).
+		First(&saved).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	saved.FormatTimeFields()
+	return &saved, nil
+}
+
+// 实现 从 gormDB 中删除成绩
+func (r *gradeRepo) DeleteGrade(ctx context.Context, id uint) error {
+	result := r.data.gormDB.WithContext(ctx).Delete(&biz.Grade{}, id)
+	if result.Error != nil {
+		return errors.Error400(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Error404()
+	}
+	r.log.WithContext(ctx).Info("gormDB: DeleteGrade, id: %d", id)
+	return nil
+}
+
+// 实现 从 gormDB 中获取成绩列表
+func (r *gradeRepo) ListGrades(ctx context.Context, page, pageSize int32, studentID uint, term string) ([]*biz.Grade, int32, error) {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Grade{})
+	if studentID != 0 {
+		query = query.Where("student_id = ?", studentID)
+	}
+	if term != "" {
+		query = query.Where("term = ?", term)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var grades []*biz.Grade
+	err := preloadGradeCourse(query).Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&grades).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	for _, g := range grades {
+		g.FormatTimeFields()
+	}
+	return grades, int32(total), nil
+}
+
+// 实现 获取学生的全部成绩
+func (r *gradeRepo) ListStudentGrades(ctx context.Context, studentID uint) ([]*biz.Grade, error) {
+	var grades []*biz.Grade
+	err := preloadGradeCourse(r.data.gormDB.WithContext(ctx)).
+		Where("student_id = ?", studentID).
+		Order("term asc, id asc").
+		Find(&grades).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	for _, g := range grades {
+		g.FormatTimeFields()
+	}
+	return grades, nil
+}
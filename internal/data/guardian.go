@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type guardianRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewGuardianRepo(data *Data, logger log.Logger) biz.GuardianRepo {
+	return &guardianRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *guardianRepo) first(ctx context.Context, query any, args ...any) (*biz.Guardian, error) {
+	var guardian biz.Guardian
+	err := r.data.gormDB.WithContext(ctx).Where(query, args...).First(&guardian).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	guardian.FormatTimeFields()
+	return &guardian, nil
+}
+
+// 实现 从 gormDB 中获取监护人
+func (r *guardianRepo) GetGuardian(ctx context.Context, id uint) (*biz.Guardian, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// 实现 通过登录账号获取监护人
+func (r *guardianRepo) GetGuardianByUserID(ctx context.Context, userID uint) (*biz.Guardian, error) {
+	return r.first(ctx, "user_id = ?", userID)
+}
+
+// 实现 获取监护人关联的学生ID
+func (r *guardianRepo) ListGuardianStudentIDs(ctx context.Context, guardianID uint) ([]uint, error) {
+	var ids []uint
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.StudentGuardian{}).
+		Where("guardian_id = ?", guardianID).
+		Pluck("student_id", &ids).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return ids, nil
+}
+
+// 实现 修改监护人关联的登录账号
+func (r *guardianRepo) SetGuardianUser(ctx context.Context, id uint, userID *uint) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.Guardian{}).Where("id = ?", id).Update("user_id", userID).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: SetGuardianUser, id: %d, user_id: %v", id, userID)
+	return nil
+}
+
+// 实现 获取学生的监护人，主要联系人排在最前
+func (r *guardianRepo) ListStudentGuardians(ctx context.Context, studentID uint) ([]*biz.StudentGuardian, error) {
+	var links []*biz.StudentGuardian
+	err := r.data.gormDB.WithContext(ctx).Preload("Guardian").
+		Where("student_id = ?", studentID).
+		Order("is_primary desc, id").
+		Find(&links).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	for _, link := range links {
+		if link.Guardian != nil {
+			link.Guardian.FormatTimeFields()
+		}
+	}
+	return links, nil
+}
+
+// 设为主要联系人时取消该学生的其他主要联系人
+func clearPrimaryGuardian(tx *gorm.DB, link *biz.StudentGuardian) error {
+	if !link.IsPrimary {
+		return nil
+	}
+	return tx.Model(&biz.StudentGuardian{}).
+		Where("student_id = ? AND guardian_id <> ? AND is_primary = ?", link.StudentID, link.GuardianID, true).
+		Update("is_primary", false).Error
+}
+
+// 实现 为学生添加监护人
+func (r *guardianRepo) AddStudentGuardian(ctx context.Context, link *biz.StudentGuardian, g *biz.GuardianForm) (*biz.StudentGuardian, error) {
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if link.GuardianID == 0 {
+			guardian := biz.Guardian{Name: g.Name, Phone: g.Phone, Email: g.Email}
+			if err := tx.Create(&guardian).Error; err != nil {
+				return errors.Error400(err)
+			}
+			link.GuardianID = guardian.ID
+		}
+		if err := clearPrimaryGuardian(tx, link); err != nil {
+			return errors.Error400(err)
+		}
+		if err := tx.Create(link).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return tx.Preload("Guardian").First(link, link.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: AddStudentGuardian, student_id: %d, guardian_id: %d", link.StudentID, link.GuardianID)
+	link.Guardian.FormatTimeFields()
+	return link, nil
+}
+
+// 实现 修改监护人信息和监护关系
+func (r *guardianRepo) UpdateStudentGuardian(ctx context.Context, link *biz.StudentGuardian, g *biz.GuardianForm) (*biz.StudentGuardian, error) {
+	var current biz.StudentGuardian
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("student_id = ? AND guardian_id = ?", link.StudentID, link.GuardianID).First(&current).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errors.Error404()
+			}
+			return errors.Error400(err)
+		}
+		err = tx.Model(&biz.Guardian{}).Where("id = ?", link.GuardianID).
+			Updates(map[string]any{"name": g.Name, "phone": g.Phone, "email": g.Email}).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		if err := clearPrimaryGuardian(tx, link); err != nil {
+			return errors.Error400(err)
+		}
+		current.Relationship = link.Relationship
+		current.IsPrimary = link.IsPrimary
+		if err := tx.Save(&current).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return tx.Preload("Guardian").First(&current, current.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateStudentGuardian, student_id: %d, guardian_id: %d", link.StudentID, link.GuardianID)
+	current.Guardian.FormatTimeFields()
+	return &current, nil
+}
+
+// 实现 删除学生与监护人的关联
+func (r *guardianRepo) RemoveStudentGuardian(ctx context.Context, studentID, guardianID uint) error {
+	return r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("student_id = ? AND guardian_id = ?", studentID, guardianID).Delete(&biz.StudentGuardian{})
+		if result.Error != nil {
+			return errors.Error400(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.Error404()
+		}
+		var remaining int64
+		if err := tx.Model(&biz.StudentGuardian{}).Where("guardian_id = ?", guardianID).Count(&remaining).Error; err != nil {
+			return errors.Error400(err)
+		}
+		if remaining == 0 {
+			if err := tx.Delete(&biz.Guardian{}, guardianID).Error; err != nil {
+				return errors.Error400(err)
+			}
+		}
+		r.log.WithContext(ctx).Info("gormDB: RemoveStudentGuardian, student_id: %d, guardian_id: %d", studentID, guardianID)
+		return nil
+	})
+}
+
+// 实现 获取登录账号作为监护人关联的学生
+func (r *guardianRepo) ListChildren(ctx context.Context, userID uint) ([]*biz.Student, error) {
+	var students []*biz.Student
+	err := r.data.gormDB.WithContext(ctx).
+		Joins("JOIN student_guardians ON student_guardians.student_id = students.id").
+		Joins("JOIN guardians ON guardians.id = student_guardians.guardian_id").
+		Where("guardians.user_id = ?", userID).
+		Order("students.id").
+		Find(&students).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	biz.FormatTimeFieldsBatch(students)
+	return students, nil
+}
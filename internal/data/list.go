@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LIKE 中的通配符需要转义
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// 按过滤条件添加 WHERE，列名来自 ListSchema，不会直接使用请求中的字段名
+func applyFilters(db *gorm.DB, filters []biz.Filter) *gorm.DB {
+	for _, f := range filters {
+		column := clause.Column{Name: f.Column}
+		switch f.Op {
+		case biz.OpIn:
+			db = db.Where("? IN ?", column, f.Values)
+		case biz.OpPrefix:
+			db = db.Where("? LIKE ?", column, likeEscaper.Replace(f.Values[0].(string))+"%")
+		case biz.OpContains:
+			db = db.Where("? LIKE ?", column, "%"+likeEscaper.Replace(f.Values[0].(string))+"%")
+		default:
+			db = db.Where("? "+f.Op+" ?", column, f.Values[0])
+		}
+	}
+	return db
+}
+
+// 键集分页的条件：排在上一页最后一条记录之后，如 (a > ?) OR (a = ? AND id < ?)
+// after 中的 nil 表示 NULL，NULL 比其他值都小：升序时排在最前，降序时排在最后
+func applyKeyset(db *gorm.DB, orders []biz.OrderBy, after []any) *gorm.DB {
+	var conditions []string
+	var args []any
+	for i, o := range orders {
+		var parts []string
+		var partArgs []any
+		for j := 0; j < i; j++ {
+			column := clause.Column{Name: orders[j].Column}
+			if after[j] == nil {
+				parts = append(parts, "? IS NULL")
+				partArgs = append(partArgs, column)
+			} else {
+				parts = append(parts, "? = ?")
+				partArgs = append(partArgs, column, after[j])
+			}
+		}
+		column := clause.Column{Name: o.Column}
+		switch {
+		case after[i] == nil && o.Desc:
+			// 降序时 NULL 之后没有更小的值
+			continue
+		case after[i] == nil:
+			parts = append(parts, "? IS NOT NULL")
+			partArgs = append(partArgs, column)
+		case o.Desc && o.Nullable:
+			parts = append(parts, "(? < ? OR ? IS NULL)")
+			partArgs = append(partArgs, column, after[i], column)
+		case o.Desc:
+			parts = append(parts, "? < ?")
+			partArgs = append(partArgs, column, after[i])
+		default:
+			parts = append(parts, "? > ?")
+			partArgs = append(partArgs, column, after[i])
+		}
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+		args = append(args, partArgs...)
+	}
+	return db.Where(strings.Join(conditions, " OR "), args...)
+}
+
+// 按 ListQuery 查询一页数据，返回下一页的 page_token 和符合条件的总数
+// 有 page_token 时使用键集分页，否则兼容旧的页码分页
+func listPage[T any](ctx context.Context, db *gorm.DB, q *biz.ListQuery) ([]*T, string, int32, error) {
+	query := applyFilters(db.WithContext(ctx).Model(new(T)), q.Filters)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, "", 0, errors.Error400(err)
+	}
+
+	if q.After != nil {
+		query = applyKeyset(query, q.OrderBy, q.After)
+	} else if q.Page > 1 {
+		query = query.Offset((q.Page - 1) * q.PageSize)
+	}
+	for _, o := range q.OrderBy {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: o.Column}, Desc: o.Desc})
+	}
+
+	// 多查一条判断是否还有下一页
+	var items []*T
+	if err := query.Limit(q.PageSize + 1).Find(&items).Error; err != nil {
+		return nil, "", 0, errors.Error400(err)
+	}
+	if len(items) <= q.PageSize {
+		return items, "", int32(total), nil
+	}
+	items = items[:q.PageSize]
+
+	last, err := orderValues(db, items[len(items)-1], q.OrderBy)
+	if err != nil {
+		return nil, "", 0, errors.Error400(err)
+	}
+	return items, q.NextPageToken(last), int32(total), nil
+}
+
+// 读取记录中排序字段的值
+func orderValues(db *gorm.DB, item any, orders []biz.OrderBy) ([]any, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(item); err != nil {
+		return nil, err
+	}
+	value := reflect.ValueOf(item)
+	values := make([]any, len(orders))
+	for i, o := range orders {
+		field := stmt.Schema.LookUpField(o.Column)
+		if field == nil {
+			return nil, gorm.ErrInvalidField
+		}
+		values[i], _ = field.ValueOf(context.Background(), value)
+	}
+	return values, nil
+}
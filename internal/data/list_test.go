@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"student/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestListPage_NullableKeyset(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t, &biz.Student{})
+	repo := NewStudentRepo(d, log.DefaultLogger)
+
+	// 部分旧数据的创建时间为 NULL
+	base := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 7; i++ {
+		stu := &biz.Student{ID: uint(i), Name: "学生", Status: 1}
+		if i%3 != 0 {
+			createdAt := base.Add(time.Duration(i%4) * time.Hour)
+			stu.CreatedAt = &createdAt
+		}
+		if err := d.gormDB.Omit("created_at").Create(stu).Error; err != nil {
+			t.Fatal(err)
+		}
+		if stu.CreatedAt != nil {
+			if err := d.gormDB.Model(stu).Update("created_at", stu.CreatedAt).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, orderBy := range []string{"created_at", "created_at desc", "created_at, id", "created_at desc, id"} {
+		t.Run(orderBy, func(t *testing.T) {
+			var token string
+			seen := make(map[uint]bool)
+			for pages := 0; ; pages++ {
+				if pages > 7 {
+					t.Fatal("翻页没有结束")
+				}
+				q, err := biz.StudentListSchema.Parse(biz.ListRequest{OrderBy: orderBy, PageSize: 2, PageToken: token})
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+				stus, next, _, err := repo.ListStudents(ctx, q)
+				if err != nil {
+					t.Fatalf("ListStudents() error = %v", err)
+				}
+				for _, stu := range stus {
+					if seen[stu.ID] {
+						t.Errorf("学生 %d 重复出现", stu.ID)
+					}
+					seen[stu.ID] = true
+				}
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			if len(seen) != 7 {
+				t.Errorf("翻页返回 %d 条, want 7", len(seen))
+			}
+		})
+	}
+}
+
+func TestStudentRepo_ExportStudentsEscapesName(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStudentRepo(newTestData(t, &biz.Student{}), log.DefaultLogger)
+	for _, name := range []string{"张三", "李_四"} {
+		if _, err := repo.CreateStudent(ctx, &biz.StudentForm{Name: name, Status: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 通配符按普通字符匹配，不能导出所有学生
+	for _, name := range []string{"%", "_"} {
+		var got []string
+		err := repo.ExportStudents(ctx, name, func(stu *biz.Student) error {
+			got = append(got, stu.Name)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range got {
+			if n == "张三" {
+				t.Errorf("ExportStudents(%q) = %v", name, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFailUserKeyPrefix = "login_fail:user:"
+	loginFailIPKeyPrefix   = "login_fail:ip:"
+	loginLockKeyPrefix     = "login_lock:"
+	loginBackoffKeyPrefix  = "login_backoff:"
+)
+
+// 自增失败计数，首次计数时设置窗口期
+var incrFailureScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+type loginAttemptRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLoginAttemptRepo(data *Data, logger log.Logger) biz.LoginAttemptRepo {
+	return &loginAttemptRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 记录一次登录失败
+func (r *loginAttemptRepo) RecordFailure(ctx context.Context, username, ip string, window time.Duration) (int64, int64, error) {
+	windowMs := window.Milliseconds()
+	userFailures, err := incrFailureScript.Run(ctx, r.data.redis, []string{loginFailUserKeyPrefix + username}, windowMs).Int64()
+	if err != nil {
+		return 0, 0, errors.Error400(err)
+	}
+
+	var ipFailures int64
+	if ip != "" {
+		ipFailures, err = incrFailureScript.Run(ctx, r.data.redis, []string{loginFailIPKeyPrefix + ip}, windowMs).Int64()
+		if err != nil {
+			return 0, 0, errors.Error400(err)
+		}
+	}
+	return userFailures, ipFailures, nil
+}
+
+// 实现 清除用户名的失败记录
+func (r *loginAttemptRepo) ResetFailures(ctx context.Context, username string) error {
+	err := r.data.redis.Del(ctx, loginFailUserKeyPrefix+username, loginBackoffKeyPrefix+username).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 获取IP的失败次数
+func (r *loginAttemptRepo) GetIPFailures(ctx context.Context, ip string) (int64, time.Duration, error) {
+	key := loginFailIPKeyPrefix + ip
+	pipe := r.data.redis.Pipeline()
+	countCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.PTTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, 0, errors.Error400(err)
+	}
+
+	count, err := countCmd.Int64()
+	if err != nil {
+		return 0, 0, nil
+	}
+	return count, max(ttlCmd.Val(), 0), nil
+}
+
+// 实现 锁定账户
+func (r *loginAttemptRepo) LockAccount(ctx context.Context, username string, duration time.Duration) error {
+	pipe := r.data.redis.TxPipeline()
+	pipe.Set(ctx, loginLockKeyPrefix+username, time.Now().Unix(), duration)
+	pipe.Del(ctx, loginFailUserKeyPrefix+username, loginBackoffKeyPrefix+username)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: LockAccount, username: ", username)
+	return nil
+}
+
+// 实现 设置退避时间
+func (r *loginAttemptRepo) SetBackoff(ctx context.Context, username string, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+	err := r.data.redis.Set(ctx, loginBackoffKeyPrefix+username, 1, duration).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 获取账户锁定和退避的剩余时间
+func (r *loginAttemptRepo) GetRestriction(ctx context.Context, username string) (time.Duration, time.Duration, error) {
+	pipe := r.data.redis.Pipeline()
+	lockCmd := pipe.PTTL(ctx, loginLockKeyPrefix+username)
+	backoffCmd := pipe.PTTL(ctx, loginBackoffKeyPrefix+username)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, errors.Error400(err)
+	}
+	// 键不存在时 PTTL 返回负值
+	return max(lockCmd.Val(), 0), max(backoffCmd.Val(), 0), nil
+}
+
+// 实现 解除账户锁定
+func (r *loginAttemptRepo) UnlockAccount(ctx context.Context, username string) error {
+	err := r.data.redis.Del(ctx, loginLockKeyPrefix+username, loginFailUserKeyPrefix+username, loginBackoffKeyPrefix+username).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: UnlockAccount, username: ", username)
+	return nil
+}
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	mfaChallengeKeyPrefix = "mfa_challenge:"
+	mfaStepKeyPrefix      = "mfa_step:"
+)
+
+// 原子地增加挑战的尝试次数，返回 user_id 和尝试次数
+var useMFAChallengeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+return {redis.call('HGET', KEYS[1], 'user_id'), attempts}
+`)
+
+type mfaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMFARepo(data *Data, logger log.Logger) biz.MFARepo {
+	return &mfaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 保存两步验证挑战
+func (r *mfaRepo) CreateChallenge(ctx context.Context, challengeHash string, userID uint, ttl time.Duration) error {
+	key := mfaChallengeKeyPrefix + challengeHash
+	pipe := r.data.redis.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"user_id":  userID,
+		"attempts": 0,
+	})
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 使用两步验证挑战
+func (r *mfaRepo) UseChallenge(ctx context.Context, challengeHash string) (uint, int64, error) {
+	res, err := useMFAChallengeScript.Run(ctx, r.data.redis, []string{mfaChallengeKeyPrefix + challengeHash}).Slice()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, 0, errors.Error404()
+		}
+		return 0, 0, errors.Error400(err)
+	}
+	if len(res) != 2 {
+		return 0, 0, errors.Error400(fmt.Errorf("unexpected mfa challenge record: %v", res))
+	}
+
+	userID, err := strconv.ParseUint(fmt.Sprint(res[0]), 10, 64)
+	if err != nil {
+		return 0, 0, errors.Error400(err)
+	}
+	attempts, _ := res[1].(int64)
+	return uint(userID), attempts, nil
+}
+
+// 实现 删除两步验证挑战
+func (r *mfaRepo) DeleteChallenge(ctx context.Context, challengeHash string) error {
+	err := r.data.redis.Del(ctx, mfaChallengeKeyPrefix+challengeHash).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 标记验证码时间步已使用
+func (r *mfaRepo) MarkStepUsed(ctx context.Context, userID uint, step int64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%d:%d", mfaStepKeyPrefix, userID, step)
+	ok, err := r.data.redis.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		return false, errors.Error400(err)
+	}
+	return ok, nil
+}
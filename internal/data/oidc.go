@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const oauthCodeKeyPrefix = "oauth_code:"
+
+type oauthClientRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewOAuthClientRepo(data *Data, logger log.Logger) biz.OAuthClientRepo {
+	return &oauthClientRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 创建 OIDC 客户端
+func (r *oauthClientRepo) CreateClient(ctx context.Context, client *biz.OAuthClient) error {
+	err := r.data.gormDB.WithContext(ctx).Create(client).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateOAuthClient, client_id: %s", client.ClientID)
+	return nil
+}
+
+// 实现 获取 OIDC 客户端列表
+func (r *oauthClientRepo) ListClients(ctx context.Context) ([]*biz.OAuthClient, error) {
+	var clients []*biz.OAuthClient
+	err := r.data.gormDB.WithContext(ctx).Order("id desc").Find(&clients).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return clients, nil
+}
+
+// 实现 通过 client_id 获取 OIDC 客户端
+func (r *oauthClientRepo) GetClient(ctx context.Context, clientID string) (*biz.OAuthClient, error) {
+	var client biz.OAuthClient
+	err := r.data.gormDB.WithContext(ctx).Where("client_id = ?", clientID).First(&client).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, errors.Error400(err)
+	}
+	return &client, nil
+}
+
+// 实现 删除 OIDC 客户端
+func (r *oauthClientRepo) DeleteClient(ctx context.Context, clientID string) (bool, error) {
+	result := r.data.gormDB.WithContext(ctx).Where("client_id = ?", clientID).Delete(&biz.OAuthClient{})
+	if result.Error != nil {
+		return false, errors.Error400(result.Error)
+	}
+	r.log.WithContext(ctx).Info("gormDB: DeleteOAuthClient, client_id: %s", clientID)
+	return result.RowsAffected > 0, nil
+}
+
+type authorizationCodeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuthorizationCodeRepo(data *Data, logger log.Logger) biz.AuthorizationCodeRepo {
+	return &authorizationCodeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 在 redis 中保存授权码
+func (r *authorizationCodeRepo) SaveCode(ctx context.Context, codeHash string, code *biz.AuthorizationCode, ttl time.Duration) error {
+	value, err := json.Marshal(code)
+	if err != nil {
+		return errors.Error400(err)
+	}
+	if err := r.data.redis.Set(ctx, oauthCodeKeyPrefix+codeHash, value, ttl).Err(); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 使用授权码，读取后立即删除
+func (r *authorizationCodeRepo) ConsumeCode(ctx context.Context, codeHash string) (*biz.AuthorizationCode, error) {
+	value, err := r.data.redis.GetDel(ctx, oauthCodeKeyPrefix+codeHash).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+
+	var code biz.AuthorizationCode
+	if err := json.Unmarshal(value, &code); err != nil {
+		return nil, nil
+	}
+	return &code, nil
+}
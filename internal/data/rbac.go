@@ -79,19 +79,26 @@ func (r *rbacRepo) CreateRole(ctx context.Context, roleForm *biz.RoleForm) (*biz
 	return &role, nil
 }
 
-func (r *rbacRepo) UpdateRole(ctx context.Context, id int32, roleForm *biz.RoleForm) (*biz.Role, error) {
+func (r *rbacRepo) UpdateRole(ctx context.Context, id int32, roleForm *biz.RoleForm, columns []string) (*biz.Role, error) {
 	var role biz.Role
 	err := r.data.gormDB.WithContext(ctx).First(&role, id).Error
 	if err != nil {
 		return nil, errors.Error404()
 	}
+	if role.Version != roleForm.Version {
+		return nil, biz.ErrorVersionConflict()
+	}
 
-	role.Name = roleForm.Name
-	role.Description = roleForm.Description
-	role.Status = roleForm.Status
-
-	err = r.data.gormDB.WithContext(ctx).Save(&role).Error
-	if err != nil {
+	result := r.data.gormDB.WithContext(ctx).Model(&role).Where("version = ?", roleForm.Version).Select(withVersion(columns)).Updates(&biz.Role{
+		Name:        roleForm.Name,
+		Description: roleForm.Description,
+		Status:      roleForm.Status,
+		Version:     roleForm.Version + 1,
+	})
+	if err := versionResult(result); err != nil {
+		return nil, err
+	}
+	if err := r.data.gormDB.WithContext(ctx).First(&role, id).Error; err != nil {
 		return nil, errors.Error400(err)
 	}
 
@@ -99,45 +106,30 @@ func (r *rbacRepo) UpdateRole(ctx context.Context, id int32, roleForm *biz.RoleF
 	return &role, nil
 }
 
-func (r *rbacRepo) DeleteRole(ctx context.Context, id int32) error {
+func (r *rbacRepo) DeleteRole(ctx context.Context, id int32, version uint) error {
 	var role biz.Role
 	err := r.data.gormDB.WithContext(ctx).First(&role, id).Error
 	if err != nil {
 		return errors.Error404()
 	}
-
-	return r.data.gormDB.WithContext(ctx).Delete(&role).Error
-}
-
-func (r *rbacRepo) ListRoles(ctx context.Context, page int32, pageSize int32, name string) ([]*biz.Role, int32, error) {
-	var roles []*biz.Role
-	var total int64
-
-	query := r.data.gormDB.WithContext(ctx).Model(&biz.Role{})
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
-	}
-
-	err := query.Count(&total).Error
-	if err != nil {
-		return nil, 0, errors.Error400(err)
+	if role.Version != version {
+		return biz.ErrorVersionConflict()
 	}
 
-	query = r.data.gormDB.WithContext(ctx).Model(&biz.Role{})
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
-	}
+	return versionResult(r.data.gormDB.WithContext(ctx).Where("version = ?", version).Delete(&role))
+}
 
-	err = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&roles).Error
+func (r *rbacRepo) ListRoles(ctx context.Context, q *biz.ListQuery) ([]*biz.Role, string, int32, error) {
+	roles, next, total, err := listPage[biz.Role](ctx, r.data.gormDB, q)
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return nil, "", 0, err
 	}
 
 	for _, role := range roles {
 		role.FormatTimeFields()
 	}
 
-	return roles, int32(total), nil
+	return roles, next, total, nil
 }
 
 func (r *rbacRepo) GetRoleByName(ctx context.Context, name string) (*biz.Role, error) {
@@ -187,21 +179,28 @@ func (r *rbacRepo) CreatePermission(ctx context.Context, permissionForm *biz.Per
 	return &permission, nil
 }
 
-func (r *rbacRepo) UpdatePermission(ctx context.Context, id int32, permissionForm *biz.PermissionForm) (*biz.Permission, error) {
+func (r *rbacRepo) UpdatePermission(ctx context.Context, id int32, permissionForm *biz.PermissionForm, columns []string) (*biz.Permission, error) {
 	var permission biz.Permission
 	err := r.data.gormDB.WithContext(ctx).First(&permission, id).Error
 	if err != nil {
 		return nil, errors.Error404()
 	}
+	if permission.Version != permissionForm.Version {
+		return nil, biz.ErrorVersionConflict()
+	}
 
-	permission.Name = permissionForm.Name
-	permission.Resource = permissionForm.Resource
-	permission.Action = permissionForm.Action
-	permission.Description = permissionForm.Description
-	permission.Status = permissionForm.Status
-
-	err = r.data.gormDB.WithContext(ctx).Save(&permission).Error
-	if err != nil {
+	result := r.data.gormDB.WithContext(ctx).Model(&permission).Where("version = ?", permissionForm.Version).Select(withVersion(columns)).Updates(&biz.Permission{
+		Name:        permissionForm.Name,
+		Resource:    permissionForm.Resource,
+		Action:      permissionForm.Action,
+		Description: permissionForm.Description,
+		Status:      permissionForm.Status,
+		Version:     permissionForm.Version + 1,
+	})
+	if err := versionResult(result); err != nil {
+		return nil, err
+	}
+	if err := r.data.gormDB.WithContext(ctx).First(&permission, id).Error; err != nil {
 		return nil, errors.Error400(err)
 	}
 
@@ -209,51 +208,30 @@ func (r *rbacRepo) UpdatePermission(ctx context.Context, id int32, permissionFor
 	return &permission, nil
 }
 
-func (r *rbacRepo) DeletePermission(ctx context.Context, id int32) error {
+func (r *rbacRepo) DeletePermission(ctx context.Context, id int32, version uint) error {
 	var permission biz.Permission
 	err := r.data.gormDB.WithContext(ctx).First(&permission, id).Error
 	if err != nil {
 		return errors.Error404()
 	}
-
-	return r.data.gormDB.WithContext(ctx).Delete(&permission).Error
-}
-
-func (r *rbacRepo) ListPermissions(ctx context.Context, page int32, pageSize int32, name, resource string) ([]*biz.Permission, int32, error) {
-	var permissions []*biz.Permission
-	var total int64
-
-	query := r.data.gormDB.WithContext(ctx).Model(&biz.Permission{})
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
-	}
-	if resource != "" {
-		query = query.Where("resource LIKE ?", "%"+resource+"%")
+	if permission.Version != version {
+		return biz.ErrorVersionConflict()
 	}
 
-	err := query.Count(&total).Error
-	if err != nil {
-		return nil, 0, errors.Error400(err)
-	}
-
-	query = r.data.gormDB.WithContext(ctx).Model(&biz.Permission{})
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
-	}
-	if resource != "" {
-		query = query.Where("resource LIKE ?", "%"+resource+"%")
-	}
+	return versionResult(r.data.gormDB.WithContext(ctx).Where("version = ?", version).Delete(&permission))
+}
 
-	err = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&permissions).Error
+func (r *rbacRepo) ListPermissions(ctx context.Context, q *biz.ListQuery) ([]*biz.Permission, string, int32, error) {
+	permissions, next, total, err := listPage[biz.Permission](ctx, r.data.gormDB, q)
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return nil, "", 0, err
 	}
 
 	for _, permission := range permissions {
 		permission.FormatTimeFields()
 	}
 
-	return permissions, int32(total), nil
+	return permissions, next, total, nil
 }
 
 func (r *rbacRepo) GetPermissionByResourceAction(ctx context.Context, resource, action string) (*biz.Permission, error) {
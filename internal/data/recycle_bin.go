@@ -0,0 +1,327 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"gorm.io/gorm"
+)
+
+// 回收站中的数据即 deleted_at 不为空的行，查询时都要用 Unscoped 跳过 GORM 的软删除条件
+
+// 按删除时间倒序分页读取已删除的行
+func listDeleted[T any](ctx context.Context, db *gorm.DB, page, pageSize int32) ([]*T, int32, error) {
+	var total int64
+	err := db.WithContext(ctx).Unscoped().Model(new(T)).Where("deleted_at IS NOT NULL").Count(&total).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var rows []*T
+	err = db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc, id desc").
+		Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).
+		Find(&rows).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	return rows, int32(total), nil
+}
+
+// 读取回收站中的一行，不存在或没有被删除时返回 404
+func findDeleted[T any](ctx context.Context, db *gorm.DB, id int32) (*T, error) {
+	var row T
+	err := db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(&row, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	return &row, nil
+}
+
+// 清空删除时间并把版本号加一，返回恢复后的行
+func restoreDeleted[T any](ctx context.Context, db *gorm.DB, id int32) (*T, error) {
+	if _, err := findDeleted[T](ctx, db, id); err != nil {
+		return nil, err
+	}
+	result := db.WithContext(ctx).Unscoped().Model(new(T)).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return nil, errors.Error400(result.Error)
+	}
+	// 并发恢复或彻底删除时只有一个请求生效
+	if result.RowsAffected == 0 {
+		return nil, errors.Error404()
+	}
+	var row T
+	if err := db.WithContext(ctx).First(&row, id).Error; err != nil {
+		return nil, errors.Error400(err)
+	}
+	return &row, nil
+}
+
+// 删除时间早于 before 的行的 ID
+func deletedBefore[T any](ctx context.Context, db *gorm.DB, before time.Time) ([]uint, error) {
+	var ids []uint
+	err := db.WithContext(ctx).Unscoped().Model(new(T)).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("id").Pluck("id", &ids).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return ids, nil
+}
+
+// 实现 获取回收站中的学生
+func (r *studentRepo) ListDeletedStudents(ctx context.Context, page, pageSize int32) ([]*biz.Student, int32, error) {
+	stus, total, err := listDeleted[biz.Student](ctx, r.data.gormDB, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	biz.FormatTimeFieldsBatch(stus)
+	return stus, total, nil
+}
+
+// 实现 恢复已删除的学生
+func (r *studentRepo) RestoreStudent(ctx context.Context, id int32) (*biz.Student, error) {
+	stu, err := restoreDeleted[biz.Student](ctx, r.data.gormDB, id)
+	if err != nil {
+		return nil, err
+	}
+	stu.FormatTimeFields()
+	r.log.WithContext(ctx).Info("gormDB: RestoreStudent, id: %d", id)
+	return stu, nil
+}
+
+// 实现 彻底删除学生，连同选课、成绩、考勤、监护关系和学籍变动记录
+func (r *studentRepo) PurgeStudent(ctx context.Context, id int32) error {
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := findDeleted[biz.Student](ctx, tx, id); err != nil {
+			return err
+		}
+		// 仍在修读的班级释放名额
+		var classIDs []uint
+		err := tx.Model(&biz.Enrollment{}).
+			Where("student_id = ? AND status = ?", id, biz.EnrollmentStatusEnrolled).
+			Pluck("class_id", &classIDs).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		for _, classID := range classIDs {
+			err := tx.Model(&biz.Class{}).Where("id = ? AND enrolled > 0", classID).
+				Update("enrolled", gorm.Expr("enrolled - 1")).Error
+			if err != nil {
+				return errors.Error400(err)
+			}
+		}
+
+		var guardianIDs []uint
+		err = tx.Model(&biz.StudentGuardian{}).Where("student_id = ?", id).Pluck("guardian_id", &guardianIDs).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+
+		for _, model := range []any{&biz.Enrollment{}, &biz.Grade{}, &biz.AttendanceRecord{}, &biz.StudentGuardian{}, &biz.StudentStatusChange{}} {
+			if err := tx.Unscoped().Where("student_id = ?", id).Delete(model).Error; err != nil {
+				return errors.Error400(err)
+			}
+		}
+		// 不再关联任何学生的监护人一并删除，与移除监护人时一致
+		if len(guardianIDs) > 0 {
+			err = tx.Where("id IN ? AND id NOT IN (?)", guardianIDs, tx.Model(&biz.StudentGuardian{}).Select("guardian_id")).
+				Delete(&biz.Guardian{}).Error
+			if err != nil {
+				return errors.Error400(err)
+			}
+		}
+		if err := tx.Unscoped().Delete(&biz.Student{}, id).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.log.WithContext(ctx).Info("gormDB: PurgeStudent, id: %d", id)
+	return nil
+}
+
+// 实现 获取删除时间早于 before 的学生 ID
+func (r *studentRepo) ListDeletedStudentIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return deletedBefore[biz.Student](ctx, r.data.gormDB, before)
+}
+
+// 实现 获取回收站中的用户
+func (r *userRepo) ListDeletedUsers(ctx context.Context, page, pageSize int32) ([]*biz.User, int32, error) {
+	users, total, err := listDeleted[biz.User](ctx, r.data.gormDB, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	biz.FormatUserTimeFieldsBatch(users)
+	return users, total, nil
+}
+
+// 实现 恢复已删除的用户
+func (r *userRepo) RestoreUser(ctx context.Context, id int32) (*biz.User, error) {
+	user, err := restoreDeleted[biz.User](ctx, r.data.gormDB, id)
+	if err != nil {
+		return nil, err
+	}
+	user.FormatTimeFields()
+	r.log.WithContext(ctx).Info("gormDB: RestoreUser, id: %d", id)
+	return user, nil
+}
+
+// 实现 彻底删除用户，连同会话、API 密钥、第三方账号绑定和角色，监护人取消关联该账号
+func (r *userRepo) PurgeUser(ctx context.Context, id int32) error {
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := findDeleted[biz.User](ctx, tx, id); err != nil {
+			return err
+		}
+		for _, model := range []any{&biz.Session{}, &biz.APIKey{}, &biz.ExternalIdentity{}, &biz.UserRole{}} {
+			if err := tx.Unscoped().Where("user_id = ?", id).Delete(model).Error; err != nil {
+				return errors.Error400(err)
+			}
+		}
+		err := tx.Model(&biz.Guardian{}).Where("user_id = ?", id).Update("user_id", nil).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		if err := tx.Unscoped().Delete(&biz.User{}, id).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.log.WithContext(ctx).Info("gormDB: PurgeUser, id: %d", id)
+	return nil
+}
+
+// 实现 获取删除时间早于 before 的用户 ID
+func (r *userRepo) ListDeletedUserIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return deletedBefore[biz.User](ctx, r.data.gormDB, before)
+}
+
+func (r *rbacRepo) ListDeletedRoles(ctx context.Context, page, pageSize int32) ([]*biz.Role, int32, error) {
+	roles, total, err := listDeleted[biz.Role](ctx, r.data.gormDB, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, role := range roles {
+		role.FormatTimeFields()
+	}
+	return roles, total, nil
+}
+
+func (r *rbacRepo) RestoreRole(ctx context.Context, id int32) (*biz.Role, error) {
+	role, err := restoreDeleted[biz.Role](ctx, r.data.gormDB, id)
+	if err != nil {
+		return nil, err
+	}
+	role.FormatTimeFields()
+	return role, nil
+}
+
+// 彻底删除角色，同时删除用户角色、角色权限关联和 Casbin 中该角色的策略
+func (r *rbacRepo) PurgeRole(ctx context.Context, id int32) error {
+	var role *biz.Role
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		role, err = findDeleted[biz.Role](ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		for _, model := range []any{&biz.UserRole{}, &biz.RolePermission{}} {
+			if err := tx.Where("role_id = ?", id).Delete(model).Error; err != nil {
+				return errors.Error400(err)
+			}
+		}
+		if err := tx.Unscoped().Delete(&biz.Role{}, id).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.enforcer.RemoveFilteredPolicy(0, role.Name); err != nil {
+		return err
+	}
+	_, err = r.enforcer.RemoveFilteredGroupingPolicy(1, role.Name)
+	return err
+}
+
+func (r *rbacRepo) ListDeletedRoleIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return deletedBefore[biz.Role](ctx, r.data.gormDB, before)
+}
+
+func (r *rbacRepo) ListDeletedPermissions(ctx context.Context, page, pageSize int32) ([]*biz.Permission, int32, error) {
+	permissions, total, err := listDeleted[biz.Permission](ctx, r.data.gormDB, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, permission := range permissions {
+		permission.FormatTimeFields()
+	}
+	return permissions, total, nil
+}
+
+func (r *rbacRepo) RestorePermission(ctx context.Context, id int32) (*biz.Permission, error) {
+	permission, err := restoreDeleted[biz.Permission](ctx, r.data.gormDB, id)
+	if err != nil {
+		return nil, err
+	}
+	permission.FormatTimeFields()
+	return permission, nil
+}
+
+// 彻底删除权限，同时删除角色权限关联和 Casbin 中授予该权限的策略
+func (r *rbacRepo) PurgePermission(ctx context.Context, id int32) error {
+	var permission *biz.Permission
+	var roleNames []string
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		permission, err = findDeleted[biz.Permission](ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		// 已删除的角色也可能仍有该权限的策略
+		err = tx.Unscoped().Model(&biz.Role{}).
+			Joins("JOIN role_permissions ON role_permissions.role_id = roles.id").
+			Where("role_permissions.permission_id = ?", id).
+			Pluck("roles.name", &roleNames).Error
+		if err != nil {
+			return errors.Error400(err)
+		}
+		if err := tx.Where("permission_id = ?", id).Delete(&biz.RolePermission{}).Error; err != nil {
+			return errors.Error400(err)
+		}
+		if err := tx.Unscoped().Delete(&biz.Permission{}, id).Error; err != nil {
+			return errors.Error400(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range roleNames {
+		if _, err := r.enforcer.RemovePolicy(name, permission.Resource, permission.Action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *rbacRepo) ListDeletedPermissionIDsBefore(ctx context.Context, before time.Time) ([]uint, error) {
+	return deletedBefore[biz.Permission](ctx, r.data.gormDB, before)
+}
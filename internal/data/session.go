@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const sessionSeenKeyPrefix = "session_seen:"
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	return &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 创建登录会话
+func (r *sessionRepo) CreateSession(ctx context.Context, session *biz.Session) error {
+	err := r.data.gormDB.WithContext(ctx).Create(session).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateSession, user_id: %d, ip: %s", session.UserID, session.IP)
+	return nil
+}
+
+// 实现 获取用户未撤销的会话
+func (r *sessionRepo) ListSessions(ctx context.Context, userID uint) ([]*biz.Session, error) {
+	var sessions []*biz.Session
+	err := r.data.gormDB.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("last_seen_at desc").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	return sessions, nil
+}
+
+// 实现 撤销会话
+func (r *sessionRepo) RevokeSession(ctx context.Context, userID uint, sessionID string, revokedAt time.Time) (bool, error) {
+	result := r.data.gormDB.WithContext(ctx).Model(&biz.Session{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return false, errors.Error400(result.Error)
+	}
+	r.log.WithContext(ctx).Info("gormDB: RevokeSession, user_id: %d, session: %s", userID, sessionID)
+	return result.RowsAffected > 0, nil
+}
+
+// 实现 撤销用户的其他会话
+func (r *sessionRepo) RevokeUserSessions(ctx context.Context, userID uint, keepSessionID string, revokedAt time.Time) error {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID)
+	if keepSessionID != "" {
+		query = query.Where("id <> ?", keepSessionID)
+	}
+	if err := query.Update("revoked_at", revokedAt).Error; err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: RevokeUserSessions, user_id: %d", userID)
+	return nil
+}
+
+// 实现 更新会话最后活跃时间，通过 redis 限制更新频率
+func (r *sessionRepo) TouchSession(ctx context.Context, sessionID string, seenAt time.Time, interval time.Duration) error {
+	ok, err := r.data.redis.SetNX(ctx, sessionSeenKeyPrefix+sessionID, 1, interval).Result()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	if !ok {
+		return nil
+	}
+
+	err = r.data.gormDB.WithContext(ctx).Model(&biz.Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		UpdateColumn("last_seen_at", seenAt).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
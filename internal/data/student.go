@@ -45,6 +45,7 @@ func (r *studentRepo) GetStudent(ctx context.Context, id int32) (*biz.Student, e
 		Info:         stu.Info,
 		ID:           stu.ID,
 		Age:          stu.Age,
+		Version:      stu.Version,
 		CreatedAt:    stu.CreatedAt,
 		UpdatedAt:    stu.UpdatedAt,
 		CreatedAtStr: stu.CreatedAtStr,
@@ -66,35 +67,37 @@ func (r *studentRepo) CreateStudent(ctx context.Context, s *biz.StudentForm) (*b
 	}
 	r.log.WithContext(ctx).Info("gormDB: CreateStudent, student: %v", stu)
 	return &biz.CreateStudentMessage{
+		ID:      int32(stu.ID),
 		Message: "Create student success",
 	}, err
 }
 
 // 实现 从 gormDB 中更新学生信息
-func (r *studentRepo) UpdateStudent(ctx context.Context, id int32, s *biz.StudentForm) (*biz.UpdateStudentMessage, error) {
-	// TODO: implement the logic of updating student
+func (r *studentRepo) UpdateStudent(ctx context.Context, id int32, s *biz.StudentForm, columns []string) (*biz.UpdateStudentMessage, error) {
 	var stu biz.Student
 	err := r.data.gormDB.WithContext(ctx).First(&stu, id).Error
 	if err != nil {
 		return nil, errors.Error404()
 	}
-	stu.Name = s.Name
-	stu.Info = s.Info
-	stu.Status = s.Status
-	stu.Age = s.Age
-	err = r.data.gormDB.WithContext(ctx).Save(&stu).Error
-	if err != nil {
-		return nil, errors.Error400(err)
+	if stu.Version != s.Version {
+		return nil, biz.ErrorVersionConflict()
+	}
+	// 只更新指定的列，零值也会写入；学籍状态只通过 ChangeStudentStatus 修改，避免覆盖并发的状态变动
+	result := r.data.gormDB.WithContext(ctx).Model(&stu).Where("version = ?", s.Version).
+		Select(withVersion(columns)).Omit("status").
+		Updates(&biz.Student{Name: s.Name, Info: s.Info, Age: s.Age, Version: s.Version + 1})
+	if err := versionResult(result); err != nil {
+		return nil, err
 	}
 	r.log.WithContext(ctx).Info("gormDB: UpdateStudent, id: %d, student: %v", id, stu)
 	return &biz.UpdateStudentMessage{
 		Message: "Update student success",
-	}, err
+		Version: s.Version + 1,
+	}, nil
 }
 
 // 实现 从 gormDB 中删除学生
-func (r *studentRepo) DeleteStudent(ctx context.Context, id int32) (*biz.DeleteStudentMessage, error) {
-	// TODO: implement the logic of deleting student
+func (r *studentRepo) DeleteStudent(ctx context.Context, id int32, version uint) (*biz.DeleteStudentMessage, error) {
 	var stu biz.Student
 	err := r.data.gormDB.WithContext(ctx).First(&stu, id).Error
 	if err != nil {
@@ -103,38 +106,120 @@ func (r *studentRepo) DeleteStudent(ctx context.Context, id int32) (*biz.DeleteS
 		}
 		return nil, errors.Error400(err)
 	}
-	err = r.data.gormDB.Delete(&stu, id).Error
+	if stu.Version != version {
+		return nil, biz.ErrorVersionConflict()
+	}
+	if err := versionResult(r.data.gormDB.WithContext(ctx).Where("version = ?", version).Delete(&stu)); err != nil {
+		return nil, err
+	}
 	r.log.WithContext(ctx).Info("gormDB: DeleteStudent, id: %d", id)
 	return &biz.DeleteStudentMessage{
 		Message: "Delete student success",
-	}, err
+	}, nil
 }
 
 // 实现 从 gormDB 中获取学生列表
-func (r *studentRepo) ListStudents(ctx context.Context, page int32, pageSize int32, name string) ([]*biz.Student, int32, error) {
-	var stus []*biz.Student
-	var total int64
-	var err error
-	if name == "" {
-		err = r.data.gormDB.WithContext(ctx).Model(&biz.Student{}).Count(&total).Error
-	} else {
-		err = r.data.gormDB.WithContext(ctx).Model(&biz.Student{}).Where("name LIKE ?", "%"+name+"%").Count(&total).Error
+func (r *studentRepo) ListStudents(ctx context.Context, q *biz.ListQuery) ([]*biz.Student, string, int32, error) {
+	stus, next, total, err := listPage[biz.Student](ctx, r.data.gormDB, q)
+	if err != nil {
+		return nil, "", 0, err
 	}
+
+	// 为每个学生记录格式化时间字段
+	biz.FormatTimeFieldsBatch(stus)
+
+	return stus, next, total, nil
+}
+
+// 实现 使用游标逐行读取学生，过滤条件与 ListStudents 相同
+func (r *studentRepo) ExportStudents(ctx context.Context, name string, fn func(*biz.Student) error) error {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Student{})
+	if name != "" {
+		// 与列表使用相同的转义，name 中的 % 和 _ 不作为通配符
+		query = applyFilters(query, []biz.Filter{{Column: "name", Op: biz.OpContains, Values: []any{name}}})
+	}
+	rows, err := query.Order("id desc").Rows()
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return errors.Error400(err)
 	}
-	// logger.Println(page, pageSize)
-	if name == "" {
-		err = r.data.gormDB.WithContext(ctx).Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&stus).Error
-	} else {
-		err = r.data.gormDB.WithContext(ctx).Where("name LIKE ?", "%"+name+"%").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&stus).Error
+	defer rows.Close()
+	for rows.Next() {
+		var stu biz.Student
+		if err := r.data.gormDB.ScanRows(rows, &stu); err != nil {
+			return errors.Error400(err)
+		}
+		stu.FormatTimeFields()
+		if err := fn(&stu); err != nil {
+			return err
+		}
 	}
+	if err := rows.Err(); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 按条件更新学籍状态并写入变动记录，状态已被其他请求修改时不更新
+func (r *studentRepo) ChangeStudentStatus(ctx context.Context, change *biz.StudentStatusChange) error {
+	return r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&biz.Student{}).
+			Where("id = ? AND status = ?", change.StudentID, change.FromStatus).
+			Updates(map[string]any{"status": change.ToStatus, "version": gorm.Expr("version + 1")})
+		if result.Error != nil {
+			return errors.Error400(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrorInvalidStatusTransition(change.FromStatus, change.ToStatus)
+		}
+		if err := tx.Create(change).Error; err != nil {
+			return errors.Error400(err)
+		}
+		r.log.WithContext(ctx).Info("gormDB: ChangeStudentStatus, student_id: %d, %d -> %d", change.StudentID, change.FromStatus, change.ToStatus)
+		return nil
+	})
+}
+
+// 导入时每批写入的学生数量
+const studentImportBatchSize = 200
+
+// 实现 在一个事务中分批创建学生
+func (r *studentRepo) CreateStudents(ctx context.Context, forms []*biz.StudentForm) ([]*biz.Student, error) {
+	students := make([]*biz.Student, 0, len(forms))
+	for _, s := range forms {
+		students = append(students, &biz.Student{Name: s.Name, Info: s.Info, Status: s.Status, Age: s.Age})
+	}
+	err := r.data.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(students, studentImportBatchSize).Error
+	})
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return nil, errors.Error400(err)
 	}
+	r.log.WithContext(ctx).Info("gormDB: CreateStudents, count: %d", len(students))
+	return students, nil
+}
 
-	// 为每个学生记录格式化时间字段
+// 实现 按 ID 批量获取学生
+func (r *studentRepo) GetStudentsByIDs(ctx context.Context, ids []uint) ([]*biz.Student, error) {
+	var stus []*biz.Student
+	if err := r.data.gormDB.WithContext(ctx).Where("id IN ?", ids).Find(&stus).Error; err != nil {
+		return nil, errors.Error400(err)
+	}
 	biz.FormatTimeFieldsBatch(stus)
+	return stus, nil
+}
 
-	return stus, int32(total), err
+// 实现 获取学生的学籍变动记录
+func (r *studentRepo) ListStatusChanges(ctx context.Context, studentID uint) ([]*biz.StudentStatusChange, error) {
+	var changes []*biz.StudentStatusChange
+	err := r.data.gormDB.WithContext(ctx).Preload("Term").
+		Where("student_id = ?", studentID).
+		Order("id desc").
+		Find(&changes).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+	for _, c := range changes {
+		c.FormatTimeFields()
+	}
+	return changes, nil
 }
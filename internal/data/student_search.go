@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"student/internal/biz"
+	"student/internal/conf"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 学生搜索索引的实现方式
+const (
+	SearchDriverMySQL = "mysql"
+	SearchDriverBleve = "bleve"
+)
+
+// 高亮片段的最大长度（字符数）
+const snippetLength = 60
+
+// NewSearchIndex 根据配置创建学生搜索索引，默认使用 MySQL FULLTEXT 索引
+func NewSearchIndex(c *conf.Bootstrap, data *Data, logger log.Logger) (biz.SearchIndex, func(), error) {
+	search := c.GetData().GetSearch()
+	switch search.GetDriver() {
+	case SearchDriverMySQL, "":
+		return &mysqlSearchIndex{data: data, log: log.NewHelper(logger)}, func() {}, nil
+	case SearchDriverBleve:
+		index, err := newBleveSearchIndex(search.GetPath(), data, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return index, index.close, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported search driver: %s", search.GetDriver())
+	}
+}
+
+// 使用 students 表上的 FULLTEXT 索引（ngram 解析器）搜索，索引由数据库维护
+type mysqlSearchIndex struct {
+	data *Data
+	log  *log.Helper
+}
+
+// 实现 FULLTEXT 索引随数据写入自动更新，无需同步
+func (r *mysqlSearchIndex) IndexStudents(ctx context.Context, students []*biz.Student) error {
+	return nil
+}
+
+// 实现 FULLTEXT 索引随数据删除自动更新，无需同步
+func (r *mysqlSearchIndex) DeleteStudent(ctx context.Context, id uint) error {
+	return nil
+}
+
+// 实现 按姓名和备注的全文相关度搜索学生
+func (r *mysqlSearchIndex) SearchStudents(ctx context.Context, q *biz.SearchQuery) (*biz.SearchResult, error) {
+	const match = "MATCH(name, info) AGAINST(? IN NATURAL LANGUAGE MODE)"
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.Student{}).Where(match, q.Query)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, errors.Error400(err)
+	}
+
+	var rows []struct {
+		ID    uint
+		Name  string
+		Info  string
+		Score float64
+	}
+	err := query.Select("id, name, info, "+match+" AS score", q.Query).
+		Order("score DESC, id DESC").
+		Offset(q.Offset).Limit(q.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Error400(err)
+	}
+
+	result := &biz.SearchResult{Total: int(total)}
+	for _, row := range rows {
+		hit := &biz.SearchHit{ID: row.ID, Score: row.Score, Highlights: make(map[string][]string)}
+		if snippet := highlightSnippet(row.Name, q.Query); snippet != "" {
+			hit.Highlights["name"] = []string{snippet}
+		}
+		if snippet := highlightSnippet(row.Info, q.Query); snippet != "" {
+			hit.Highlights["info"] = []string{snippet}
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+	return result, nil
+}
+
+// 用 <mark> 标记 text 中的关键词，与 ngram 解析器一致，较长的中文关键词也按相邻两个字匹配
+// 文本过长时截取第一个匹配附近的片段，没有匹配时返回空字符串
+func highlightSnippet(text, query string) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		t := []rune(term)
+		terms := [][]rune{t}
+		for i := 0; len(t) > 2 && i+2 <= len(t); i++ {
+			terms = append(terms, t[i:i+2])
+		}
+		for _, t := range terms {
+			for i := 0; i+len(t) <= len(lower); i++ {
+				if string(lower[i:i+len(t)]) != string(t) {
+					continue
+				}
+				for j := i; j < i+len(t); j++ {
+					marked[j] = true
+				}
+				if first == -1 || i < first {
+					first = i
+				}
+			}
+		}
+	}
+	if first == -1 {
+		return ""
+	}
+
+	start, end := 0, len(runes)
+	if len(runes) > snippetLength {
+		start = max(0, first-snippetLength/4)
+		end = min(len(runes), start+snippetLength)
+	}
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		if marked[i] {
+			b.WriteString("<mark>" + html.EscapeString(string(runes[i:j])) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(string(runes[i:j])))
+		}
+		i = j
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
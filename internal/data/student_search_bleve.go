@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"student/internal/biz"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 重建索引时每批写入的学生数量
+const bleveBatchSize = 500
+
+// 索引中的学生文档
+type studentDocument struct {
+	Name string `json:"name"`
+	Info string `json:"info"`
+}
+
+// 使用内嵌的 Bleve 索引搜索，适合本地开发，不依赖 MySQL 的 FULLTEXT 索引
+type bleveSearchIndex struct {
+	index bleve.Index
+	log   *log.Helper
+}
+
+// 打开 path 下的索引，索引不存在或 path 为空（只保存在内存中）时新建并从数据库导入所有学生
+func newBleveSearchIndex(path string, data *Data, logger log.Logger) (*bleveSearchIndex, error) {
+	var index bleve.Index
+	var err error
+	created := true
+	if path == "" {
+		index, err = bleve.NewMemOnly(studentIndexMapping())
+	} else if index, err = bleve.Open(path); err == nil {
+		created = false
+	} else if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.New(path, studentIndexMapping())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := &bleveSearchIndex{index: index, log: log.NewHelper(logger)}
+	if created {
+		if err := r.rebuild(context.Background(), data); err != nil {
+			index.Close()
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// 中文按单字切分，配合短语查询匹配连续的字，英文按单词切分并转为小写
+func studentIndexMapping() mapping.IndexMapping {
+	m := bleve.NewIndexMapping()
+	_ = m.AddCustomAnalyzer("student_text", map[string]any{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{cjk.WidthName, lowercase.Name},
+	})
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = "student_text"
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("name", text)
+	doc.AddFieldMappingsAt("info", text)
+	m.DefaultMapping = doc
+	return m
+}
+
+// 从数据库逐行读取所有学生写入索引
+func (r *bleveSearchIndex) rebuild(ctx context.Context, data *Data) error {
+	rows, err := data.gormDB.WithContext(ctx).Model(&biz.Student{}).Select("id", "name", "info").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var batch []*biz.Student
+	count := 0
+	for rows.Next() {
+		var stu biz.Student
+		if err := data.gormDB.ScanRows(rows, &stu); err != nil {
+			return err
+		}
+		batch = append(batch, &stu)
+		if len(batch) == bleveBatchSize {
+			if err := r.IndexStudents(ctx, batch); err != nil {
+				return err
+			}
+			count += len(batch)
+			batch = batch[:0]
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := r.IndexStudents(ctx, batch); err != nil {
+		return err
+	}
+	r.log.Infof("bleve: rebuilt student index, count: %d", count+len(batch))
+	return nil
+}
+
+func (r *bleveSearchIndex) close() {
+	if err := r.index.Close(); err != nil {
+		r.log.Errorf("bleve: close index failed: %v", err)
+	}
+}
+
+// 实现 写入或覆盖学生文档
+func (r *bleveSearchIndex) IndexStudents(ctx context.Context, students []*biz.Student) error {
+	if len(students) == 0 {
+		return nil
+	}
+	batch := r.index.NewBatch()
+	for _, s := range students {
+		if err := batch.Index(strconv.FormatUint(uint64(s.ID), 10), studentDocument{Name: s.Name, Info: s.Info}); err != nil {
+			return err
+		}
+	}
+	return r.index.Batch(batch)
+}
+
+// 实现 从索引中删除学生文档
+func (r *bleveSearchIndex) DeleteStudent(ctx context.Context, id uint) error {
+	return r.index.Delete(strconv.FormatUint(uint64(id), 10))
+}
+
+// 实现 姓名的权重高于备注，连续匹配的权重高于分散匹配
+func (r *bleveSearchIndex) SearchStudents(ctx context.Context, q *biz.SearchQuery) (*biz.SearchResult, error) {
+	var queries []query.Query
+	for field, boost := range map[string]float64{"name": 2, "info": 1} {
+		phrase := bleve.NewMatchPhraseQuery(q.Query)
+		phrase.SetField(field)
+		phrase.SetBoost(boost * 2)
+		match := bleve.NewMatchQuery(q.Query)
+		match.SetField(field)
+		match.SetOperator(query.MatchQueryOperatorAnd)
+		match.SetBoost(boost)
+		queries = append(queries, phrase, match)
+	}
+
+	req := bleve.NewSearchRequestOptions(bleve.NewDisjunctionQuery(queries...), q.Limit, q.Offset, false)
+	req.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	req.Highlight.Fields = []string{"name", "info"}
+	res, err := r.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &biz.SearchResult{Total: int(res.Total)}
+	for _, hit := range res.Hits {
+		id, err := strconv.ParseUint(hit.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		result.Hits = append(result.Hits, &biz.SearchHit{ID: uint(id), Score: hit.Score, Highlights: markedFragments(hit.Fragments)})
+	}
+	return result, nil
+}
+
+// 只保留有匹配的片段，中文按单字匹配，相邻的标记合并为一个
+func markedFragments(fragments map[string][]string) map[string][]string {
+	highlights := make(map[string][]string)
+	for field, list := range fragments {
+		for _, fragment := range list {
+			if strings.Contains(fragment, "<mark>") {
+				highlights[field] = append(highlights[field], strings.ReplaceAll(fragment, "</mark><mark>", ""))
+			}
+		}
+	}
+	return highlights
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type academicTermRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAcademicTermRepo(data *Data, logger log.Logger) biz.AcademicTermRepo {
+	return &academicTermRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *academicTermRepo) first(ctx context.Context, query any, args ...any) (*biz.AcademicTerm, error) {
+	var term biz.AcademicTerm
+	err := r.data.gormDB.WithContext(ctx).Where(query, args...).First(&term).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	term.FormatTimeFields()
+	return &term, nil
+}
+
+// 实现 从 gormDB 中获取学期
+func (r *academicTermRepo) GetTerm(ctx context.Context, id uint) (*biz.AcademicTerm, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// 实现 通过学期编号获取学期
+func (r *academicTermRepo) GetTermByCode(ctx context.Context, code string) (*biz.AcademicTerm, error) {
+	return r.first(ctx, "code = ?", code)
+}
+
+// 实现 获取包含指定日期的学期
+func (r *academicTermRepo) GetTermByDate(ctx context.Context, date time.Time) (*biz.AcademicTerm, error) {
+	day := date.Format(biz.DateFormat)
+	return r.first(ctx, "start_date <= ? AND end_date >= ?", day, day)
+}
+
+// 实现 从 gormDB 中创建学期
+func (r *academicTermRepo) CreateTerm(ctx context.Context, t *biz.AcademicTermForm) (*biz.AcademicTerm, error) {
+	term := biz.AcademicTerm{
+		Code:      t.Code,
+		Name:      t.Name,
+		StartDate: t.StartDate,
+		EndDate:   t.EndDate,
+	}
+	if err := r.data.gormDB.WithContext(ctx).Create(&term).Error; err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: CreateTerm, term: %v", term)
+	term.FormatTimeFields()
+	return &term, nil
+}
+
+// 实现 从 gormDB 中更新学期
+func (r *academicTermRepo) UpdateTerm(ctx context.Context, id uint, t *biz.AcademicTermForm) (*biz.AcademicTerm, error) {
+	var term biz.AcademicTerm
+	err := r.data.gormDB.WithContext(ctx).First(&term, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Error404()
+		}
+		return nil, errors.Error400(err)
+	}
+	term.Code = t.Code
+	term.Name = t.Name
+	term.StartDate = t.StartDate
+	term.EndDate = t.EndDate
+	if err := r.data.gormDB.WithContext(ctx).Save(&term).Error; err != nil {
+		return nil, errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateTerm, id: %d, term: %v", id, term)
+	term.FormatTimeFields()
+	return &term, nil
+}
+
+// 实现 从 gormDB 中获取学期列表
+func (r *academicTermRepo) ListTerms(ctx context.Context, page, pageSize int32) ([]*biz.AcademicTerm, int32, error) {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.AcademicTerm{})
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	var terms []*biz.AcademicTerm
+	err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("start_date desc").Find(&terms).Error
+	if err != nil {
+		return nil, 0, errors.Error400(err)
+	}
+	for _, t := range terms {
+		t.FormatTimeFields()
+	}
+	return terms, int32(total), nil
+}
+
+// 实现 统计与日期范围重叠的学期数量
+func (r *academicTermRepo) CountOverlappingTerms(ctx context.Context, start, end time.Time, excludeID uint) (int64, error) {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.AcademicTerm{}).
+		Where("start_date <= ? AND end_date >= ?", end.Format(biz.DateFormat), start.Format(biz.DateFormat))
+	if excludeID != 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, errors.Error400(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,219 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"student/internal/biz"
+
+	errors "student/internal/data/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	refreshTokenKeyPrefix  = "refresh_token:"
+	refreshFamilyKeyPrefix = "refresh_family:"
+	userFamiliesKeyPrefix  = "user_families:"
+	revokedTokenKeyPrefix  = "revoked_token:"
+	revokedUserKeyPrefix   = "revoked_user:"
+)
+
+// 原子地标记刷新令牌为已使用，返回 user_id、family_id 以及使用次数
+var useRefreshTokenScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local used = redis.call('HINCRBY', KEYS[1], 'used', 1)
+return {redis.call('HGET', KEYS[1], 'user_id'), redis.call('HGET', KEYS[1], 'family_id'), used, redis.call('HGET', KEYS[1], 'client_id') or ''}
+`)
+
+type tokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTokenRepo(data *Data, logger log.Logger) biz.TokenRepo {
+	return &tokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 实现 在 redis 中创建令牌族
+func (r *tokenRepo) CreateTokenFamily(ctx context.Context, familyID string, userID uint, ttl time.Duration) error {
+	userKey := userFamiliesKey(userID)
+	pipe := r.data.redis.TxPipeline()
+	pipe.Set(ctx, refreshFamilyKeyPrefix+familyID, userID, ttl)
+	pipe.SAdd(ctx, userKey, familyID)
+	pipe.Expire(ctx, userKey, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 检查令牌族是否有效
+func (r *tokenRepo) IsTokenFamilyActive(ctx context.Context, familyID string) (bool, error) {
+	n, err := r.data.redis.Exists(ctx, refreshFamilyKeyPrefix+familyID).Result()
+	if err != nil {
+		return false, errors.Error400(err)
+	}
+	return n > 0, nil
+}
+
+// 实现 撤销令牌族
+func (r *tokenRepo) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	err := r.data.redis.Del(ctx, refreshFamilyKeyPrefix+familyID).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: RevokeTokenFamily, family: ", familyID)
+	return nil
+}
+
+// 实现 保存刷新令牌，同时延长令牌族的有效期
+func (r *tokenRepo) SaveRefreshToken(ctx context.Context, tokenHash string, record *biz.RefreshTokenRecord, ttl time.Duration) error {
+	key := refreshTokenKeyPrefix + tokenHash
+	pipe := r.data.redis.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"user_id":   record.UserID,
+		"family_id": record.FamilyID,
+		"client_id": record.ClientID,
+		"used":      0,
+	})
+	pipe.Expire(ctx, key, ttl)
+	pipe.Expire(ctx, refreshFamilyKeyPrefix+record.FamilyID, ttl)
+	pipe.Expire(ctx, userFamiliesKey(record.UserID), ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
+}
+
+// 实现 使用刷新令牌
+func (r *tokenRepo) UseRefreshToken(ctx context.Context, tokenHash string) (*biz.RefreshTokenRecord, bool, error) {
+	res, err := useRefreshTokenScript.Run(ctx, r.data.redis, []string{refreshTokenKeyPrefix + tokenHash}).Slice()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, errors.Error404()
+		}
+		return nil, false, errors.Error400(err)
+	}
+	if len(res) != 4 {
+		return nil, false, errors.Error400(fmt.Errorf("unexpected refresh token record: %v", res))
+	}
+
+	userID, err := strconv.ParseUint(fmt.Sprint(res[0]), 10, 64)
+	if err != nil {
+		return nil, false, errors.Error400(err)
+	}
+	used, _ := res[2].(int64)
+
+	return &biz.RefreshTokenRecord{
+		UserID:   uint(userID),
+		FamilyID: fmt.Sprint(res[1]),
+		ClientID: fmt.Sprint(res[3]),
+	}, used > 1, nil
+}
+
+// 实现 将访问令牌加入黑名单
+func (r *tokenRepo) RevokeAccessToken(ctx context.Context, tokenID string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	err := r.data.redis.Set(ctx, revokedTokenKeyPrefix+tokenID, 1, ttl).Err()
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: RevokeAccessToken, jti: ", tokenID)
+	return nil
+}
+
+// 实现 撤销用户的全部令牌
+func (r *tokenRepo) RevokeUserTokens(ctx context.Context, userID uint, ttl time.Duration) error {
+	userKey := userFamiliesKey(userID)
+	families, err := r.data.redis.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return errors.Error400(err)
+	}
+
+	keys := make([]string, 0, len(families)+1)
+	for _, familyID := range families {
+		keys = append(keys, refreshFamilyKeyPrefix+familyID)
+	}
+	keys = append(keys, userKey)
+
+	// 记录撤销时间（毫秒），此前签发的访问令牌全部失效
+	pipe := r.data.redis.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.Set(ctx, revokedUserKeyPrefix+strconv.FormatUint(uint64(userID), 10), time.Now().UnixMilli(), ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: RevokeUserTokens, user: ", userID)
+	return nil
+}
+
+// 实现 撤销用户除当前令牌族以外的全部令牌族
+func (r *tokenRepo) RevokeOtherTokenFamilies(ctx context.Context, userID uint, keepFamilyID string) error {
+	userKey := userFamiliesKey(userID)
+	families, err := r.data.redis.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return errors.Error400(err)
+	}
+
+	var keys []string
+	var members []any
+	for _, familyID := range families {
+		if familyID == keepFamilyID {
+			continue
+		}
+		keys = append(keys, refreshFamilyKeyPrefix+familyID)
+		members = append(members, familyID)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	pipe := r.data.redis.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.SRem(ctx, userKey, members...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("redis: RevokeOtherTokenFamilies, user: ", userID, ", revoked: ", len(keys))
+	return nil
+}
+
+// 实现 检查访问令牌是否已被撤销
+func (r *tokenRepo) IsAccessTokenRevoked(ctx context.Context, tokenID, sessionID string, userID uint, issuedAt time.Time) (bool, error) {
+	pipe := r.data.redis.Pipeline()
+	tokenCmd := pipe.Exists(ctx, revokedTokenKeyPrefix+tokenID)
+	userCmd := pipe.Get(ctx, revokedUserKeyPrefix+strconv.FormatUint(uint64(userID), 10))
+	var familyCmd *redis.IntCmd
+	if sessionID != "" {
+		familyCmd = pipe.Exists(ctx, refreshFamilyKeyPrefix+sessionID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return false, errors.Error400(err)
+	}
+
+	if tokenCmd.Val() > 0 {
+		return true, nil
+	}
+	if familyCmd != nil && familyCmd.Val() == 0 {
+		return true, nil
+	}
+	if revokedAt, err := userCmd.Int64(); err == nil && issuedAt.UnixMilli() < revokedAt {
+		return true, nil
+	}
+	return false, nil
+}
+
+func userFamiliesKey(userID uint) string {
+	return userFamiliesKeyPrefix + strconv.FormatUint(uint64(userID), 10)
+}
@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"student/internal/biz"
 
@@ -39,18 +41,23 @@ func (r *userRepo) GetUser(ctx context.Context, id int32) (*biz.User, error) {
 	user.FormatTimeFields()
 
 	return &biz.User{
-		ID:           user.ID,
-		Username:     user.Username,
-		Email:        user.Email,
-		Phone:        user.Phone,
-		Password:     user.Password,
-		Status:       user.Status,
-		Age:          user.Age,
-		Avatar:       user.Avatar,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
-		CreatedAtStr: user.CreatedAtStr,
-		UpdatedAtStr: user.UpdatedAtStr,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Phone:            user.Phone,
+		Password:         user.Password,
+		Status:           user.Status,
+		Age:              user.Age,
+		Avatar:           user.Avatar,
+		MFAEnabled:       user.MFAEnabled,
+		MFASecret:        user.MFASecret,
+		MFARecoveryCodes: user.MFARecoveryCodes,
+		EmailVerifiedAt:  user.EmailVerifiedAt,
+		Version:          user.Version,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
+		CreatedAtStr:     user.CreatedAtStr,
+		UpdatedAtStr:     user.UpdatedAtStr,
 	}, err
 }
 
@@ -81,39 +88,52 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.UserForm) (*biz.Create
 }
 
 // 实现 从 gormDB 中更新用户信息
-func (r *userRepo) UpdateUser(ctx context.Context, id int32, u *biz.UserForm) (*biz.UpdateUserMessage, error) {
+func (r *userRepo) UpdateUser(ctx context.Context, id int32, u *biz.UserForm, columns []string) (*biz.UpdateUserMessage, error) {
 	var user biz.User
 	err := r.data.gormDB.WithContext(ctx).First(&user, id).Error
 	if err != nil {
 		return nil, errors.Error404()
 	}
+	if user.Version != u.Version {
+		return nil, biz.ErrorVersionConflict()
+	}
 
-	user.Username = u.Username
-	user.Email = u.Email
-	user.Phone = u.Phone
-	if u.Password != "" {
+	if slices.Contains(columns, "password") {
 		// 如果提供了新密码，则加密
 		if err := u.HashPassword(); err != nil {
 			return nil, errors.Error400(err)
 		}
-		user.Password = u.Password
 	}
-	user.Status = u.Status
-	user.Age = u.Age
-	user.Avatar = u.Avatar
+	updates := &biz.User{
+		Username: u.Username,
+		Email:    u.Email,
+		Phone:    u.Phone,
+		Password: u.Password,
+		Status:   u.Status,
+		Age:      u.Age,
+		Avatar:   u.Avatar,
+		Version:  u.Version + 1,
+	}
+	if slices.Contains(columns, "email") && user.Email != u.Email {
+		// 邮箱变更后需要重新验证
+		columns = append(slices.Clone(columns), "email_verified_at")
+	}
 
-	err = r.data.gormDB.WithContext(ctx).Save(&user).Error
-	if err != nil {
-		return nil, errors.Error400(err)
+	// 只更新指定的列，零值也会写入
+	result := r.data.gormDB.WithContext(ctx).Model(&user).Where("version = ?", u.Version).
+		Select(withVersion(columns)).Updates(updates)
+	if err := versionResult(result); err != nil {
+		return nil, err
 	}
 	r.log.WithContext(ctx).Info("gormDB: UpdateUser, id: %d, user: %v", id, user)
 	return &biz.UpdateUserMessage{
 		Message: "Update user success",
-	}, err
+		Version: u.Version + 1,
+	}, nil
 }
 
 // 实现 从 gormDB 中删除用户
-func (r *userRepo) DeleteUser(ctx context.Context, id int32) (*biz.DeleteUserMessage, error) {
+func (r *userRepo) DeleteUser(ctx context.Context, id int32, version uint) (*biz.DeleteUserMessage, error) {
 	var user biz.User
 	err := r.data.gormDB.WithContext(ctx).First(&user, id).Error
 	if err != nil {
@@ -122,52 +142,62 @@ func (r *userRepo) DeleteUser(ctx context.Context, id int32) (*biz.DeleteUserMes
 		}
 		return nil, errors.Error400(err)
 	}
-	err = r.data.gormDB.Delete(&user, id).Error
+	if user.Version != version {
+		return nil, biz.ErrorVersionConflict()
+	}
+	if err := versionResult(r.data.gormDB.WithContext(ctx).Where("version = ?", version).Delete(&user)); err != nil {
+		return nil, err
+	}
 	r.log.WithContext(ctx).Info("gormDB: DeleteUser, id: %d", id)
 	return &biz.DeleteUserMessage{
 		Message: "Delete user success",
-	}, err
+	}, nil
 }
 
 // 实现 从 gormDB 中获取用户列表
-func (r *userRepo) ListUsers(ctx context.Context, page int32, pageSize int32, username, email string) ([]*biz.User, int32, error) {
-	var users []*biz.User
-	var total int64
-	var err error
-
-	// 构建查询条件
-	query := r.data.gormDB.WithContext(ctx).Model(&biz.User{})
-	if username != "" {
-		query = query.Where("username LIKE ?", "%"+username+"%")
-	}
-	if email != "" {
-		query = query.Where("email LIKE ?", "%"+email+"%")
-	}
-
-	// 获取总数
-	err = query.Count(&total).Error
+func (r *userRepo) ListUsers(ctx context.Context, q *biz.ListQuery) ([]*biz.User, string, int32, error) {
+	users, next, total, err := listPage[biz.User](ctx, r.data.gormDB, q)
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return nil, "", 0, err
 	}
 
-	// 获取分页数据
-	query = r.data.gormDB.WithContext(ctx).Model(&biz.User{})
+	// 为每个用户记录格式化时间字段
+	biz.FormatUserTimeFieldsBatch(users)
+
+	return users, next, total, nil
+}
+
+// 实现 使用游标逐行读取用户，过滤条件与 ListUsers 相同
+func (r *userRepo) ExportUsers(ctx context.Context, username, email string, fn func(*biz.User) error) error {
+	query := r.data.gormDB.WithContext(ctx).Model(&biz.User{}).
+		Select("id", "username", "email", "phone", "status", "age", "created_at", "updated_at", "email_verified_at")
+	// 与列表使用相同的转义，查询参数中的 % 和 _ 不作为通配符
+	var filters []biz.Filter
 	if username != "" {
-		query = query.Where("username LIKE ?", "%"+username+"%")
+		filters = append(filters, biz.Filter{Column: "username", Op: biz.OpContains, Values: []any{username}})
 	}
 	if email != "" {
-		query = query.Where("email LIKE ?", "%"+email+"%")
+		filters = append(filters, biz.Filter{Column: "email", Op: biz.OpContains, Values: []any{email}})
 	}
-
-	err = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id desc").Find(&users).Error
+	rows, err := applyFilters(query, filters).Order("id desc").Rows()
 	if err != nil {
-		return nil, 0, errors.Error400(err)
+		return errors.Error400(err)
 	}
-
-	// 为每个用户记录格式化时间字段
-	biz.FormatUserTimeFieldsBatch(users)
-
-	return users, int32(total), err
+	defer rows.Close()
+	for rows.Next() {
+		var user biz.User
+		if err := r.data.gormDB.ScanRows(rows, &user); err != nil {
+			return errors.Error400(err)
+		}
+		user.FormatTimeFields()
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Error400(err)
+	}
+	return nil
 }
 
 // 实现 通过用户名获取用户信息
@@ -186,18 +216,23 @@ func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*biz
 	user.FormatTimeFields()
 
 	return &biz.User{
-		ID:           user.ID,
-		Username:     user.Username,
-		Email:        user.Email,
-		Phone:        user.Phone,
-		Password:     user.Password,
-		Status:       user.Status,
-		Age:          user.Age,
-		Avatar:       user.Avatar,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
-		CreatedAtStr: user.CreatedAtStr,
-		UpdatedAtStr: user.UpdatedAtStr,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Phone:            user.Phone,
+		Password:         user.Password,
+		Status:           user.Status,
+		Age:              user.Age,
+		Avatar:           user.Avatar,
+		MFAEnabled:       user.MFAEnabled,
+		MFASecret:        user.MFASecret,
+		MFARecoveryCodes: user.MFARecoveryCodes,
+		EmailVerifiedAt:  user.EmailVerifiedAt,
+		Version:          user.Version,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
+		CreatedAtStr:     user.CreatedAtStr,
+		UpdatedAtStr:     user.UpdatedAtStr,
 	}, err
 }
 
@@ -217,18 +252,23 @@ func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User,
 	user.FormatTimeFields()
 
 	return &biz.User{
-		ID:           user.ID,
-		Username:     user.Username,
-		Email:        user.Email,
-		Phone:        user.Phone,
-		Password:     user.Password,
-		Status:       user.Status,
-		Age:          user.Age,
-		Avatar:       user.Avatar,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
-		CreatedAtStr: user.CreatedAtStr,
-		UpdatedAtStr: user.UpdatedAtStr,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Phone:            user.Phone,
+		Password:         user.Password,
+		Status:           user.Status,
+		Age:              user.Age,
+		Avatar:           user.Avatar,
+		MFAEnabled:       user.MFAEnabled,
+		MFASecret:        user.MFASecret,
+		MFARecoveryCodes: user.MFARecoveryCodes,
+		EmailVerifiedAt:  user.EmailVerifiedAt,
+		Version:          user.Version,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
+		CreatedAtStr:     user.CreatedAtStr,
+		UpdatedAtStr:     user.UpdatedAtStr,
 	}, err
 }
 
@@ -263,3 +303,69 @@ func (r *userRepo) RegisterUser(ctx context.Context, u *biz.RegisterForm) (*biz.
 		Success: true,
 	}, err
 }
+
+// 实现 更新用户两步验证设置
+func (r *userRepo) UpdateMFA(ctx context.Context, id uint, settings *biz.MFASettings) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.User{}).Where("id = ?", id).Updates(map[string]any{
+		"mfa_enabled":        settings.Enabled,
+		"mfa_secret":         settings.Secret,
+		"mfa_recovery_codes": settings.RecoveryCodes,
+	}).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateMFA, id: %d, enabled: %v", id, settings.Enabled)
+	return nil
+}
+
+// 实现 更新用户密码，传入的密码必须已加密
+func (r *userRepo) UpdatePassword(ctx context.Context, id uint, hashedPassword string) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.User{}).Where("id = ?", id).Update("password", hashedPassword).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdatePassword, id: %d", id)
+	return nil
+}
+
+// 实现 标记用户邮箱已验证
+func (r *userRepo) MarkEmailVerified(ctx context.Context, id uint, verifiedAt time.Time) error {
+	err := r.data.gormDB.WithContext(ctx).Model(&biz.User{}).Where("id = ?", id).Update("email_verified_at", verifiedAt).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: MarkEmailVerified, id: %d", id)
+	return nil
+}
+
+// 实现 更新用户资料，只写入 columns 中的列，邮箱变更时清除验证状态
+func (r *userRepo) UpdateProfile(ctx context.Context, id uint, p *biz.ProfileForm, columns []string) error {
+	var user biz.User
+	err := r.data.gormDB.WithContext(ctx).First(&user, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.Error404()
+		}
+		return errors.Error400(err)
+	}
+
+	values := map[string]any{
+		"email":  p.Email,
+		"phone":  p.Phone,
+		"avatar": p.Avatar,
+		"age":    p.Age,
+	}
+	updates := map[string]any{"version": gorm.Expr("version + 1")}
+	for _, column := range columns {
+		updates[column] = values[column]
+	}
+	if _, ok := updates["email"]; ok && user.Email != p.Email {
+		updates["email_verified_at"] = nil
+	}
+	err = r.data.gormDB.WithContext(ctx).Model(&biz.User{}).Where("id = ?", id).Updates(updates).Error
+	if err != nil {
+		return errors.Error400(err)
+	}
+	r.log.WithContext(ctx).Info("gormDB: UpdateProfile, id: %d", id)
+	return nil
+}
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"student/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestUserRepo_UpdateProfile(t *testing.T) {
+	ctx := context.Background()
+	d := newTestData(t, &biz.User{})
+	repo := NewUserRepo(d, log.DefaultLogger)
+
+	if _, err := repo.CreateUser(ctx, &biz.UserForm{Username: "zhangsan", Email: "zhangsan@example.com", Phone: "13800138000", Password: "Passw0rd!", Age: 18, Status: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.MarkEmailVerified(ctx, 1, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 只写入列出的列，其他列保持不变
+	if err := repo.UpdateProfile(ctx, 1, &biz.ProfileForm{Avatar: "a.png"}, []string{"avatar"}); err != nil {
+		t.Fatalf("UpdateProfile() error = %v", err)
+	}
+	user, err := repo.GetUser(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Avatar != "a.png" || user.Email != "zhangsan@example.com" || user.Phone != "13800138000" || user.Age != 18 {
+		t.Errorf("GetUser() = %+v", user)
+	}
+	if user.EmailVerifiedAt == nil {
+		t.Error("没有更新邮箱时应保留验证状态")
+	}
+	if user.Version != 2 {
+		t.Errorf("version = %d, want 2", user.Version)
+	}
+
+	if err := repo.UpdateProfile(ctx, 1, &biz.ProfileForm{Email: "lisi@example.com"}, []string{"email"}); err != nil {
+		t.Fatalf("UpdateProfile() error = %v", err)
+	}
+	if user, err = repo.GetUser(ctx, 1); err != nil || user.Email != "lisi@example.com" || user.EmailVerifiedAt != nil || user.Age != 18 {
+		t.Errorf("GetUser() = %+v, %v", user, err)
+	}
+}
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"slices"
+
+	"student/internal/biz"
+	"student/internal/data/errors"
+
+	"gorm.io/gorm"
+)
+
+// 需要更新的列加上版本号
+func withVersion(columns []string) []string {
+	return append(slices.Clone(columns), "version")
+}
+
+// 检查按版本号条件更新或删除的结果，没有影响任何行说明数据已被其他请求修改
+func versionResult(result *gorm.DB) error {
+	if result.Error != nil {
+		return errors.Error400(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrorVersionConflict()
+	}
+	return nil
+}
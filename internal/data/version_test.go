@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"student/internal/biz"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 使用内存中的 sqlite 创建数据表，只用于测试仓储层的读写
+func newTestData(t *testing.T, models ...any) *Data {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 内存数据库只在同一个连接中可见
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatal(err)
+	}
+	return &Data{gormDB: db}
+}
+
+func TestStudentRepo_Version(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStudentRepo(newTestData(t, &biz.Student{}), log.DefaultLogger)
+
+	created, err := repo.CreateStudent(ctx, &biz.StudentForm{Name: "张三", Status: 1, Age: 18})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stu, err := repo.GetStudent(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stu.Version != 1 {
+		t.Fatalf("GetStudent() version = %d, want 1", stu.Version)
+	}
+
+	// 使用读取到的版本号更新
+	updated, err := repo.UpdateStudent(ctx, created.ID, &biz.StudentForm{Name: "李四", Version: stu.Version}, []string{"name"})
+	if err != nil {
+		t.Fatalf("UpdateStudent() error = %v", err)
+	}
+	stu, err = repo.GetStudent(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stu.Version != updated.Version || stu.Version != 2 || stu.Name != "李四" {
+		t.Errorf("GetStudent() = %+v, want version 2", stu)
+	}
+
+	if _, err := repo.UpdateStudent(ctx, created.ID, &biz.StudentForm{Name: "王五", Version: 1}, []string{"name"}); err == nil {
+		t.Error("旧版本号更新应失败")
+	}
+}
+
+func TestUserRepo_Version(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepo(newTestData(t, &biz.User{}), log.DefaultLogger)
+
+	if _, err := repo.CreateUser(ctx, &biz.UserForm{Username: "zhangsan", Email: "zhangsan@example.com", Password: "Passw0rd!", Status: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	getters := []struct {
+		name string
+		get  func() (*biz.User, error)
+	}{
+		{name: "GetUser", get: func() (*biz.User, error) { return repo.GetUser(ctx, 1) }},
+		{name: "GetUserByUsername", get: func() (*biz.User, error) { return repo.GetUserByUsername(ctx, "zhangsan") }},
+		{name: "GetUserByEmail", get: func() (*biz.User, error) { return repo.GetUserByEmail(ctx, "zhangsan@example.com") }},
+	}
+	for _, tt := range getters {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := tt.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.Version != 1 {
+				t.Errorf("version = %d, want 1", user.Version)
+			}
+		})
+	}
+
+	user, err := repo.GetUser(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.UpdateUser(ctx, 1, &biz.UserForm{Age: 20, Version: user.Version}, []string{"age"}); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	if user, err = repo.GetUser(ctx, 1); err != nil || user.Version != 2 || user.Age != 20 {
+		t.Errorf("GetUser() = %+v, %v, want version 2", user, err)
+	}
+}
@@ -0,0 +1,27 @@
+package jwt
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OpenID Connect ID Token 的 Claims
+type IDTokenClaims struct {
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time,omitempty"`
+	// profile 范围
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	// email 范围
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// 签发 ID Token，与访问令牌使用同一套签名密钥
+func (j *JWTUtil) GenerateIDToken(claims *IDTokenClaims) (string, error) {
+	return j.keys.Sign(claims)
+}
+
+// 签名算法
+func (j *JWTUtil) Algorithm() string {
+	return j.keys.Algorithm()
+}
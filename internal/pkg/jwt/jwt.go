@@ -1,16 +1,36 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// iat 等时间精确到毫秒，撤销用户全部令牌后同一秒内重新登录签发的令牌仍然有效
+func init() {
+	jwt.TimePrecision = time.Millisecond
+}
+
+// 没有配置刷新令牌有效期时默认 7 天
+const DefaultRefreshExpire = 7 * 24 * time.Hour
+
 // JWT配置
 type Config struct {
-	SecretKey string        `json:"secret_key"`
-	Expire    time.Duration `json:"expire"`
+	SecretKey     string        `json:"secret_key"`
+	Expire        time.Duration `json:"expire"`
+	RefreshExpire time.Duration `json:"refresh_expire"`
+	// 签名算法：HS256（默认）、RS256、EdDSA
+	Algorithm string `json:"algorithm"`
+	// 当前用于签名的密钥 kid
+	ActiveKid string      `json:"active_kid"`
+	Keys      []KeyConfig `json:"keys"`
+	// 远程 JWKS 地址，只验证不签名的服务使用
+	JWKSURL string `json:"jwks_url"`
 }
 
 // 自定义Claims
@@ -18,30 +38,89 @@ type Claims struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
+	// 会话标识，对应登录时创建的令牌族
+	SessionID string `json:"sid,omitempty"`
+	// 模拟登录时的实际操作人 (RFC 8693 act)，UserID 为被模拟的用户
+	Actor *Actor `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// 模拟登录的操作人
+type Actor struct {
+	UserID   uint   `json:"user_id"`
+	Username string `json:"sub"`
+}
+
+// 是否为模拟登录的Token
+func (c *Claims) Impersonated() bool {
+	return c.Actor != nil
+}
+
+// 访问Token的剩余有效期
+func (c *Claims) RemainingLifetime() time.Duration {
+	if c.ExpiresAt == nil {
+		return 0
+	}
+	return time.Until(c.ExpiresAt.Time)
+}
+
 // JWT工具
 type JWTUtil struct {
 	config *Config
+	keys   *KeySet
 }
 
 // 创建JWT工具实例
-func NewJWTUtil(config *Config) *JWTUtil {
-	return &JWTUtil{
-		config: config,
+func NewJWTUtil(config *Config) (*JWTUtil, error) {
+	keys, err := NewKeySet(config)
+	if err != nil {
+		return nil, err
+	}
+	// 有效期为 0 时刷新令牌写入 redis 后立即过期
+	c := *config
+	if c.RefreshExpire <= 0 {
+		c.RefreshExpire = DefaultRefreshExpire
 	}
+	return &JWTUtil{
+		config: &c,
+		keys:   keys,
+	}, nil
 }
 
 // 生成JWT Token
 func (j *JWTUtil) GenerateToken(userID uint, username, email string) (string, error) {
+	return j.GenerateSessionToken(userID, username, email, "")
+}
+
+// 生成绑定会话的JWT Token，每个Token带有唯一的 jti 用于撤销
+func (j *JWTUtil) GenerateSessionToken(userID uint, username, email, sessionID string) (string, error) {
+	return j.generateToken(userID, username, email, sessionID, nil, j.config.Expire)
+}
+
+// 生成模拟登录的JWT Token，以被模拟用户的身份访问，act 中记录实际操作人
+func (j *JWTUtil) GenerateImpersonationToken(userID uint, username, email, sessionID string, actor *Actor, expire time.Duration) (string, error) {
+	if actor == nil {
+		return "", errors.New("actor is required")
+	}
+	return j.generateToken(userID, username, email, sessionID, actor, expire)
+}
+
+func (j *JWTUtil) generateToken(userID uint, username, email, sessionID string, actor *Actor, expire time.Duration) (string, error) {
+	tokenID, err := NewTokenID()
+	if err != nil {
+		return "", err
+	}
+
 	// 创建Claims
 	claims := Claims{
-		UserID:   userID,
-		Username: username,
-		Email:    email,
+		UserID:    userID,
+		Username:  username,
+		Email:     email,
+		SessionID: sessionID,
+		Actor:     actor,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.config.Expire)),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expire)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 			Issuer:    "student-system",
@@ -49,28 +128,14 @@ func (j *JWTUtil) GenerateToken(userID uint, username, email string) (string, er
 		},
 	}
 
-	// 创建Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
 	// 签名Token
-	tokenString, err := token.SignedString([]byte(j.config.SecretKey))
-	if err != nil {
-		return "", err
-	}
-
-	return tokenString, nil
+	return j.keys.Sign(claims)
 }
 
 // 验证JWT Token
 func (j *JWTUtil) ValidateToken(tokenString string) (*Claims, error) {
 	// 解析Token
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
-		// 验证签名方法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(j.config.SecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keys.Keyfunc)
 
 	if err != nil {
 		return nil, err
@@ -83,22 +148,48 @@ func (j *JWTUtil) ValidateToken(tokenString string) (*Claims, error) {
 
 	// 获取Claims
 	if claims, ok := token.Claims.(*Claims); ok {
+		// 带有 aud 的是签发给 OIDC 客户端的 ID Token，不能作为访问令牌使用
+		if len(claims.Audience) > 0 {
+			return nil, errors.New("invalid token audience")
+		}
 		return claims, nil
 	}
 
 	return nil, errors.New("invalid claims")
 }
 
-// 刷新Token
-func (j *JWTUtil) RefreshToken(tokenString string) (string, error) {
-	// 验证当前Token
-	claims, err := j.ValidateToken(tokenString)
-	if err != nil {
+// 访问Token有效期
+func (j *JWTUtil) AccessExpire() time.Duration {
+	return j.config.Expire
+}
+
+// 刷新Token有效期
+func (j *JWTUtil) RefreshExpire() time.Duration {
+	return j.config.RefreshExpire
+}
+
+// 生成不透明的刷新Token
+func GenerateRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
 		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// 生成随机的Token标识，用于 jti、令牌族等场景
+func NewTokenID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
 
-	// 生成新的Token
-	return j.GenerateToken(claims.UserID, claims.Username, claims.Email)
+// 计算刷新Token的哈希值，服务端只保存哈希
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 // 从Token中获取用户ID
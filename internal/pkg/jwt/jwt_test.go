@@ -0,0 +1,122 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestGenerateRefreshToken(t *testing.T) {
+	token1, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken failed: %v", err)
+	}
+	token2, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken failed: %v", err)
+	}
+
+	// 验证刷新令牌随机生成
+	if token1 == token2 {
+		t.Error("Refresh tokens should be unique")
+	}
+
+	// 验证哈希稳定且不等于原令牌
+	if HashRefreshToken(token1) != HashRefreshToken(token1) {
+		t.Error("HashRefreshToken should be deterministic")
+	}
+	if HashRefreshToken(token1) == token1 {
+		t.Error("Hashed refresh token should not be equal to original token")
+	}
+	if HashRefreshToken(token1) == HashRefreshToken(token2) {
+		t.Error("Different refresh tokens should have different hashes")
+	}
+}
+
+func TestGenerateToken(t *testing.T) {
+	util, _ := NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute})
+
+	token, err := util.GenerateToken(1, "testuser", "test@example.com")
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+
+	claims, err := util.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+	if claims.UserID != 1 || claims.Username != "testuser" {
+		t.Errorf("claims = %+v, want user 1 testuser", claims)
+	}
+
+	// 验证错误密钥无法通过校验
+	other, _ := NewJWTUtil(&Config{SecretKey: "other-secret", Expire: 15 * time.Minute})
+	if _, err := other.ValidateToken(token); err == nil {
+		t.Error("ValidateToken should fail with wrong secret")
+	}
+}
+
+func TestGenerateSessionToken(t *testing.T) {
+	util, _ := NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute})
+
+	token1, err := util.GenerateSessionToken(1, "testuser", "test@example.com", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateSessionToken failed: %v", err)
+	}
+	token2, err := util.GenerateSessionToken(1, "testuser", "test@example.com", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateSessionToken failed: %v", err)
+	}
+
+	claims1, err := util.ValidateToken(token1)
+	if err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+	claims2, err := util.ValidateToken(token2)
+	if err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+
+	// 验证 jti 唯一且会话标识被保留
+	if claims1.ID == "" || claims1.ID == claims2.ID {
+		t.Errorf("jti should be unique, got %q and %q", claims1.ID, claims2.ID)
+	}
+	if claims1.SessionID != "session-1" {
+		t.Errorf("SessionID = %v, want session-1", claims1.SessionID)
+	}
+	if remaining := claims1.RemainingLifetime(); remaining <= 0 || remaining > 15*time.Minute {
+		t.Errorf("RemainingLifetime = %v, want (0, 15m]", remaining)
+	}
+}
+
+func TestIDTokenRejectedAsAccessToken(t *testing.T) {
+	util, _ := NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute})
+
+	idToken, err := util.GenerateIDToken(&IDTokenClaims{
+		Nonce: "n-1",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "1",
+			Audience:  jwt.ClaimStrings{"client-1"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("GenerateIDToken failed: %v", err)
+	}
+	if _, err := util.ValidateToken(idToken); err == nil {
+		t.Error("ID Token should not be accepted as access token")
+	}
+}
+
+func TestRefreshExpireDefault(t *testing.T) {
+	util, _ := NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute})
+	if util.RefreshExpire() != DefaultRefreshExpire {
+		t.Errorf("RefreshExpire() = %v, want %v", util.RefreshExpire(), DefaultRefreshExpire)
+	}
+
+	util, _ = NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute, RefreshExpire: time.Hour})
+	if util.RefreshExpire() != time.Hour {
+		t.Errorf("RefreshExpire() = %v, want %v", util.RefreshExpire(), time.Hour)
+	}
+}
@@ -0,0 +1,284 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 支持的签名算法
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// 未知 kid 触发远程 JWKS 刷新的最小间隔
+const jwksRefreshInterval = time.Minute
+
+// 签名密钥配置
+type KeyConfig struct {
+	Kid            string `json:"kid"`
+	PrivateKeyFile string `json:"private_key_file"`
+	PublicKeyFile  string `json:"public_key_file"`
+}
+
+// 密钥集合，使用 active kid 对应的私钥签名，使用所有公钥验证
+type KeySet struct {
+	method    jwt.SigningMethod
+	secret    []byte
+	activeKid string
+	signKey   crypto.PrivateKey
+
+	mu         sync.RWMutex
+	verifyKeys map[string]crypto.PublicKey
+	// 本地配置的公钥，刷新远程 JWKS 时保留
+	localKeys map[string]crypto.PublicKey
+
+	jwksURL     string
+	lastFetched time.Time
+}
+
+// 根据配置创建密钥集合
+func NewKeySet(config *Config) (*KeySet, error) {
+	algorithm := config.Algorithm
+	if algorithm == "" {
+		algorithm = AlgorithmHS256
+	}
+
+	ks := &KeySet{
+		verifyKeys: make(map[string]crypto.PublicKey),
+		jwksURL:    config.JWKSURL,
+	}
+
+	switch algorithm {
+	case AlgorithmHS256:
+		if config.SecretKey == "" {
+			return nil, errors.New("jwt: secret_key is required for HS256")
+		}
+		ks.method = jwt.SigningMethodHS256
+		ks.secret = []byte(config.SecretKey)
+		return ks, nil
+	case AlgorithmRS256:
+		ks.method = jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		ks.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("jwt: unsupported algorithm %q", algorithm)
+	}
+
+	for _, kc := range config.Keys {
+		if kc.Kid == "" {
+			return nil, errors.New("jwt: key kid is required")
+		}
+
+		if kc.PrivateKeyFile != "" {
+			priv, err := loadPrivateKey(kc.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("jwt: load private key %s: %w", kc.Kid, err)
+			}
+			if !ks.matchAlgorithm(priv) {
+				return nil, fmt.Errorf("jwt: private key %s does not match algorithm %s", kc.Kid, algorithm)
+			}
+			if kc.Kid == config.ActiveKid {
+				ks.activeKid = kc.Kid
+				ks.signKey = priv
+			}
+			ks.verifyKeys[kc.Kid] = priv.(crypto.Signer).Public()
+		}
+
+		if kc.PublicKeyFile != "" {
+			pub, err := loadPublicKey(kc.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("jwt: load public key %s: %w", kc.Kid, err)
+			}
+			if !ks.matchAlgorithm(pub) {
+				return nil, fmt.Errorf("jwt: public key %s does not match algorithm %s", kc.Kid, algorithm)
+			}
+			ks.verifyKeys[kc.Kid] = pub
+		}
+	}
+
+	if config.ActiveKid != "" && ks.signKey == nil {
+		return nil, fmt.Errorf("jwt: private key for active kid %q not found", config.ActiveKid)
+	}
+
+	// 只验证不签名的服务从远程 JWKS 加载公钥，签发方暂时不可用时在首次验证时重试
+	if ks.jwksURL != "" {
+		ks.localKeys = maps.Clone(ks.verifyKeys)
+		_ = ks.refresh()
+		return ks, nil
+	}
+
+	if len(ks.verifyKeys) == 0 {
+		return nil, errors.New("jwt: no verification keys configured")
+	}
+	return ks, nil
+}
+
+// 签名算法
+func (ks *KeySet) Algorithm() string {
+	return ks.method.Alg()
+}
+
+// 是否可以签发Token
+func (ks *KeySet) CanSign() bool {
+	return ks.secret != nil || ks.signKey != nil
+}
+
+// 签名Claims
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.method, claims)
+	if ks.secret != nil {
+		return token.SignedString(ks.secret)
+	}
+	if ks.signKey == nil {
+		return "", errors.New("jwt: no signing key configured")
+	}
+	token.Header["kid"] = ks.activeKid
+	return token.SignedString(ks.signKey)
+}
+
+// 解析Token时查找验证密钥
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	// 只接受配置的签名算法，防止算法混淆攻击
+	if token.Method.Alg() != ks.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	if ks.secret != nil {
+		return ks.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("missing kid")
+	}
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+
+	// 未知 kid 可能是签发方刚刚轮换了密钥，刷新远程 JWKS 后再试一次
+	if ks.jwksURL != "" && ks.shouldRefresh() {
+		if err := ks.refresh(); err != nil {
+			return nil, err
+		}
+		if key, ok := ks.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown kid %q", kid)
+}
+
+// 导出公钥集合，对称密钥不会被导出
+func (ks *KeySet) JWKS() *JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	set := &JWKS{Keys: []JWK{}}
+	for kid, key := range ks.verifyKeys {
+		jwk, err := NewJWK(kid, ks.method.Alg(), key)
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, *jwk)
+	}
+	return set
+}
+
+func (ks *KeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	key, ok := ks.verifyKeys[kid]
+	return key, ok
+}
+
+func (ks *KeySet) shouldRefresh() bool {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return time.Since(ks.lastFetched) >= jwksRefreshInterval
+}
+
+// 从远程 JWKS 刷新公钥，用新的公钥集合替换上次获取的，签发方已移除的公钥不再用于验证
+// 获取失败时保留现有的公钥
+func (ks *KeySet) refresh() error {
+	set, err := FetchJWKS(ks.jwksURL)
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.lastFetched = time.Now()
+	if err != nil {
+		return fmt.Errorf("jwt: fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys)+len(ks.localKeys))
+	for _, jwk := range set.Keys {
+		if jwk.Alg != "" && jwk.Alg != ks.method.Alg() {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	// 本地配置的公钥优先
+	maps.Copy(keys, ks.localKeys)
+	ks.verifyKeys = keys
+	return nil
+}
+
+func (ks *KeySet) matchAlgorithm(key any) bool {
+	switch key.(type) {
+	case *rsa.PrivateKey, *rsa.PublicKey:
+		return ks.method == jwt.SigningMethodRS256
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return ks.method == jwt.SigningMethodEdDSA
+	}
+	return false
+}
+
+// 加载 PEM 格式的私钥，支持 PKCS#8 和 PKCS#1
+func loadPrivateKey(path string) (crypto.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// 加载 PEM 格式的公钥，支持 PKIX 和 PKCS#1
+func loadPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParsePKCS1PublicKey(block.Bytes)
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM data")
+	}
+	return block, nil
+}
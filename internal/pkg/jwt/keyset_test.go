@@ -0,0 +1,172 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 将私钥以 PKCS#8 PEM 格式写入临时文件
+func writePrivateKey(t *testing.T, dir, name string, key any) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey failed: %v", err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	return path
+}
+
+func TestKeySetAsymmetric(t *testing.T) {
+	dir := t.TempDir()
+	rsaOld, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaNew, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		keys      map[string]any
+	}{
+		{
+			name:      "RS256",
+			algorithm: AlgorithmRS256,
+			keys:      map[string]any{"old": rsaOld, "new": rsaNew},
+		},
+		{
+			name:      "EdDSA",
+			algorithm: AlgorithmEdDSA,
+			keys:      map[string]any{"new": edKey},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{Algorithm: tt.algorithm, ActiveKid: "new", Expire: 15 * time.Minute}
+			for kid, key := range tt.keys {
+				config.Keys = append(config.Keys, KeyConfig{
+					Kid:            kid,
+					PrivateKeyFile: writePrivateKey(t, dir, tt.name+"-"+kid+".pem", key),
+				})
+			}
+
+			issuer, err := NewJWTUtil(config)
+			if err != nil {
+				t.Fatalf("NewJWTUtil failed: %v", err)
+			}
+			token, err := issuer.GenerateToken(1, "testuser", "test@example.com")
+			if err != nil {
+				t.Fatalf("GenerateToken failed: %v", err)
+			}
+
+			// 验证方只通过 JWKS 获取公钥
+			srv := httptest.NewServer(issuer.JWKSHandler())
+			defer srv.Close()
+
+			verifier, err := NewJWTUtil(&Config{Algorithm: tt.algorithm, JWKSURL: srv.URL})
+			if err != nil {
+				t.Fatalf("NewJWTUtil with jwks failed: %v", err)
+			}
+			if len(verifier.keys.JWKS().Keys) != len(tt.keys) {
+				t.Errorf("verifier has %d keys, want %d", len(verifier.keys.JWKS().Keys), len(tt.keys))
+			}
+			claims, err := verifier.ValidateToken(token)
+			if err != nil {
+				t.Fatalf("ValidateToken failed: %v", err)
+			}
+			if claims.UserID != 1 {
+				t.Errorf("UserID = %v, want 1", claims.UserID)
+			}
+			if _, err := verifier.GenerateToken(1, "testuser", "test@example.com"); err == nil {
+				t.Error("verifier without private key should not sign tokens")
+			}
+		})
+	}
+}
+
+func TestKeySetRejectsAlgorithmConfusion(t *testing.T) {
+	dir := t.TempDir()
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaUtil, err := NewJWTUtil(&Config{
+		Algorithm: AlgorithmRS256,
+		ActiveKid: "k1",
+		Expire:    15 * time.Minute,
+		Keys:      []KeyConfig{{Kid: "k1", PrivateKeyFile: writePrivateKey(t, dir, "k1.pem", key)}},
+	})
+	if err != nil {
+		t.Fatalf("NewJWTUtil failed: %v", err)
+	}
+
+	// HS256 签发的 token 不能通过 RS256 验证
+	hmacUtil, _ := NewJWTUtil(&Config{SecretKey: "test-secret", Expire: 15 * time.Minute})
+	token, err := hmacUtil.GenerateToken(1, "testuser", "test@example.com")
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+	if _, err := rsaUtil.ValidateToken(token); err == nil {
+		t.Error("HS256 token should be rejected by RS256 key set")
+	}
+
+	// 对称密钥不会出现在 JWKS 中
+	if n := len(hmacUtil.keys.JWKS().Keys); n != 0 {
+		t.Errorf("HS256 JWKS has %d keys, want 0", n)
+	}
+}
+
+func TestKeySetRefreshReplacesRemoteKeys(t *testing.T) {
+	dir := t.TempDir()
+	newIssuer := func(kid string) *JWTUtil {
+		key, _ := rsa.GenerateKey(rand.Reader, 2048)
+		util, err := NewJWTUtil(&Config{
+			Algorithm: AlgorithmRS256,
+			ActiveKid: kid,
+			Expire:    15 * time.Minute,
+			Keys:      []KeyConfig{{Kid: kid, PrivateKeyFile: writePrivateKey(t, dir, kid+".pem", key)}},
+		})
+		if err != nil {
+			t.Fatalf("NewJWTUtil failed: %v", err)
+		}
+		return util
+	}
+	oldIssuer, rotated := newIssuer("old"), newIssuer("new")
+
+	// 签发方轮换密钥后 JWKS 中只剩新公钥
+	current := oldIssuer
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current.JWKSHandler()(w, r)
+	}))
+	defer srv.Close()
+
+	verifier, err := NewJWTUtil(&Config{Algorithm: AlgorithmRS256, JWKSURL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewJWTUtil with jwks failed: %v", err)
+	}
+	oldToken, _ := oldIssuer.GenerateToken(1, "testuser", "test@example.com")
+	if _, err := verifier.ValidateToken(oldToken); err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+
+	current = rotated
+	verifier.keys.lastFetched = time.Time{}
+	newToken, _ := rotated.GenerateToken(1, "testuser", "test@example.com")
+	if _, err := verifier.ValidateToken(newToken); err != nil {
+		t.Fatalf("ValidateToken with rotated key failed: %v", err)
+	}
+	if _, err := verifier.ValidateToken(oldToken); err == nil {
+		t.Error("签发方移除的公钥不应继续用于验证")
+	}
+	if n := len(verifier.keys.JWKS().Keys); n != 1 {
+		t.Errorf("verifier has %d keys, want 1", n)
+	}
+}
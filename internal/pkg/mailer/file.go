@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileMailer 将邮件写入本地目录，便于本地调试
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer 创建文件邮件发送器
+func NewFileMailer(dir, from string) *FileMailer {
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+	return &FileMailer{dir: dir, from: from}
+}
+
+// Send 将邮件保存为 .eml 文件
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	if err := validateMessage(msg); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(msg.To)
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102-150405.000000000"), recipient)
+	return os.WriteFile(filepath.Join(m.dir, name), buildMessage(m.from, msg, now), 0o600)
+}
@@ -0,0 +1,92 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// 发送方式
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// 本地运行时邮件的默认保存目录
+const defaultDir = "mails"
+
+// Config 邮件发送配置
+type Config struct {
+	// smtp / file / memory，为空时使用 file
+	Driver   string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// file 方式下邮件的保存目录
+	Dir string
+}
+
+// Message 邮件内容
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 邮件发送接口
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewMailer 根据配置创建邮件发送器
+func NewMailer(c *Config) (Mailer, error) {
+	if c == nil {
+		c = &Config{}
+	}
+	switch c.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(c)
+	case DriverMemory:
+		return NewMemoryMailer(), nil
+	case DriverFile, "":
+		dir := c.Dir
+		if dir == "" {
+			dir = defaultDir
+		}
+		return NewFileMailer(dir, c.From), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", c.Driver)
+	}
+}
+
+// 生成 RFC 5322 格式的邮件内容
+func buildMessage(from string, msg *Message, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return buf.Bytes()
+}
+
+// 校验收件人，防止头部注入
+func validateMessage(msg *Message) error {
+	if msg == nil || msg.To == "" {
+		return fmt.Errorf("mail recipient is empty")
+	}
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return fmt.Errorf("invalid mail header")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewMailer(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{name: "默认使用文件", config: nil},
+		{name: "内存", config: &Config{Driver: DriverMemory}},
+		{name: "SMTP", config: &Config{Driver: DriverSMTP, Host: "smtp.example.com", From: "no-reply@example.com"}},
+		{name: "SMTP缺少主机", config: &Config{Driver: DriverSMTP}, wantErr: true},
+		{name: "未知方式", config: &Config{Driver: "sendmail"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMailer(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMailer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := t.TempDir()
+	m := NewFileMailer(dir, "no-reply@example.com")
+
+	err := m.Send(context.Background(), &Message{To: "user@example.com", Subject: "重置密码", Body: "hello\nworld"})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Fatalf("邮件文件数量 = %d, want 1", len(entries))
+	}
+	content, _ := os.ReadFile(dir + "/" + entries[0].Name())
+	if !strings.Contains(string(content), "To: user@example.com\r\n") || !strings.HasSuffix(string(content), "hello\r\nworld") {
+		t.Errorf("邮件内容不正确: %q", content)
+	}
+}
+
+func TestMemoryMailer_Send(t *testing.T) {
+	m := NewMemoryMailer()
+
+	if err := m.Send(context.Background(), &Message{To: "a@example.com\r\nBcc: b@example.com"}); err == nil {
+		t.Error("包含换行的收件人应返回错误")
+	}
+	if err := m.Send(context.Background(), &Message{To: "a@example.com", Subject: "hi"}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got := m.Messages(); len(got) != 1 || got[0].To != "a@example.com" {
+		t.Errorf("Messages() = %v", got)
+	}
+}
@@ -0,0 +1,48 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPMailer 通过 SMTP 服务器发送邮件
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer 创建 SMTP 邮件发送器
+func NewSMTPMailer(c *Config) (*SMTPMailer, error) {
+	if c.Host == "" || c.From == "" {
+		return nil, fmt.Errorf("smtp host and from are required")
+	}
+	port := c.Port
+	if port == 0 {
+		port = 587
+	}
+
+	m := &SMTPMailer{
+		addr: net.JoinHostPort(c.Host, strconv.Itoa(port)),
+		from: c.From,
+	}
+	if c.Username != "" {
+		m.auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+	return m, nil
+}
+
+// Send 发送邮件，服务器支持时自动使用 STARTTLS
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	if err := validateMessage(msg); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, buildMessage(m.from, msg, time.Now()))
+}
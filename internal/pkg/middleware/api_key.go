@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"student/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// APIKeyHeader API Key 请求头
+const APIKeyHeader = "X-API-Key"
+
+const apiKeyIdentityKey contextKey = "api_key"
+
+// API Key 认证
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*biz.APIKeyIdentity, bool)
+}
+
+// 从请求头中提取 API Key，支持 X-API-Key 和 Authorization: ApiKey <key>
+func extractAPIKey(header interface{ Get(string) string }) string {
+	if key := header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	scheme, key, ok := strings.Cut(header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "ApiKey") {
+		return strings.TrimSpace(key)
+	}
+	return ""
+}
+
+// 校验 API Key 及其权限范围
+func authenticateAPIKey(ctx context.Context, auth APIKeyAuthenticator, key, path, method string) (*biz.APIKeyIdentity, error) {
+	identity, ok := auth.AuthenticateAPIKey(ctx, key)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "无效的API Key")
+	}
+	if !identity.Allows(path, method) {
+		return nil, errors.Forbidden("FORBIDDEN", "API Key权限范围不足")
+	}
+	return identity, nil
+}
+
+// 从上下文中获取当前请求使用的 API Key，使用 JWT 认证时返回 false
+func GetAPIKeyFromContext(ctx context.Context) (*biz.APIKeyIdentity, bool) {
+	identity, ok := ctx.Value(apiKeyIdentityKey).(*biz.APIKeyIdentity)
+	return identity, ok
+}
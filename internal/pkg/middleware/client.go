@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+const clientIPKey contextKey = "client_ip"
+
+// TrustedProxies 受信任的反向代理地址
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies 解析受信任的反向代理，支持单个 IP 和 CIDR
+func ParseTrustedProxies(addrs []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(addrs))
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if !strings.Contains(addr, "/") {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", addr)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", addr, err)
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) contains(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 解析客户端IP并写入上下文
+// 只有连接的对端是受信任的代理时才使用 X-Forwarded-For 和 X-Real-IP，否则请求头可以被客户端任意伪造
+func ClientIP(trusted TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			return handler(context.WithValue(ctx, clientIPKey, resolveClientIP(ctx, trusted)), req)
+		}
+	}
+}
+
+func resolveClientIP(ctx context.Context, trusted TrustedProxies) string {
+	remote := remoteIP(ctx)
+	if !trusted.contains(remote) {
+		return remote
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return remote
+	}
+	// 从右向左跳过受信任的代理，第一个不受信任的地址即客户端
+	if forwarded := tr.RequestHeader().Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if i == 0 || !trusted.contains(hop) {
+				return hop
+			}
+		}
+	}
+	if realIP := tr.RequestHeader().Get("X-Real-IP"); realIP != "" {
+		return strings.TrimSpace(realIP)
+	}
+	return remote
+}
+
+// 连接的对端地址
+func remoteIP(ctx context.Context) string {
+	var addr string
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		addr = req.RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// 从上下文中获取客户端IP，没有经过 ClientIP 中间件时使用连接的对端地址
+func GetClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey).(string); ok {
+		return ip
+	}
+	return remoteIP(ctx)
+}
+
+// 从上下文中获取客户端 User-Agent
+func GetUserAgent(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("User-Agent")
+	}
+	return ""
+}
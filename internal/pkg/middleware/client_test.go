@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type fakeHeader metadata.MD
+
+func (h fakeHeader) Get(key string) string {
+	if v := metadata.MD(h).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+func (h fakeHeader) Set(key, value string)      { metadata.MD(h).Set(key, value) }
+func (h fakeHeader) Add(key, value string)      { metadata.MD(h).Append(key, value) }
+func (h fakeHeader) Keys() []string             { return nil }
+func (h fakeHeader) Values(key string) []string { return metadata.MD(h).Get(key) }
+
+type fakeTransport struct {
+	header metadata.MD
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return "" }
+func (t *fakeTransport) RequestHeader() transport.Header { return fakeHeader(t.header) }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return fakeHeader(metadata.MD{}) }
+
+func TestClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		trusted TrustedProxies
+		peer    string
+		header  map[string]string
+		want    string
+	}{
+		{name: "未配置代理时忽略转发头", peer: "203.0.113.5", header: map[string]string{"x-forwarded-for": "1.1.1.1"}, want: "203.0.113.5"},
+		{name: "不受信任的对端不能伪造IP", trusted: trusted, peer: "203.0.113.5", header: map[string]string{"x-forwarded-for": "1.1.1.1", "x-real-ip": "1.1.1.1"}, want: "203.0.113.5"},
+		{name: "受信任的代理转发", trusted: trusted, peer: "192.168.1.1", header: map[string]string{"x-forwarded-for": "1.1.1.1"}, want: "1.1.1.1"},
+		// 代理追加在最右侧，左侧的地址由客户端提供
+		{name: "忽略客户端伪造的左侧地址", trusted: trusted, peer: "10.0.0.1", header: map[string]string{"x-forwarded-for": "1.1.1.1, 2.2.2.2"}, want: "2.2.2.2"},
+		{name: "跳过多级受信任的代理", trusted: trusted, peer: "10.0.0.1", header: map[string]string{"x-forwarded-for": "2.2.2.2, 10.0.0.2"}, want: "2.2.2.2"},
+		{name: "受信任的代理使用 X-Real-IP", trusted: trusted, peer: "10.0.0.1", header: map[string]string{"x-real-ip": "3.3.3.3"}, want: "3.3.3.3"},
+		{name: "代理没有转发头", trusted: trusted, peer: "10.0.0.1", want: "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 50000}})
+			ctx = transport.NewServerContext(ctx, &fakeTransport{header: metadata.New(tt.header)})
+
+			var got string
+			_, err := ClientIP(tt.trusted)(func(ctx context.Context, req any) (any, error) {
+				got = GetClientIP(ctx)
+				return nil, nil
+			})(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GetClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("应拒绝无效的 CIDR")
+	}
+	if _, err := ParseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Error("应拒绝无效的 IP")
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 在响应头中返回版本号，格式为 ETag: "3"
+func SetETag(ctx context.Context, version uint) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("ETag", strconv.Quote(strconv.FormatUint(uint64(version), 10)))
+	}
+}
+
+// 获取客户端提交的版本号，优先使用 If-Match 请求头，其次使用请求中的 version 字段，都没有时返回 0
+func RequestVersion(ctx context.Context, version uint32) (uint, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return uint(version), nil
+	}
+	ifMatch := strings.TrimSpace(tr.RequestHeader().Get("If-Match"))
+	if ifMatch == "" {
+		return uint(version), nil
+	}
+	// 版本号只能精确匹配，弱校验的 W/ 前缀忽略
+	tag, err := strconv.Unquote(strings.TrimPrefix(ifMatch, "W/"))
+	if err != nil {
+		return 0, errors.BadRequest("INVALID_ARGUMENT", "If-Match 格式错误，应为 GET 响应中的 ETag")
+	}
+	v, err := strconv.ParseUint(tag, 10, 32)
+	if err != nil || v == 0 {
+		return 0, errors.BadRequest("INVALID_ARGUMENT", "If-Match 格式错误，应为 GET 响应中的 ETag")
+	}
+	if version != 0 && uint64(version) != v {
+		return 0, errors.BadRequest("INVALID_ARGUMENT", "If-Match 与 version 不一致")
+	}
+	return uint(v), nil
+}
@@ -4,19 +4,44 @@ import (
 	"context"
 	"log"
 	"net/http"
-	"slices"
 	"strings"
 
+	"student/internal/biz"
 	"student/internal/pkg/jwt"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 )
 
+// 访问令牌撤销检查
+type TokenRevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, claims *jwt.Claims) (bool, error)
+}
+
+// 会话活跃时间记录
+type SessionTracker interface {
+	TouchSession(ctx context.Context, claims *jwt.Claims)
+}
+
+// 模拟登录请求的审计记录
+type ImpersonationAuditor interface {
+	AuditImpersonation(ctx context.Context, claims *jwt.Claims, method, path string, client biz.ClientInfo) error
+}
+
 // JWT中间件配置
 type JWTConfig struct {
 	JWTUtil *jwt.JWTUtil
-	// 不需要验证JWT的路径
+	// 令牌撤销检查，为空时不检查
+	Revocation TokenRevocationChecker
+	// API Key 认证，为空时不支持 API Key
+	APIKeys APIKeyAuthenticator
+	// 会话活跃时间记录，为空时不记录
+	Sessions SessionTracker
+	// 模拟登录审计，为空时拒绝模拟登录的Token
+	Audit ImpersonationAuditor
+	// 不需要验证JWT的路径，gRPC 请求按方法名匹配，如 /user.v1.User/Login
 	SkipPaths []string
 }
 
@@ -30,6 +55,24 @@ func JWTAuth(config *JWTConfig) middleware.Middleware {
 				return handler(ctx, req)
 			}
 
+			// 使用 API Key 认证时，以所属用户的身份访问
+			if httpCtx, ok := ctx.(interface {
+				Request() *http.Request
+			}); ok && config.APIKeys != nil {
+				r := httpCtx.Request()
+				if key := extractAPIKey(r.Header); key != "" {
+					identity, err := authenticateAPIKey(ctx, config.APIKeys, key, r.URL.Path, r.Method)
+					if err != nil {
+						return nil, err
+					}
+					ctx = context.WithValue(ctx, apiKeyIdentityKey, identity)
+					ctx = context.WithValue(ctx, "user_id", identity.UserID)
+					ctx = context.WithValue(ctx, "username", identity.Username)
+					ctx = context.WithValue(ctx, "email", identity.Email)
+					return handler(ctx, req)
+				}
+			}
+
 			// 从HTTP请求中获取token
 			token, err := extractTokenFromContext(ctx)
 			if err != nil {
@@ -42,7 +85,21 @@ func JWTAuth(config *JWTConfig) middleware.Middleware {
 				return nil, errors.Unauthorized("UNAUTHORIZED", "token验证失败")
 			}
 
+			// 检查token是否已被撤销
+			if err := checkTokenRevoked(ctx, config.Revocation, claims); err != nil {
+				return nil, err
+			}
+			if config.Sessions != nil {
+				config.Sessions.TouchSession(ctx, claims)
+			}
+			if claims.Impersonated() {
+				if err := auditImpersonation(ctx, config.Audit, claims); err != nil {
+					return nil, err
+				}
+			}
+
 			// 将用户信息存储到上下文中
+			ctx = context.WithValue(ctx, claimsKey, claims)
 			ctx = context.WithValue(ctx, "user_id", claims.UserID)
 			ctx = context.WithValue(ctx, "username", claims.Username)
 			ctx = context.WithValue(ctx, "email", claims.Email)
@@ -55,6 +112,42 @@ func JWTAuth(config *JWTConfig) middleware.Middleware {
 	}
 }
 
+// 检查token是否已被撤销
+func checkTokenRevoked(ctx context.Context, checker TokenRevocationChecker, claims *jwt.Claims) error {
+	if checker == nil {
+		return nil
+	}
+	revoked, err := checker.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return errors.InternalServer("INTERNAL_ERROR", "token状态检查失败")
+	}
+	if revoked {
+		return errors.Unauthorized("TOKEN_REVOKED", "token已失效，请重新登录")
+	}
+	return nil
+}
+
+// 记录模拟登录的请求，无法记录时拒绝请求
+func auditImpersonation(ctx context.Context, auditor ImpersonationAuditor, claims *jwt.Claims) error {
+	if auditor == nil {
+		return errors.Forbidden("IMPERSONATION_NOT_ALLOWED", "当前服务不支持模拟登录")
+	}
+	var method, path string
+	if req, ok := khttp.RequestFromServerContext(ctx); ok {
+		method, path = req.Method, req.URL.Path
+	} else if tr, ok := transport.FromServerContext(ctx); ok {
+		path = tr.Operation()
+	}
+	err := auditor.AuditImpersonation(ctx, claims, method, path, biz.ClientInfo{
+		IP:        GetClientIP(ctx),
+		UserAgent: GetUserAgent(ctx),
+	})
+	if err != nil {
+		return errors.InternalServer("INTERNAL_ERROR", "审计日志写入失败")
+	}
+	return nil
+}
+
 // 从上下文中提取token
 func extractTokenFromContext(ctx context.Context) (string, error) {
 	// 对于Kratos，我们需要通过HTTP请求头获取
@@ -64,6 +157,14 @@ func extractTokenFromContext(ctx context.Context) (string, error) {
 		req := httpCtx.Request()
 		return extractTokenFromRequest(req)
 	}
+	// gRPC 请求从 metadata 中获取
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		authHeader := tr.RequestHeader().Get("Authorization")
+		if authHeader == "" {
+			return "", errors.New(400, "MISSING_TOKEN", "缺少Authorization头")
+		}
+		return extractTokenFromHeader(authHeader)
+	}
 	return "", errors.New(400, "INVALID_REQUEST", "无法获取请求信息")
 }
 
@@ -94,11 +195,21 @@ func shouldSkipJWT(ctx context.Context, skipPaths []string) bool {
 		path := req.URL.Path
 
 		// 检查当前路径是否在跳过列表中
-		return slices.Contains(skipPaths, path)
+		return matchSkipPath(path, skipPaths)
+	}
+	// gRPC 请求按方法名匹配
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return matchSkipPath(tr.Operation(), skipPaths)
 	}
 	return false
 }
 
+// 从上下文中获取当前token的Claims
+func GetClaimsFromContext(ctx context.Context) (*jwt.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*jwt.Claims)
+	return claims, ok
+}
+
 // 从上下文中获取用户ID
 func GetUserIDFromContext(ctx context.Context) (uint, bool) {
 	if userID, ok := ctx.Value(userIDKey).(uint); ok {
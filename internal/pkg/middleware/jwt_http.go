@@ -18,6 +18,7 @@ const (
 	userIDKey   contextKey = "user_id"
 	usernameKey contextKey = "username"
 	emailKey    contextKey = "email"
+	claimsKey   contextKey = "claims"
 )
 
 // RequireAuthorizationHeader 是一个中间件，要求请求必须带 Authorization 头
@@ -35,7 +36,7 @@ func RequireAuthorizationHeader() func(http.Handler) http.Handler {
 }
 
 // JWTAuthMiddleware JWT 认证中间件
-func JWTAuthMiddleware(jwtUtil *jwt.JWTUtil, skipPaths []string) func(http.Handler) http.Handler {
+func JWTAuthMiddleware(jwtUtil *jwt.JWTUtil, revocation TokenRevocationChecker, skipPaths []string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// 检查是否需要跳过认证
@@ -65,8 +66,16 @@ func JWTAuthMiddleware(jwtUtil *jwt.JWTUtil, skipPaths []string) func(http.Handl
 				return
 			}
 
+			// 检查 token 是否已被撤销
+			if err := checkTokenRevoked(r.Context(), revocation, claims); err != nil {
+				se := errors.FromError(err)
+				sendErrorResponse(w, int(se.Code), se.Message)
+				return
+			}
+
 			// 将用户信息存储到请求上下文中
 			ctx := r.Context()
+			ctx = context.WithValue(ctx, claimsKey, claims)
 			ctx = context.WithValue(ctx, userIDKey, claims.UserID)
 			ctx = context.WithValue(ctx, usernameKey, claims.Username)
 			ctx = context.WithValue(ctx, emailKey, claims.Email)
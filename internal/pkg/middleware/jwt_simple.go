@@ -33,6 +33,7 @@ func SimpleJWTAuth(config *SimpleJWTConfig) middleware.Middleware {
 			}
 
 			// 将用户信息存储到上下文中
+			ctx = context.WithValue(ctx, claimsKey, claims)
 			ctx = context.WithValue(ctx, userIDKey, claims.UserID)
 			ctx = context.WithValue(ctx, usernameKey, claims.Username)
 			ctx = context.WithValue(ctx, emailKey, claims.Email)
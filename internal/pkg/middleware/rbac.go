@@ -18,6 +18,10 @@ import (
 type RBACConfig struct {
 	RBACUC  *biz.RBACUsecase
 	JWTUtil *jwt.JWTUtil
+	// 令牌撤销检查，为空时不检查
+	Revocation TokenRevocationChecker
+	// API Key 认证，为空时不支持 API Key
+	APIKeys APIKeyAuthenticator
 	// 不需要进行RBAC权限检查的路径
 	SkipPaths []string
 }
@@ -44,27 +48,16 @@ func RBACMiddleware(config *RBACConfig) middleware.Middleware {
 				}
 
 				// 检查是否需要跳过RBAC权限检查
-				if shouldSkipRBAC(path, config.SkipPaths) {
+				if matchSkipPath(path, config.SkipPaths) {
 					return handler(ctx, req)
 				}
 
-				// 从请求头获取JWT token
-				token := tr.RequestHeader().Get("Authorization")
-				if token == "" {
-					return nil, errors.Unauthorized("UNAUTHORIZED", "未提供认证token")
-				}
-
-				// 移除Bearer前缀
-				token = strings.TrimPrefix(token, "Bearer ")
-
-				// 验证JWT token
-				claims, err := config.JWTUtil.ValidateToken(token)
+				userID, err := rbacSubject(ctx, config, tr.RequestHeader(), path, method)
 				if err != nil {
-					return nil, errors.Unauthorized("UNAUTHORIZED", "无效的token")
+					return nil, err
 				}
 
 				// 检查权限
-				userID := strconv.Itoa(int(claims.UserID))
 				hasPermission, err := config.RBACUC.CheckPermission(ctx, userID, path, method)
 				if err != nil {
 					return nil, errors.InternalServer("INTERNAL_ERROR", "权限检查失败")
@@ -80,9 +73,49 @@ func RBACMiddleware(config *RBACConfig) middleware.Middleware {
 	}
 }
 
-// 检查是否需要跳过RBAC权限检查
-func shouldSkipRBAC(path string, skipPaths []string) bool {
-	return slices.Contains(skipPaths, path)
+// 解析请求对应的用户ID，API Key 请求使用所属用户的权限，并限制在 Key 的权限范围内
+func rbacSubject(ctx context.Context, config *RBACConfig, header transport.Header, path, method string) (string, error) {
+	if config.APIKeys != nil {
+		if key := extractAPIKey(header); key != "" {
+			identity, err := authenticateAPIKey(ctx, config.APIKeys, key, path, method)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(int(identity.UserID)), nil
+		}
+	}
+
+	// 从请求头获取JWT token
+	token := header.Get("Authorization")
+	if token == "" {
+		return "", errors.Unauthorized("UNAUTHORIZED", "未提供认证token")
+	}
+
+	// 移除Bearer前缀
+	token = strings.TrimPrefix(token, "Bearer ")
+
+	// 验证JWT token
+	claims, err := config.JWTUtil.ValidateToken(token)
+	if err != nil {
+		return "", errors.Unauthorized("UNAUTHORIZED", "无效的token")
+	}
+
+	// 检查token是否已被撤销
+	if err := checkTokenRevoked(ctx, config.Revocation, claims); err != nil {
+		return "", err
+	}
+	// 模拟登录的Token中 user_id 为被模拟的用户，按其权限检查，操作人的权限不会叠加
+	return strconv.Itoa(int(claims.UserID)), nil
+}
+
+// 检查路径是否在跳过列表中，以 * 结尾的路径按前缀匹配
+func matchSkipPath(path string, skipPaths []string) bool {
+	return slices.ContainsFunc(skipPaths, func(skip string) bool {
+		if prefix, ok := strings.CutSuffix(skip, "*"); ok {
+			return strings.HasPrefix(path, prefix)
+		}
+		return skip == path
+	})
 }
 
 // SimpleRBACMiddleware 简化版RBAC中间件，用于特定路径的权限检查
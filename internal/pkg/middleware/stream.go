@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc"
+)
+
+// StreamServerInterceptor 在 gRPC 流式方法上执行中间件，kratos 的中间件默认只作用于普通方法
+// 中间件在建立流时执行一次，req 为 nil，中间件写入 ctx 的值可以通过 stream.Context() 获取
+func StreamServerInterceptor(m ...middleware.Middleware) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		h := middleware.Chain(m...)(func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, kgrpc.NewWrappedStream(ctx, ss))
+		})
+		_, err := h(ss.Context(), nil)
+		return err
+	}
+}
@@ -0,0 +1,101 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 支持的哈希算法
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// Config 密码配置
+type Config struct {
+	// bcrypt 或 argon2id，为空时使用 bcrypt
+	Algorithm  string
+	BcryptCost int
+	Argon2     Argon2Params
+	Policy     Policy
+}
+
+// Hasher 按配置的算法加密密码，并识别已存储哈希的算法和强度
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     Argon2Params
+}
+
+// NewHasher 创建密码加密器
+func NewHasher(c *Config) (*Hasher, error) {
+	if c == nil {
+		c = &Config{}
+	}
+
+	h := &Hasher{
+		algorithm:  c.Algorithm,
+		bcryptCost: c.BcryptCost,
+		argon2:     c.Argon2,
+	}
+	if h.algorithm == "" {
+		h.algorithm = AlgorithmBcrypt
+	}
+	if h.algorithm != AlgorithmBcrypt && h.algorithm != AlgorithmArgon2id {
+		return nil, fmt.Errorf("unsupported password algorithm: %s", c.Algorithm)
+	}
+	if h.bcryptCost == 0 {
+		h.bcryptCost = DefaultCost
+	}
+	if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("invalid bcrypt cost: %d", h.bcryptCost)
+	}
+	if h.argon2.Memory == 0 {
+		h.argon2.Memory = DefaultArgon2Params.Memory
+	}
+	if h.argon2.Iterations == 0 {
+		h.argon2.Iterations = DefaultArgon2Params.Iterations
+	}
+	if h.argon2.Parallelism == 0 {
+		h.argon2.Parallelism = DefaultArgon2Params.Parallelism
+	}
+	return h, nil
+}
+
+// Hash 使用当前配置的算法加密密码
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmArgon2id {
+		return HashArgon2id(password, h.argon2)
+	}
+	return HashPasswordWithCost(password, h.bcryptCost)
+}
+
+// Check 验证密码，根据哈希前缀识别算法
+func (h *Hasher) Check(password, hash string) bool {
+	return CheckPassword(password, hash)
+}
+
+// NeedsRehash 判断已存储的哈希是否弱于当前配置，需要在登录成功后重新加密
+func (h *Hasher) NeedsRehash(hash string) bool {
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		if h.algorithm != AlgorithmArgon2id {
+			return true
+		}
+		p, _, _, err := decodeArgon2id(hash)
+		if err != nil {
+			return true
+		}
+		return p.Memory < h.argon2.Memory || p.Iterations < h.argon2.Iterations || p.Parallelism < h.argon2.Parallelism
+	case isBcrypt(hash):
+		if h.algorithm != AlgorithmBcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost < h.bcryptCost
+	default:
+		return true
+	}
+}
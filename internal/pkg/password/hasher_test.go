@@ -0,0 +1,87 @@
+package password
+
+import (
+	"strings"
+	"testing"
+)
+
+// 测试使用较小的参数，避免耗时过长
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestHashArgon2id(t *testing.T) {
+	hash, err := HashArgon2id("testpassword123", testArgon2Params)
+	if err != nil {
+		t.Fatalf("HashArgon2id failed: %v", err)
+	}
+
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("unexpected hash format: %s", hash)
+	}
+	if !IsHashed(hash) {
+		t.Error("Argon2id hash should be recognized as hashed")
+	}
+	if !CheckPassword("testpassword123", hash) {
+		t.Error("Password check should pass for correct password")
+	}
+	if CheckPassword("wrongpassword", hash) {
+		t.Error("Password check should fail for wrong password")
+	}
+	if CheckPassword("testpassword123", "$argon2id$v=19$m=1024,t=1,p=1$invalid") {
+		t.Error("Password check should fail for malformed hash")
+	}
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	bcrypt10, _ := HashPasswordWithCost("testpassword123", 10)
+	argonWeak, _ := HashArgon2id("testpassword123", testArgon2Params)
+	argonStrong, _ := HashArgon2id("testpassword123", Argon2Params{Memory: 2048, Iterations: 2, Parallelism: 1})
+
+	bcryptHasher, err := NewHasher(&Config{BcryptCost: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	argonHasher, err := NewHasher(&Config{Algorithm: AlgorithmArgon2id, Argon2: Argon2Params{Memory: 2048, Iterations: 2, Parallelism: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		hasher   *Hasher
+		hash     string
+		expected bool
+	}{
+		{name: "bcrypt成本一致", hasher: bcryptHasher, hash: bcrypt10, expected: false},
+		{name: "bcrypt升级为Argon2id", hasher: argonHasher, hash: bcrypt10, expected: true},
+		{name: "Argon2id参数较弱", hasher: argonHasher, hash: argonWeak, expected: true},
+		{name: "Argon2id参数一致", hasher: argonHasher, hash: argonStrong, expected: false},
+		{name: "Argon2id降级为bcrypt", hasher: bcryptHasher, hash: argonStrong, expected: true},
+		{name: "未知格式", hasher: bcryptHasher, hash: "plaintext", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.expected {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewHasher(t *testing.T) {
+	if _, err := NewHasher(&Config{Algorithm: "md5"}); err == nil {
+		t.Error("unsupported algorithm should return error")
+	}
+	if _, err := NewHasher(&Config{BcryptCost: 40}); err == nil {
+		t.Error("invalid bcrypt cost should return error")
+	}
+
+	h, err := NewHasher(&Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := h.Hash("testpassword123")
+	if err != nil || !h.Check("testpassword123", hash) {
+		t.Errorf("Hash/Check failed: %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+package password
+
+// Manager 组合密码策略和加密器
+type Manager struct {
+	hasher *Hasher
+	policy Policy
+}
+
+// NewManager 根据配置创建密码管理器
+func NewManager(c *Config) (*Manager, error) {
+	if c == nil {
+		c = &Config{}
+	}
+	hasher, err := NewHasher(c)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{hasher: hasher, policy: c.Policy}, nil
+}
+
+// Validate 校验密码强度
+func (m *Manager) Validate(password, username string) error {
+	return m.policy.Validate(password, username)
+}
+
+// Hash 使用当前配置的算法加密密码
+func (m *Manager) Hash(password string) (string, error) {
+	return m.hasher.Hash(password)
+}
+
+// Check 验证密码
+func (m *Manager) Check(password, hash string) bool {
+	return m.hasher.Check(password, hash)
+}
+
+// NeedsRehash 判断已存储的哈希是否需要升级
+func (m *Manager) NeedsRehash(hash string) bool {
+	return m.hasher.NeedsRehash(hash)
+}
@@ -1,6 +1,8 @@
 package password
 
 import (
+	"strings"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -21,14 +23,21 @@ func HashPasswordWithCost(password string, cost int) (string, error) {
 	return string(bytes), err
 }
 
-// CheckPassword 验证密码是否匹配
+// CheckPassword 验证密码是否匹配，支持 bcrypt 和 Argon2id
 func CheckPassword(password, hash string) bool {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		return checkArgon2id(password, hash)
+	}
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
 
 // IsHashed 检查密码是否已经加密
 func IsHashed(password string) bool {
-	// bcrypt 加密后的密码通常以 $2a$, $2b$, $2x$, $2y$ 开头
-	return len(password) >= 4 && (password[:4] == "$2a$" || password[:4] == "$2b$" || password[:4] == "$2x$" || password[:4] == "$2y$")
+	return isBcrypt(password) || strings.HasPrefix(password, argon2idPrefix)
+}
+
+// bcrypt 加密后的密码通常以 $2a$, $2b$, $2x$, $2y$ 开头
+func isBcrypt(hash string) bool {
+	return len(hash) >= 4 && (hash[:4] == "$2a$" || hash[:4] == "$2b$" || hash[:4] == "$2x$" || hash[:4] == "$2y$")
 }
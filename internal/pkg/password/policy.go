@@ -0,0 +1,91 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultMinLength 默认最小长度
+const DefaultMinLength = 8
+
+// 内置的常见弱密码，比较时忽略大小写
+var commonPasswords = []string{
+	"12345678", "123456789", "1234567890", "87654321", "11111111", "00000000",
+	"88888888", "66666666", "123123123", "password", "password1", "password123",
+	"passw0rd", "p@ssw0rd", "qwerty123", "qwertyuiop", "1q2w3e4r", "1qaz2wsx",
+	"abc12345", "abcd1234", "a1234567", "admin123", "admin888", "administrator",
+	"iloveyou", "welcome1", "letmein1", "sunshine", "football", "baseball",
+	"woaini1314", "5201314520", "zxcvbnm1", "asdfghjkl",
+}
+
+// Policy 密码强度策略
+type Policy struct {
+	// 最小长度（字符数），为 0 时使用默认值
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// 额外禁止使用的密码，与内置的常见弱密码合并
+	DenyList []string
+}
+
+// Validate 校验密码是否满足策略，username 不为空时禁止密码与用户名相同
+func (p *Policy) Validate(password, username string) error {
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = DefaultMinLength
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		return fmt.Errorf("密码长度不能少于%d位", minLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		return errors.New("密码必须包含大写字母")
+	}
+	if p.RequireLower && !hasLower {
+		return errors.New("密码必须包含小写字母")
+	}
+	if p.RequireDigit && !hasDigit {
+		return errors.New("密码必须包含数字")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		return errors.New("密码必须包含特殊字符")
+	}
+
+	if username != "" && strings.EqualFold(password, username) {
+		return errors.New("密码不能与用户名相同")
+	}
+	if p.denied(password) {
+		return errors.New("密码过于常见，请更换")
+	}
+	return nil
+}
+
+func (p *Policy) denied(password string) bool {
+	lower := strings.ToLower(password)
+	for _, list := range [][]string{commonPasswords, p.DenyList} {
+		for _, w := range list {
+			if lower == strings.ToLower(w) {
+				return true
+			}
+		}
+	}
+	return false
+}
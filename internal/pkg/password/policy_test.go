@@ -0,0 +1,53 @@
+package password
+
+import (
+	"testing"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	policy := &Policy{
+		MinLength:    10,
+		RequireUpper: true,
+		RequireLower: true,
+		RequireDigit: true,
+		DenyList:     []string{"Welcome2Campus"},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		username string
+		wantErr  bool
+	}{
+		{name: "满足策略", password: "Correct1Horse", username: "zhangsan", wantErr: false},
+		{name: "长度不足", password: "Short1A", wantErr: true},
+		{name: "缺少大写字母", password: "lowercase123", wantErr: true},
+		{name: "缺少数字", password: "NoDigitsHere", wantErr: true},
+		{name: "与用户名相同", password: "ZhangSan2025", username: "zhangsan2025", wantErr: true},
+		{name: "自定义禁用列表", password: "Welcome2Campus", wantErr: true},
+		{name: "自定义禁用列表忽略大小写", password: "welcome2CAMPUS", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password, tt.username)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicy_Defaults(t *testing.T) {
+	policy := &Policy{}
+
+	if err := policy.Validate("abc123", ""); err == nil {
+		t.Error("默认最小长度应为 8")
+	}
+	if err := policy.Validate("Password123", ""); err == nil {
+		t.Error("常见弱密码应被拒绝")
+	}
+	if err := policy.Validate("correct horse", ""); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Package spreadsheet 读取 CSV 和 XLSX 表格，并以流的方式导出 CSV、JSON Lines 和 XLSX
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// 表格格式
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// ErrUnsupportedFormat 不支持的表格格式
+var ErrUnsupportedFormat = errors.New("spreadsheet: unsupported format, only csv and xlsx are supported")
+
+// Limits 读取时允许的最大行数和列数，为 0 时不限制
+// XLSX 按行号和单元格引用补齐中间的空行和空单元格，不限制时很小的文件也可能占用大量内存
+type Limits struct {
+	Rows    int
+	Columns int
+}
+
+func (l Limits) checkRow(n int) error {
+	if l.Rows > 0 && n > l.Rows {
+		return fmt.Errorf("spreadsheet: too many rows, at most %d", l.Rows)
+	}
+	return nil
+}
+
+func (l Limits) checkColumn(n int) error {
+	if l.Columns > 0 && n > l.Columns {
+		return fmt.Errorf("spreadsheet: too many columns, at most %d", l.Columns)
+	}
+	return nil
+}
+
+// FormatFromFilename 根据文件扩展名判断表格格式，无法识别时返回空字符串
+func FormatFromFilename(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return FormatCSV
+	case ".xlsx":
+		return FormatXLSX
+	}
+	return ""
+}
+
+// Read 按格式读取表格的所有行，XLSX 只读取第一个工作表
+func Read(format string, data []byte, limits Limits) ([][]string, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return ReadCSV(bytes.NewReader(data), limits)
+	case FormatXLSX:
+		return ReadXLSX(data, limits)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// ReadCSV 读取 CSV，忽略 UTF-8 BOM，允许每行的列数不同
+func ReadCSV(r io.Reader, limits Limits) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var rows [][]string
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("spreadsheet: invalid csv: %w", err)
+		}
+		if err := limits.checkRow(len(rows) + 1); err != nil {
+			return nil, err
+		}
+		if err := limits.checkColumn(len(row)); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,179 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// 生成只包含必要文件的 XLSX
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	workbook := `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="学生" sheetId="1" r:id="rId2"/></sheets></workbook>`
+	rels := `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId2" Target="worksheets/students.xml"/></Relationships>`
+	shared := `<sst><si><t>name</t></si><si><t>age</t></si><si><r><t>张</t></r><r><t>三</t></r></si></sst>`
+	sheet := `<worksheet><sheetData>` +
+		`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>` +
+		`<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3" t="inlineStr"><is><t>备注</t></is></c></row>` +
+		`<row r="4"><c r="B4"><v>18</v></c></row>` +
+		`</sheetData></worksheet>`
+
+	tests := []struct {
+		name    string
+		format  string
+		data    []byte
+		limits  Limits
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name:   "CSV 去掉 BOM",
+			format: FormatCSV,
+			data:   []byte("\xef\xbb\xbfname,age\n张三, 18\n李四\n"),
+			want:   [][]string{{"name", "age"}, {"张三", "18"}, {"李四"}},
+		},
+		{
+			name:   "XLSX 共享字符串、内联字符串和空单元格",
+			format: FormatXLSX,
+			data: buildXLSX(t, map[string]string{
+				"xl/workbook.xml":            workbook,
+				"xl/_rels/workbook.xml.rels": rels,
+				"xl/sharedStrings.xml":       shared,
+				"xl/worksheets/students.xml": sheet,
+			}),
+			want: [][]string{{"name", "age"}, nil, {"张三", "", "备注"}, {"", "18"}},
+		},
+		{name: "XLSX 不是 zip 文件", format: FormatXLSX, data: []byte("name,age"), wantErr: true},
+		{name: "不支持的格式", format: "xls", data: []byte("x"), wantErr: true},
+		{
+			name:   "XLSX 行号超过限制",
+			format: FormatXLSX,
+			data: buildXLSX(t, map[string]string{
+				"xl/workbook.xml":            workbook,
+				"xl/_rels/workbook.xml.rels": rels,
+				"xl/worksheets/students.xml": `<worksheet><sheetData><row r="2000000000"><c r="A2000000000" t="inlineStr"><is><t>x</t></is></c></row></sheetData></worksheet>`,
+			}),
+			limits:  Limits{Rows: 10, Columns: 10},
+			wantErr: true,
+		},
+		{
+			name:   "XLSX 列号超过限制",
+			format: FormatXLSX,
+			data: buildXLSX(t, map[string]string{
+				"xl/workbook.xml":            workbook,
+				"xl/_rels/workbook.xml.rels": rels,
+				"xl/worksheets/students.xml": `<worksheet><sheetData><row r="1"><c r="ZZZ1" t="inlineStr"><is><t>x</t></is></c></row></sheetData></worksheet>`,
+			}),
+			limits:  Limits{Rows: 10, Columns: 10},
+			wantErr: true,
+		},
+		{name: "CSV 行数超过限制", format: FormatCSV, data: []byte("a\nb\nc\n"), limits: Limits{Rows: 2}, wantErr: true},
+		{name: "CSV 列数超过限制", format: FormatCSV, data: []byte("a,b,c\n"), limits: Limits{Columns: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(tt.format, tt.data, tt.limits)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Read() 应返回错误")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Read() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	header := []string{"id", "name", "info"}
+	records := [][]any{{1, "张三", `<a & "b">`}, {uint(2), " 李四", nil}}
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{name: "CSV", format: FormatCSV},
+		{name: "XLSX", format: FormatXLSX},
+		{
+			name:   "JSON Lines",
+			format: FormatJSONL,
+			want:   "{\"id\":1,\"name\":\"张三\",\"info\":\"\\u003ca \\u0026 \\\"b\\\"\\u003e\"}\n{\"id\":2,\"name\":\" 李四\",\"info\":null}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(tt.format, &buf, header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, record := range records {
+				if err := w.Write(record); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if tt.want != "" {
+				if buf.String() != tt.want {
+					t.Errorf("got %q, want %q", buf.String(), tt.want)
+				}
+				return
+			}
+			// 导出的文件可以重新读取
+			got, err := Read(tt.format, buf.Bytes(), Limits{})
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			want := [][]string{header, {"1", "张三", `<a & "b">`}, {"2", " 李四"}}
+			if tt.format == FormatCSV {
+				want[2] = append(want[2], "")
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Read() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFormatFromAccept(t *testing.T) {
+	tests := map[string]string{
+		"text/csv":                  FormatCSV,
+		"application/x-ndjson; q=1": FormatJSONL,
+		"text/html, application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": FormatXLSX,
+		"*/*": "",
+	}
+	for accept, want := range tests {
+		if got := FormatFromAccept(accept); got != want {
+			t.Errorf("FormatFromAccept(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// FormatJSONL JSON Lines，每行一个 JSON 对象，只用于导出
+const FormatJSONL = "jsonl"
+
+// Writer 逐行写入表格，内存占用与行数无关；Close 写入文件结尾，不关闭底层的 io.Writer
+type Writer interface {
+	Write(record []any) error
+	Close() error
+}
+
+// NewWriter 创建写入器并写入表头，JSON Lines 使用表头作为字段名
+func NewWriter(format string, w io.Writer, header []string) (Writer, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		cw := &csvWriter{w: csv.NewWriter(w)}
+		if err := cw.w.Write(header); err != nil {
+			return nil, err
+		}
+		return cw, nil
+	case FormatJSONL:
+		return newJSONLWriter(w, header)
+	case FormatXLSX:
+		return newXLSXWriter(w, header)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// ContentType 格式对应的 MIME 类型
+func ContentType(format string) string {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/octet-stream"
+}
+
+// FormatFromAccept 根据 Accept 请求头选择导出格式，无法识别时返回空字符串
+func FormatFromAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "text/csv":
+			return FormatCSV
+		case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+			return FormatJSONL
+		case "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":
+			return FormatXLSX
+		}
+	}
+	return ""
+}
+
+// 单元格文本，nil 为空字符串
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// 数值类型在 XLSX 中写为数字
+func isNumber(v any) bool {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(record []any) error {
+	cells := make([]string, len(record))
+	for i, v := range record {
+		cells[i] = formatValue(v)
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonlWriter struct {
+	w *bufio.Writer
+	// 编码后的字段名
+	keys [][]byte
+}
+
+func newJSONLWriter(w io.Writer, header []string) (*jsonlWriter, error) {
+	jw := &jsonlWriter{w: bufio.NewWriter(w), keys: make([][]byte, len(header))}
+	for i, name := range header {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		jw.keys[i] = key
+	}
+	return jw, nil
+}
+
+func (j *jsonlWriter) Write(record []any) error {
+	if len(record) != len(j.keys) {
+		return fmt.Errorf("spreadsheet: record has %d fields, header has %d", len(record), len(j.keys))
+	}
+	j.w.WriteByte('{')
+	for i, v := range record {
+		if i > 0 {
+			j.w.WriteByte(',')
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		j.w.Write(j.keys[i])
+		j.w.WriteByte(':')
+		j.w.Write(value)
+	}
+	j.w.WriteByte('}')
+	return j.w.WriteByte('\n')
+}
+
+func (j *jsonlWriter) Close() error {
+	return j.w.Flush()
+}
+
+// XLSX 只包含一个工作表，单元格使用内联字符串，不需要在内存中保存共享字符串表
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+func newXLSXWriter(w io.Writer, header []string) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbookXML},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, part := range parts {
+		pw, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(pw, part.content); err != nil {
+			return nil, err
+		}
+	}
+	// 工作表必须最后创建，之后的写入都在这个文件中
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	xw := &xlsxWriter{zw: zw, sheet: bufio.NewWriter(sw)}
+	xw.sheet.WriteString(xlsxSheetStart)
+	record := make([]any, len(header))
+	for i, name := range header {
+		record[i] = name
+	}
+	if err := xw.Write(record); err != nil {
+		return nil, err
+	}
+	return xw, nil
+}
+
+// 列号对应的列名，0 为 A，27 为 AB
+func columnName(col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name
+}
+
+func (x *xlsxWriter) Write(record []any) error {
+	x.row++
+	row := strconv.Itoa(x.row)
+	x.sheet.WriteString(`<row r="` + row + `">`)
+	for i, v := range record {
+		if v == nil {
+			continue
+		}
+		ref := columnName(i) + row
+		if isNumber(v) {
+			x.sheet.WriteString(`<c r="` + ref + `"><v>` + formatValue(v) + `</v></c>`)
+			continue
+		}
+		x.sheet.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(x.sheet, []byte(formatValue(v))); err != nil {
+			return err
+		}
+		x.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := x.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	x.sheet.WriteString(xlsxSheetEnd)
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zw.Close()
+}
@@ -0,0 +1,210 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// 解压后单个文件的最大大小，防止压缩炸弹
+const maxPartSize = 64 << 20
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// 富文本的字符串由多段 r 组成
+type xlsxText struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.R) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.R {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX 读取 XLSX 第一个工作表的所有行，行号与工作表一致，中间的空行返回空切片
+func ReadXLSX(data []byte, limits Limits) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("spreadsheet: invalid xlsx: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+	var shared xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodePart(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("spreadsheet: invalid xlsx: missing %s", sheetPath)
+	}
+	var sheet xlsxSheet
+	if err := decodePart(f, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, row := range sheet.Rows {
+		index := row.Index
+		if index <= len(rows) {
+			index = len(rows) + 1
+		}
+		// 补齐空行之前检查行号
+		if err := limits.checkRow(index); err != nil {
+			return nil, err
+		}
+		for len(rows) < index-1 {
+			rows = append(rows, nil)
+		}
+		var cells []string
+		for _, c := range row.Cells {
+			col := len(cells)
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+			if err := limits.checkColumn(col + 1); err != nil {
+				return nil, err
+			}
+			for len(cells) < col {
+				cells = append(cells, "")
+			}
+			value := c.Value
+			switch c.Type {
+			case "s":
+				i, err := strconv.Atoi(c.Value)
+				if err != nil || i < 0 || i >= len(shared.Items) {
+					return nil, fmt.Errorf("spreadsheet: invalid xlsx: bad shared string index in %s", c.Ref)
+				}
+				value = shared.Items[i].String()
+			case "inlineStr":
+				value = c.Inline.String()
+			}
+			if col < len(cells) {
+				cells[col] = value
+			} else {
+				cells = append(cells, value)
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+// 通过 workbook.xml 和关系文件找到第一个工作表的路径
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	const fallback = "xl/worksheets/sheet1.xml"
+	wf, ok := files["xl/workbook.xml"]
+	if !ok {
+		return "", errors.New("spreadsheet: invalid xlsx: missing xl/workbook.xml")
+	}
+	var workbook xlsxWorkbook
+	if err := decodePart(wf, &workbook); err != nil {
+		return "", err
+	}
+	rf, ok := files["xl/_rels/workbook.xml.rels"]
+	if !ok || len(workbook.Sheets) == 0 {
+		return fallback, nil
+	}
+	var rels xlsxRelationships
+	if err := decodePart(rf, &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return fallback, nil
+}
+
+func decodePart(f *zip.File, v any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("spreadsheet: invalid xlsx: %w", err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, maxPartSize+1))
+	if err != nil {
+		return fmt.Errorf("spreadsheet: invalid xlsx: %w", err)
+	}
+	if len(data) > maxPartSize {
+		return fmt.Errorf("spreadsheet: %s is too large", f.Name)
+	}
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("spreadsheet: invalid xlsx %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// 单元格引用的列号，从 0 开始，如 A1 为 0，AB3 为 27
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A') + 1
+		n++
+	}
+	if n == 0 || n > 3 {
+		return 0, fmt.Errorf("spreadsheet: invalid xlsx: bad cell reference %q", ref)
+	}
+	return col - 1, nil
+}
@@ -0,0 +1,66 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量，取后 6 位
+func TestGenerateCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		name     string
+		unix     int64
+		expected string
+	}{
+		{name: "59", unix: 59, expected: "287082"},
+		{name: "1111111109", unix: 1111111109, expected: "081804"},
+		{name: "1111111111", unix: 1111111111, expected: "050471"},
+		{name: "1234567890", unix: 1234567890, expected: "005924"},
+		{name: "2000000000", unix: 2000000000, expected: "279037"},
+		{name: "20000000000", unix: 20000000000, expected: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := GenerateCode(secret, time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("GenerateCode failed: %v", err)
+			}
+			if code != tt.expected {
+				t.Errorf("GenerateCode = %v, want %v", code, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret failed: %v", err)
+	}
+	now := time.Date(2025, 7, 17, 18, 18, 43, 0, time.UTC)
+	code, _ := GenerateCode(secret, now)
+
+	// 当前时间步
+	if step, ok := Validate(secret, code, now, 1); !ok || step != Step(now) {
+		t.Errorf("Validate current step = %v, %v", step, ok)
+	}
+
+	// 允许一个时间步的时钟偏差
+	if _, ok := Validate(secret, code, now.Add(Period*time.Second), 1); !ok {
+		t.Error("code should be valid within skew")
+	}
+
+	// 超出偏差范围
+	if _, ok := Validate(secret, code, now.Add(2*Period*time.Second), 1); ok {
+		t.Error("code should be invalid outside skew")
+	}
+
+	// 格式错误
+	if _, ok := Validate(secret, "12345", now, 1); ok {
+		t.Error("short code should be invalid")
+	}
+}
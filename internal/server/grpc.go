@@ -1,6 +1,8 @@
 package server
 
 import (
+	attendanceV1 "student/api/attendance/v1"
+	courseV1 "student/api/course/v1"
 	v1 "student/api/student/v1"
 	userV1 "student/api/user/v1"
 	"student/internal/biz"
@@ -14,9 +16,32 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
+// 无需登录即可调用的 gRPC 方法，与 HTTP 的 publicPaths 对应
+var publicOperations = []string{
+	userV1.OperationUserLogin,
+	userV1.OperationUserVerifyMFA,
+	userV1.OperationUserRegister,
+	userV1.OperationUserRefreshToken,
+	userV1.OperationUserRequestPasswordReset,
+	userV1.OperationUserResetPassword,
+	userV1.OperationUserVerifyEmail,
+	userV1.OperationUserStartExternalLogin,
+	userV1.OperationUserExternalLoginCallback,
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, user *service.UserService, rbacUC *biz.RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, user *service.UserService, course *service.CourseService, attendance *service.AttendanceService, rbacUC *biz.RBACUsecase, userUC *biz.UserUsecase, apiKeyUC *biz.APIKeyUsecase, impersonationUC *biz.ImpersonationUsecase, jwtUtil *jwt.JWTUtil, proxies middleware.TrustedProxies, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{}
+
+	// JWT认证中间件，服务从 ctx 中读取 user_id
+	jwtAuth := middleware.JWTAuth(&middleware.JWTConfig{
+		JWTUtil:    jwtUtil,
+		Revocation: userUC,
+		APIKeys:    apiKeyUC,
+		Sessions:   userUC,
+		Audit:      impersonationUC,
+		SkipPaths:  publicOperations,
+	})
 	
 	// 如果启用了 RBAC，添加 RBAC 中间件
 	if c.Rbac != nil && c.Rbac.Enabled {
@@ -24,6 +49,8 @@ func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, user *ser
 		rbacConfig := &middleware.RBACConfig{
 			RBACUC: rbacUC,
 			JWTUtil: jwtUtil,
+			Revocation: userUC,
+			APIKeys: apiKeyUC,
 			SkipPaths: []string{
 				// 可以在这里添加不需要权限检查的 gRPC 方法路径
 				// 例如："/student.v1.Student/GetStudent",
@@ -33,13 +60,29 @@ func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, user *ser
 		// 添加 RBAC 中间件到 gRPC 中间件链
 		opts = append(opts, grpc.Middleware(
 			recovery.Recovery(),
+			middleware.ClientIP(proxies),
+			jwtAuth,
 			middleware.RBACMiddleware(rbacConfig),
 		))
+		// 导出等流式方法同样需要认证和权限检查
+		opts = append(opts, grpc.StreamInterceptor(middleware.StreamServerInterceptor(
+			recovery.Recovery(),
+			middleware.ClientIP(proxies),
+			jwtAuth,
+			middleware.RBACMiddleware(rbacConfig),
+		)))
 	} else {
-		// 如果没有启用 RBAC，只使用 recovery 中间件
+		// 如果没有启用 RBAC，只使用 recovery 和 JWT 认证中间件
 		opts = append(opts, grpc.Middleware(
 			recovery.Recovery(),
+			middleware.ClientIP(proxies),
+			jwtAuth,
 		))
+		opts = append(opts, grpc.StreamInterceptor(middleware.StreamServerInterceptor(
+			recovery.Recovery(),
+			middleware.ClientIP(proxies),
+			jwtAuth,
+		)))
 	}
 	
 	if c.Server.Grpc.Network != "" {
@@ -54,5 +97,7 @@ func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, user *ser
 	srv := grpc.NewServer(opts...)
 	v1.RegisterStudentServer(srv, student)
 	userV1.RegisterUserServer(srv, user)
+	courseV1.RegisterCourseServiceServer(srv, course)
+	attendanceV1.RegisterAttendanceServiceServer(srv, attendance)
 	return srv
 }
@@ -2,6 +2,9 @@ package server
 
 import (
 	stdhttp "net/http"
+	"slices"
+	attendanceV1 "student/api/attendance/v1"
+	courseV1 "student/api/course/v1"
 	errorsV1 "student/api/errors/v1"
 	rbacV1 "student/api/rbac/v1"
 	v1 "student/api/student/v1"
@@ -17,21 +20,47 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
+// 无需登录即可访问的接口
+var publicPaths = []string{
+	"/v1/user/login",
+	"/v1/user/login/mfa",
+	"/v1/user/register",
+	"/v1/user/refresh",
+	"/v1/user/password/forgot",
+	"/v1/user/password/reset",
+	"/v1/user/email/verify",
+	"/v1/user/oauth/*",
+	"/v1/errors",
+}
+
+// 只操作当前登录用户自身数据的接口，登录后即可访问，不做 RBAC 权限检查
+var accountPaths = []string{
+	"/v1/account/*",
+}
+
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, user *service.UserService, rbac *service.RBACService, errorService *service.ErrorService, rbacUC *biz.RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, user *service.UserService, rbac *service.RBACService, errorService *service.ErrorService, oidc *service.OIDCService, course *service.CourseService, attendance *service.AttendanceService, rbacUC *biz.RBACUsecase, userUC *biz.UserUsecase, apiKeyUC *biz.APIKeyUsecase, impersonationUC *biz.ImpersonationUsecase, jwtUtil *jwt.JWTUtil, proxies middleware.TrustedProxies, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			// 解析客户端IP，登录限流、会话和审计日志使用
+			middleware.ClientIP(proxies),
 			// JWT认证中间件
 			middleware.JWTAuth(&middleware.JWTConfig{
-				JWTUtil:   jwtUtil,
-				SkipPaths: []string{"/v1/user/login", "/v1/user/register", "/v1/errors"},
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
+				Sessions:   userUC,
+				Audit:      impersonationUC,
+				SkipPaths:  publicPaths,
 			}),
 			// RBAC权限中间件
 			middleware.RBACMiddleware(&middleware.RBACConfig{
-				RBACUC:    rbacUC,
-				JWTUtil:   jwtUtil,
-				SkipPaths: []string{"/v1/user/login", "/v1/user/register", "/v1/errors"},
+				RBACUC:     rbacUC,
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
+				SkipPaths:  slices.Concat(publicPaths, accountPaths),
 			}),
 		),
 	}
@@ -51,6 +80,23 @@ func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, user *ser
 	userV1.RegisterUserHTTPServer(srv, user)
 	rbacV1.RegisterRBACServiceHTTPServer(srv, rbac)
 	errorsV1.RegisterErrorServiceHTTPServer(srv, errorService)
+	courseV1.RegisterCourseServiceHTTPServer(srv, course)
+	attendanceV1.RegisterAttendanceServiceHTTPServer(srv, attendance)
+
+	// multipart 上传导入学生，与 ImportStudents 共用中间件和权限
+	srv.Route("/").POST("/v1/students/import/upload", student.UploadStudents)
+	// 分块下载导出的学生和用户
+	srv.Route("/").GET("/v1/students/export", student.DownloadStudents)
+	srv.Route("/").GET("/v1/users/export", user.DownloadUsers)
+
+	// 公钥集合，供其他服务和网关验证token
+	srv.HandleFunc("/.well-known/jwks.json", jwtUtil.JWKSHandler())
+
+	// OpenID Connect 端点，供内部应用单点登录
+	srv.HandleFunc("/.well-known/openid-configuration", oidc.Discovery)
+	srv.HandleFunc("/oauth2/authorize", oidc.Authorize)
+	srv.HandleFunc("/oauth2/token", oidc.Token)
+	srv.HandleFunc("/oauth2/userinfo", oidc.UserInfo)
 
 	// 添加健康检查端点
 	srv.HandleFunc("/health", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
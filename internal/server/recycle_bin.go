@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"student/internal/biz"
+	"student/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 没有配置清理间隔时每小时清理一次
+const defaultPurgeInterval = time.Hour
+
+// RecycleBinPurger 定时彻底删除回收站中超过保留期限的数据，随应用启动和停止
+type RecycleBinPurger struct {
+	recycle  *biz.RecycleBinUsecase
+	interval time.Duration
+	log      *log.Helper
+	// Start 和 Stop 在不同的 goroutine 中调用，在创建时准备好
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRecycleBinPurger(c *conf.RecycleBin, recycle *biz.RecycleBinUsecase, logger log.Logger) *RecycleBinPurger {
+	interval := defaultPurgeInterval
+	if c != nil && c.PurgeInterval != nil && c.PurgeInterval.AsDuration() > 0 {
+		interval = c.PurgeInterval.AsDuration()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RecycleBinPurger{
+		recycle:  recycle,
+		interval: interval,
+		log:      log.NewHelper(logger),
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 实现 transport.Server，每隔 interval 清理一次，直到 Stop
+func (p *RecycleBinPurger) Start(context.Context) error {
+	defer close(p.done)
+	ctx := p.ctx
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			n, err := p.recycle.PurgeExpired(ctx)
+			if err != nil {
+				p.log.Errorf("purge recycle bin failed: %v", err)
+			}
+			if n > 0 {
+				p.log.Infof("purged %d expired records from recycle bin", n)
+			}
+		}
+	}
+}
+
+// Stop 实现 transport.Server，等待正在进行的清理结束
+func (p *RecycleBinPurger) Stop(ctx context.Context) error {
+	p.cancel()
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
@@ -1,11 +1,19 @@
 package server
 
 import (
+	"student/internal/conf"
+	"student/internal/pkg/middleware"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRecycleBinPurger, NewTrustedProxies)
+
+// NewTrustedProxies 读取受信任的反向代理，没有配置时不信任任何代理转发的客户端IP
+func NewTrustedProxies(c *conf.Bootstrap) (middleware.TrustedProxies, error) {
+	return middleware.ParseTrustedProxies(c.Server.GetTrustedProxies())
+}
 
 // GatewayProviderSet is gateway server providers.
 var GatewayProviderSet = wire.NewSet(NewGatewayHTTPServer)
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+
+	v1 "student/api/attendance/v1"
+	"student/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type AttendanceService struct {
+	v1.UnimplementedAttendanceServiceServer
+
+	attendance *biz.AttendanceUsecase
+	log        *log.Helper
+}
+
+func NewAttendanceService(attendance *biz.AttendanceUsecase, logger log.Logger) *AttendanceService {
+	return &AttendanceService{
+		attendance: attendance,
+		log:        log.NewHelper(logger),
+	}
+}
+
+func (s *AttendanceService) SubmitRollCall(ctx context.Context, req *v1.SubmitRollCallRequest) (*v1.SubmitRollCallReply, error) {
+	teacherID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "未找到用户信息")
+	}
+	date, err := biz.ParseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*biz.AttendanceRecord, 0, len(req.Records))
+	for _, r := range req.Records {
+		records = append(records, &biz.AttendanceRecord{
+			StudentID: uint(r.StudentId),
+			Status:    int(r.Status),
+			Note:      r.Note,
+		})
+	}
+	session, err := s.attendance.SubmitRollCall(ctx, &biz.RollCallForm{
+		Date:       date,
+		GroupLabel: req.GroupLabel,
+		TeacherID:  teacherID,
+		Records:    records,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SubmitRollCallReply{Session: toAttendanceSessionProto(session)}, nil
+}
+
+func (s *AttendanceService) GetAttendanceSession(ctx context.Context, req *v1.GetAttendanceSessionRequest) (*v1.GetAttendanceSessionReply, error) {
+	viewerID, _ := ctx.Value("user_id").(uint)
+	session, err := s.attendance.GetSession(ctx, viewerID, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetAttendanceSessionReply{Session: toAttendanceSessionProto(session)}, nil
+}
+
+func (s *AttendanceService) ListAttendanceSessions(ctx context.Context, req *v1.ListAttendanceSessionsRequest) (*v1.ListAttendanceSessionsReply, error) {
+	viewerID, _ := ctx.Value("user_id").(uint)
+	filter, err := toAttendanceFilter(req.From, req.To, req.GroupLabel)
+	if err != nil {
+		return nil, err
+	}
+	sessions, total, err := s.attendance.ListSessions(ctx, viewerID, req.Page, req.PageSize, filter)
+	if err != nil {
+		return nil, err
+	}
+	sessionProtos := make([]*v1.AttendanceSession, 0, len(sessions))
+	for _, session := range sessions {
+		sessionProtos = append(sessionProtos, toAttendanceSessionProto(session))
+	}
+	return &v1.ListAttendanceSessionsReply{
+		Sessions: sessionProtos,
+		Total:    total,
+	}, nil
+}
+
+func (s *AttendanceService) ListAbsenceRates(ctx context.Context, req *v1.ListAbsenceRatesRequest) (*v1.ListAbsenceRatesReply, error) {
+	viewerID, _ := ctx.Value("user_id").(uint)
+	filter, err := toAttendanceFilter(req.From, req.To, req.GroupLabel)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.attendance.ListAbsenceRates(ctx, viewerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	rateProtos := make([]*v1.AbsenceRate, 0, len(rates))
+	for _, r := range rates {
+		rateProtos = append(rateProtos, &v1.AbsenceRate{
+			StudentId:   uint32(r.StudentID),
+			StudentName: r.StudentName,
+			Total:       int32(r.Total),
+			Absent:      int32(r.Absent),
+			Late:        int32(r.Late),
+			Excused:     int32(r.Excused),
+			AbsenceRate: r.Rate(),
+		})
+	}
+	return &v1.ListAbsenceRatesReply{Rates: rateProtos}, nil
+}
+
+func toAttendanceFilter(from, to, groupLabel string) (*biz.AttendanceFilter, error) {
+	fromDate, err := biz.ParseDate(from)
+	if err != nil {
+		return nil, err
+	}
+	toDate, err := biz.ParseDate(to)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.AttendanceFilter{From: fromDate, To: toDate, GroupLabel: groupLabel}, nil
+}
+
+func toAttendanceSessionProto(s *biz.AttendanceSession) *v1.AttendanceSession {
+	session := &v1.AttendanceSession{
+		Id:         uint32(s.ID),
+		Date:       s.Date.Format(biz.DateFormat),
+		GroupLabel: s.GroupLabel,
+		TeacherId:  uint32(s.TeacherID),
+		CreatedAt:  s.CreatedAtStr,
+		UpdatedAt:  s.UpdatedAtStr,
+	}
+	for _, r := range s.Records {
+		session.Records = append(session.Records, &v1.AttendanceRecord{
+			StudentId: uint32(r.StudentID),
+			Status:    int32(r.Status),
+			Note:      r.Note,
+		})
+	}
+	return session
+}
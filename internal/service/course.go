@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+
+	v1 "student/api/course/v1"
+	"student/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type CourseService struct {
+	v1.UnimplementedCourseServiceServer
+
+	course *biz.CourseUsecase
+	terms  *biz.AcademicTermUsecase
+	log    *log.Helper
+}
+
+func NewCourseService(course *biz.CourseUsecase, terms *biz.AcademicTermUsecase, logger log.Logger) *CourseService {
+	return &CourseService{
+		course: course,
+		terms:  terms,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// 学期相关服务方法
+func (s *CourseService) GetAcademicTerm(ctx context.Context, req *v1.GetAcademicTermRequest) (*v1.GetAcademicTermReply, error) {
+	term, err := s.terms.GetTerm(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetAcademicTermReply{Term: toAcademicTermProto(term)}, nil
+}
+
+func (s *CourseService) CreateAcademicTerm(ctx context.Context, req *v1.CreateAcademicTermRequest) (*v1.CreateAcademicTermReply, error) {
+	form, err := toAcademicTermForm(req.Code, req.Name, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	term, err := s.terms.CreateTerm(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateAcademicTermReply{Term: toAcademicTermProto(term)}, nil
+}
+
+func (s *CourseService) UpdateAcademicTerm(ctx context.Context, req *v1.UpdateAcademicTermRequest) (*v1.UpdateAcademicTermReply, error) {
+	form, err := toAcademicTermForm(req.Code, req.Name, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	term, err := s.terms.UpdateTerm(ctx, uint(req.Id), form)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateAcademicTermReply{Term: toAcademicTermProto(term)}, nil
+}
+
+func (s *CourseService) ListAcademicTerms(ctx context.Context, req *v1.ListAcademicTermsRequest) (*v1.ListAcademicTermsReply, error) {
+	terms, total, err := s.terms.ListTerms(ctx, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	termProtos := make([]*v1.AcademicTerm, 0, len(terms))
+	for _, term := range terms {
+		termProtos = append(termProtos, toAcademicTermProto(term))
+	}
+	return &v1.ListAcademicTermsReply{
+		Terms: termProtos,
+		Total: total,
+	}, nil
+}
+
+// 课程相关服务方法
+func (s *CourseService) GetCourse(ctx context.Context, req *v1.GetCourseRequest) (*v1.GetCourseReply, error) {
+	course, err := s.course.GetCourse(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetCourseReply{Course: toCourseProto(course)}, nil
+}
+
+func (s *CourseService) CreateCourse(ctx context.Context, req *v1.CreateCourseRequest) (*v1.CreateCourseReply, error) {
+	course, err := s.course.CreateCourse(ctx, &biz.CourseForm{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		Credits:     req.Credits,
+		Status:      int(req.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateCourseReply{Course: toCourseProto(course)}, nil
+}
+
+func (s *CourseService) UpdateCourse(ctx context.Context, req *v1.UpdateCourseRequest) (*v1.UpdateCourseReply, error) {
+	course, err := s.course.UpdateCourse(ctx, uint(req.Id), &biz.CourseForm{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		Credits:     req.Credits,
+		Status:      int(req.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateCourseReply{Course: toCourseProto(course)}, nil
+}
+
+func (s *CourseService) DeleteCourse(ctx context.Context, req *v1.DeleteCourseRequest) (*v1.DeleteCourseReply, error) {
+	if err := s.course.DeleteCourse(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCourseReply{
+		Message: "课程删除成功",
+	}, nil
+}
+
+func (s *CourseService) ListCourses(ctx context.Context, req *v1.ListCoursesRequest) (*v1.ListCoursesReply, error) {
+	courses, total, err := s.course.ListCourses(ctx, req.Page, req.PageSize, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	courseProtos := make([]*v1.Course, 0, len(courses))
+	for _, course := range courses {
+		courseProtos = append(courseProtos, toCourseProto(course))
+	}
+	return &v1.ListCoursesReply{
+		Courses: courseProtos,
+		Total:   total,
+	}, nil
+}
+
+// 班级相关服务方法
+func (s *CourseService) GetClass(ctx context.Context, req *v1.GetClassRequest) (*v1.GetClassReply, error) {
+	class, err := s.course.GetClass(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetClassReply{Class: toClassProto(class)}, nil
+}
+
+func (s *CourseService) CreateClass(ctx context.Context, req *v1.CreateClassRequest) (*v1.CreateClassReply, error) {
+	class, err := s.course.CreateClass(ctx, &biz.ClassForm{
+		CourseID: uint(req.CourseId),
+		Term:     req.Term,
+		Teacher:  req.Teacher,
+		Capacity: int(req.Capacity),
+		Status:   int(req.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateClassReply{Class: toClassProto(class)}, nil
+}
+
+func (s *CourseService) UpdateClass(ctx context.Context, req *v1.UpdateClassRequest) (*v1.UpdateClassReply, error) {
+	class, err := s.course.UpdateClass(ctx, uint(req.Id), &biz.ClassForm{
+		Term:     req.Term,
+		Teacher:  req.Teacher,
+		Capacity: int(req.Capacity),
+		Status:   int(req.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateClassReply{Class: toClassProto(class)}, nil
+}
+
+func (s *CourseService) DeleteClass(ctx context.Context, req *v1.DeleteClassRequest) (*v1.DeleteClassReply, error) {
+	if err := s.course.DeleteClass(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteClassReply{
+		Message: "班级删除成功",
+	}, nil
+}
+
+func (s *CourseService) ListClasses(ctx context.Context, req *v1.ListClassesRequest) (*v1.ListClassesReply, error) {
+	classes, total, err := s.course.ListClasses(ctx, req.Page, req.PageSize, uint(req.CourseId), req.Term)
+	if err != nil {
+		return nil, err
+	}
+	classProtos := make([]*v1.Class, 0, len(classes))
+	for _, class := range classes {
+		classProtos = append(classProtos, toClassProto(class))
+	}
+	return &v1.ListClassesReply{
+		Classes: classProtos,
+		Total:   total,
+	}, nil
+}
+
+// 选课相关服务方法
+func (s *CourseService) EnrollStudent(ctx context.Context, req *v1.EnrollStudentRequest) (*v1.EnrollStudentReply, error) {
+	enrollment, err := s.course.EnrollStudent(ctx, uint(req.ClassId), uint(req.StudentId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EnrollStudentReply{Enrollment: toEnrollmentProto(enrollment)}, nil
+}
+
+func (s *CourseService) DropStudent(ctx context.Context, req *v1.DropStudentRequest) (*v1.DropStudentReply, error) {
+	enrollment, err := s.course.DropStudent(ctx, uint(req.ClassId), uint(req.StudentId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DropStudentReply{Enrollment: toEnrollmentProto(enrollment)}, nil
+}
+
+func (s *CourseService) ListEnrollments(ctx context.Context, req *v1.ListEnrollmentsRequest) (*v1.ListEnrollmentsReply, error) {
+	enrollments, err := s.course.ListEnrollments(ctx, uint(req.ClassId))
+	if err != nil {
+		return nil, err
+	}
+	enrollmentProtos := make([]*v1.Enrollment, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		enrollmentProtos = append(enrollmentProtos, toEnrollmentProto(enrollment))
+	}
+	return &v1.ListEnrollmentsReply{Enrollments: enrollmentProtos}, nil
+}
+
+func toAcademicTermForm(code, name, startDate, endDate string) (*biz.AcademicTermForm, error) {
+	start, err := biz.ParseDate(startDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := biz.ParseDate(endDate)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.AcademicTermForm{Code: code, Name: name, StartDate: start, EndDate: end}, nil
+}
+
+func toAcademicTermProto(t *biz.AcademicTerm) *v1.AcademicTerm {
+	return &v1.AcademicTerm{
+		Id:        uint32(t.ID),
+		Code:      t.Code,
+		Name:      t.Name,
+		StartDate: t.StartDate.Format(biz.DateFormat),
+		EndDate:   t.EndDate.Format(biz.DateFormat),
+		CreatedAt: t.CreatedAtStr,
+		UpdatedAt: t.UpdatedAtStr,
+	}
+}
+
+func toCourseProto(c *biz.Course) *v1.Course {
+	return &v1.Course{
+		Id:          uint32(c.ID),
+		Code:        c.Code,
+		Name:        c.Name,
+		Description: c.Description,
+		Credits:     c.Credits,
+		Status:      int32(c.Status),
+		CreatedAt:   c.CreatedAtStr,
+		UpdatedAt:   c.UpdatedAtStr,
+	}
+}
+
+func toClassProto(c *biz.Class) *v1.Class {
+	return &v1.Class{
+		Id:        uint32(c.ID),
+		CourseId:  uint32(c.CourseID),
+		Term:      c.Term,
+		Teacher:   c.Teacher,
+		Capacity:  int32(c.Capacity),
+		Enrolled:  int32(c.Enrolled),
+		Status:    int32(c.Status),
+		CreatedAt: c.CreatedAtStr,
+		UpdatedAt: c.UpdatedAtStr,
+	}
+}
+
+func toEnrollmentProto(e *biz.Enrollment) *v1.Enrollment {
+	return &v1.Enrollment{
+		Id:         uint32(e.ID),
+		ClassId:    uint32(e.ClassID),
+		StudentId:  uint32(e.StudentID),
+		Status:     int32(e.Status),
+		EnrolledAt: formatTime(e.EnrolledAt),
+		DroppedAt:  formatTime(e.DroppedAt),
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"io"
+	stdhttp "net/http"
+	"strings"
+
+	"student/internal/pkg/spreadsheet"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// 导出时每个分块的大小，gRPC 按分块发送，HTTP 按分块写入响应
+const exportChunkSize = 32 << 10
+
+// 导出格式，format 为空时根据 Accept 请求头选择，默认为 CSV
+func exportFormat(format, accept string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = spreadsheet.FormatFromAccept(accept)
+	}
+	switch format {
+	case "":
+		return spreadsheet.FormatCSV, nil
+	case spreadsheet.FormatCSV, spreadsheet.FormatJSONL, spreadsheet.FormatXLSX:
+		return format, nil
+	}
+	return "", errors.BadRequest("INVALID_ARGUMENT", "导出格式只能是 csv、jsonl 或 xlsx")
+}
+
+// 把表格写入 w，each 按顺序把每一行交给 write
+func writeExport(w io.Writer, format string, header []string, each func(write func([]any) error) error) error {
+	buf := bufio.NewWriterSize(w, exportChunkSize)
+	sw, err := spreadsheet.NewWriter(format, buf, header)
+	if err != nil {
+		return err
+	}
+	if err := each(sw.Write); err != nil {
+		return err
+	}
+	if err := sw.Close(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+// 每次写入作为一个分块发送，用于 gRPC 流
+type chunkSender func(data []byte) error
+
+func (f chunkSender) Write(p []byte) (int, error) {
+	// 发送后底层缓冲会被复用，需要复制
+	if err := f(append([]byte(nil), p...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// 第一次写入时才设置响应头，写入之前出错时仍然可以返回正常的错误响应
+type downloadWriter struct {
+	w           stdhttp.ResponseWriter
+	contentType string
+	filename    string
+	written     int64
+}
+
+func (d *downloadWriter) Write(p []byte) (int, error) {
+	if d.written == 0 {
+		d.w.Header().Set("Content-Type", d.contentType)
+		d.w.Header().Set("Content-Disposition", `attachment; filename="`+d.filename+`"`)
+	}
+	n, err := d.w.Write(p)
+	d.written += int64(n)
+	return n, err
+}
+
+// 通过 HTTP 分块下载导出文件，operation 与对应的 gRPC 方法相同，经过相同的中间件
+// 导出时间可能超过服务器的请求超时，查询不使用请求的超时时间；客户端断开时写入失败，导出随之结束
+func streamDownload(ctx http.Context, logger *log.Helper, operation string, req any, name, format string, export func(ctx context.Context, w io.Writer) error) error {
+	http.SetOperation(ctx, operation)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		w := &downloadWriter{w: ctx.Response(), contentType: spreadsheet.ContentType(format), filename: name + "." + format}
+		err := export(context.WithoutCancel(c), w)
+		if err != nil && w.written > 0 {
+			// 已经开始发送文件，无法再返回错误响应，客户端会收到不完整的文件
+			logger.Errorf("export %s failed after %d bytes: %v", w.filename, w.written, err)
+			return nil, nil
+		}
+		return nil, err
+	})
+	_, err := h(ctx, req)
+	return err
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+
+	pb "student/api/student/v1"
+	"student/internal/biz"
+)
+
+// 关联了监护人的账号只能查看自己监护的学生，studentID 为 0 时不允许
+// RBAC 中间件只在请求带有 X-Request-Path 时检查路由权限，不能依赖它限制监护人
+func (s *StudentService) checkStudentAccess(ctx context.Context, studentID uint) error {
+	userID, _ := ctx.Value("user_id").(uint)
+	return s.guardian.CheckStudentAccess(ctx, userID, studentID)
+}
+
+func (s *StudentService) ListStudentGuardians(ctx context.Context, req *pb.ListStudentGuardiansRequest) (*pb.ListStudentGuardiansReply, error) {
+	if err := s.checkStudentAccess(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	links, err := s.guardian.ListStudentGuardians(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	guardians := make([]*pb.StudentGuardian, 0, len(links))
+	for _, link := range links {
+		guardians = append(guardians, toStudentGuardianProto(link))
+	}
+	return &pb.ListStudentGuardiansReply{Guardians: guardians}, nil
+}
+
+func (s *StudentService) AddStudentGuardian(ctx context.Context, req *pb.AddStudentGuardianRequest) (*pb.AddStudentGuardianReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	s.log.Info("add student guardian", req.Id, req.GuardianId)
+	link, err := s.guardian.AddStudentGuardian(ctx, req.Id, &biz.StudentGuardianForm{
+		GuardianID:   uint(req.GuardianId),
+		Guardian:     biz.GuardianForm{Name: req.Name, Phone: req.Phone, Email: req.Email},
+		Relationship: req.Relationship,
+		IsPrimary:    req.IsPrimary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddStudentGuardianReply{Guardian: toStudentGuardianProto(link)}, nil
+}
+
+func (s *StudentService) UpdateStudentGuardian(ctx context.Context, req *pb.UpdateStudentGuardianRequest) (*pb.UpdateStudentGuardianReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	link, err := s.guardian.UpdateStudentGuardian(ctx, req.Id, uint(req.GuardianId), &biz.StudentGuardianForm{
+		Guardian:     biz.GuardianForm{Name: req.Name, Phone: req.Phone, Email: req.Email},
+		Relationship: req.Relationship,
+		IsPrimary:    req.IsPrimary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateStudentGuardianReply{Guardian: toStudentGuardianProto(link)}, nil
+}
+
+func (s *StudentService) RemoveStudentGuardian(ctx context.Context, req *pb.RemoveStudentGuardianRequest) (*pb.RemoveStudentGuardianReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	s.log.Info("remove student guardian", req.Id, req.GuardianId)
+	if err := s.guardian.RemoveStudentGuardian(ctx, req.Id, uint(req.GuardianId)); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveStudentGuardianReply{Message: "remove student guardian success"}, nil
+}
+
+func (s *StudentService) LinkGuardianUser(ctx context.Context, req *pb.LinkGuardianUserRequest) (*pb.LinkGuardianUserReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	guardian, err := s.guardian.LinkUser(ctx, uint(req.Id), uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LinkGuardianUserReply{Guardian: toGuardianProto(guardian)}, nil
+}
+
+func (s *StudentService) ListMyChildren(ctx context.Context, req *pb.ListMyChildrenRequest) (*pb.ListMyChildrenReply, error) {
+	userID, _ := ctx.Value("user_id").(uint)
+	students, err := s.guardian.ListChildren(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.Students, 0, len(students))
+	for _, stu := range students {
+		data = append(data, &pb.Students{
+			Id:        int32(stu.ID),
+			Name:      stu.Name,
+			Info:      stu.Info,
+			Status:    int32(stu.Status),
+			Age:       int32(stu.Age),
+			CreatedAt: stu.CreatedAtStr,
+			UpdatedAt: stu.UpdatedAtStr,
+		})
+	}
+	return &pb.ListMyChildrenReply{Students: data}, nil
+}
+
+func toGuardianProto(g *biz.Guardian) *pb.Guardian {
+	guardian := &pb.Guardian{
+		Id:        uint32(g.ID),
+		Name:      g.Name,
+		Phone:     g.Phone,
+		Email:     g.Email,
+		CreatedAt: g.CreatedAtStr,
+		UpdatedAt: g.UpdatedAtStr,
+	}
+	if g.UserID != nil {
+		guardian.UserId = uint32(*g.UserID)
+	}
+	return guardian
+}
+
+func toStudentGuardianProto(link *biz.StudentGuardian) *pb.StudentGuardian {
+	guardian := &pb.StudentGuardian{
+		Relationship: link.Relationship,
+		IsPrimary:    link.IsPrimary,
+	}
+	if link.Guardian != nil {
+		guardian.Guardian = toGuardianProto(link.Guardian)
+	}
+	return guardian
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	stdhttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"student/internal/biz"
+	"student/internal/pkg/middleware"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 授权页面，只包含登录所需的最少内容
+var authorizeTemplate = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>登录</title>
+</head>
+<body>
+<h1>登录</h1>
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<form method="post" action="{{.Action}}">
+{{range $name, $value := .Params}}<input type="hidden" name="{{$name}}" value="{{$value}}">
+{{end}}<p><label>用户名 <input name="username" value="{{.Username}}" autocomplete="username" required></label></p>
+<p><label>密码 <input name="password" type="password" autocomplete="current-password" required></label></p>
+{{if .MFARequired}}<p><label>两步验证码 <input name="mfa_code" autocomplete="one-time-code" required></label></p>
+{{end}}<p><button type="submit">登录</button></p>
+</form>
+</body>
+</html>
+`))
+
+// 授权页面的数据
+type authorizePage struct {
+	Action      string
+	Params      map[string]string
+	Username    string
+	Error       string
+	MFARequired bool
+}
+
+// OIDCService 提供 OpenID Connect 协议端点，端点按协议格式收发数据，不经过 protobuf 编解码
+type OIDCService struct {
+	oidc *biz.OIDCUsecase
+	log  *log.Helper
+}
+
+func NewOIDCService(oidc *biz.OIDCUsecase, logger log.Logger) *OIDCService {
+	return &OIDCService{
+		oidc: oidc,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GET /.well-known/openid-configuration
+func (s *OIDCService) Discovery(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	issuer := s.oidc.Issuer()
+	writeJSON(w, stdhttp.StatusOK, map[string]any{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth2/authorize",
+		"token_endpoint":                        issuer + "/oauth2/token",
+		"userinfo_endpoint":                     issuer + "/oauth2/userinfo",
+		"jwks_uri":                              issuer + "/.well-known/jwks.json",
+		"response_types_supported":              []string{biz.OAuthResponseTypeCode},
+		"grant_types_supported":                 []string{biz.OAuthGrantAuthorizationCode, biz.OAuthGrantRefreshToken},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{s.oidc.SigningAlgorithm()},
+		"scopes_supported":                      biz.OAuthScopes,
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{biz.OAuthCodeChallengeMethodS256},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "preferred_username", "email", "email_verified"},
+	})
+}
+
+// GET /oauth2/authorize 展示登录页面，POST 提交账号密码后重定向回客户端
+func (s *OIDCService) Authorize(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if r.Method != stdhttp.MethodGet && r.Method != stdhttp.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		stdhttp.Error(w, "invalid request", stdhttp.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	req := &biz.AuthorizationRequest{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		Nonce:               r.Form.Get("nonce"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+	}
+	redirectURI, err := s.oidc.ValidateAuthorization(ctx, req)
+	if err != nil {
+		// 客户端或回调地址无效时不能重定向，直接展示错误
+		if redirectURI == "" {
+			stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+			return
+		}
+		redirectWithParams(w, r, redirectURI, oauthErrorParams(err, req.State))
+		return
+	}
+
+	page := &authorizePage{
+		Action: r.URL.Path,
+		Params: authorizeParams(req),
+	}
+	if r.Method == stdhttp.MethodGet {
+		renderAuthorizePage(w, stdhttp.StatusOK, page)
+		return
+	}
+
+	page.Username = r.PostForm.Get("username")
+	user, failed, err := s.oidc.Authenticate(ctx, &biz.LoginForm{
+		Username:  page.Username,
+		Password:  r.PostForm.Get("password"),
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: middleware.GetUserAgent(ctx),
+	}, r.PostForm.Get("mfa_code"))
+	if err != nil {
+		// 账户锁定、限流等错误直接提示用户
+		e := kerrors.FromError(err)
+		page.Error = e.Message
+		renderAuthorizePage(w, int(e.Code), page)
+		return
+	}
+	if failed != nil {
+		page.Error = failed.Message
+		page.MFARequired = failed.MFARequired
+		renderAuthorizePage(w, stdhttp.StatusUnauthorized, page)
+		return
+	}
+
+	code, err := s.oidc.IssueCode(ctx, req, user)
+	if err != nil {
+		s.log.Error("签发授权码失败", err)
+		redirectWithParams(w, r, redirectURI, oauthErrorParams(&biz.OAuthError{Code: biz.OAuthErrServerError, Description: "签发授权码失败"}, req.State))
+		return
+	}
+	params := url.Values{"code": {code}}
+	if req.State != "" {
+		params.Set("state", req.State)
+	}
+	redirectWithParams(w, r, redirectURI, params)
+}
+
+// POST /oauth2/token
+func (s *OIDCService) Token(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if r.Method != stdhttp.MethodPost {
+		w.Header().Set("Allow", "POST")
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, &biz.OAuthError{Code: biz.OAuthErrInvalidRequest, Description: "请求格式错误"})
+		return
+	}
+
+	ctx := r.Context()
+	req := &biz.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Client: biz.ClientInfo{
+			IP:        middleware.GetClientIP(ctx),
+			UserAgent: middleware.GetUserAgent(ctx),
+		},
+	}
+	// client_secret_basic 的凭据按 application/x-www-form-urlencoded 编码
+	if id, secret, ok := r.BasicAuth(); ok {
+		req.ClientID, _ = url.QueryUnescape(id)
+		req.ClientSecret, _ = url.QueryUnescape(secret)
+	}
+
+	token, err := s.oidc.Exchange(ctx, req)
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, token)
+}
+
+// GET /oauth2/userinfo
+func (s *OIDCService) UserInfo(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if r.Method != stdhttp.MethodGet && r.Method != stdhttp.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		stdhttp.Error(w, "method not allowed", stdhttp.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = ""
+	}
+	user, err := s.oidc.UserInfo(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		var oauthErr *biz.OAuthError
+		if !errors.As(err, &oauthErr) {
+			oauthErr = &biz.OAuthError{Code: biz.OAuthErrServerError, Description: "获取用户信息失败"}
+		}
+		w.Header().Set("WWW-Authenticate", `Bearer error="`+oauthErr.Code+`"`)
+		writeJSON(w, stdhttp.StatusUnauthorized, map[string]string{
+			"error":             oauthErr.Code,
+			"error_description": oauthErr.Description,
+		})
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, map[string]any{
+		"sub":                strconv.FormatUint(uint64(user.ID), 10),
+		"preferred_username": user.Username,
+		"email":              user.Email,
+		"email_verified":     user.EmailVerifiedAt != nil,
+		"phone_number":       user.Phone,
+		"picture":            user.Avatar,
+		"roles":              user.Roles,
+	})
+}
+
+// 需要在登录表单中原样提交的授权参数
+func authorizeParams(req *biz.AuthorizationRequest) map[string]string {
+	params := map[string]string{
+		"response_type":         req.ResponseType,
+		"client_id":             req.ClientID,
+		"redirect_uri":          req.RedirectURI,
+		"scope":                 req.Scope,
+		"code_challenge":        req.CodeChallenge,
+		"code_challenge_method": req.CodeChallengeMethod,
+	}
+	if req.State != "" {
+		params["state"] = req.State
+	}
+	if req.Nonce != "" {
+		params["nonce"] = req.Nonce
+	}
+	return params
+}
+
+func renderAuthorizePage(w stdhttp.ResponseWriter, status int, page *authorizePage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	// 防止登录页被嵌入其他页面进行点击劫持
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(status)
+	authorizeTemplate.Execute(w, page)
+}
+
+func oauthErrorParams(err error, state string) url.Values {
+	var oauthErr *biz.OAuthError
+	if !errors.As(err, &oauthErr) {
+		oauthErr = &biz.OAuthError{Code: biz.OAuthErrServerError, Description: err.Error()}
+	}
+	params := url.Values{
+		"error":             {oauthErr.Code},
+		"error_description": {oauthErr.Description},
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+	return params
+}
+
+func redirectWithParams(w stdhttp.ResponseWriter, r *stdhttp.Request, redirectURI string, params url.Values) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		stdhttp.Error(w, "invalid redirect_uri", stdhttp.StatusBadRequest)
+		return
+	}
+	query := u.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+	u.RawQuery = query.Encode()
+	stdhttp.Redirect(w, r, u.String(), stdhttp.StatusFound)
+}
+
+// 按 RFC 6749 5.2 返回令牌端点错误
+func writeOAuthError(w stdhttp.ResponseWriter, err error) {
+	var oauthErr *biz.OAuthError
+	if !errors.As(err, &oauthErr) {
+		oauthErr = &biz.OAuthError{Code: biz.OAuthErrServerError, Description: "服务器内部错误"}
+	}
+
+	status := stdhttp.StatusBadRequest
+	switch oauthErr.Code {
+	case biz.OAuthErrInvalidClient:
+		status = stdhttp.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth2"`)
+	case biz.OAuthErrServerError:
+		status = stdhttp.StatusInternalServerError
+	}
+	writeJSON(w, status, map[string]string{
+		"error":             oauthErr.Code,
+		"error_description": oauthErr.Description,
+	})
+}
+
+func writeJSON(w stdhttp.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
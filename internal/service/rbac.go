@@ -5,6 +5,7 @@ import (
 
 	v1 "student/api/rbac/v1"
 	"student/internal/biz"
+	"student/internal/pkg/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -12,14 +13,16 @@ import (
 type RBACService struct {
 	v1.UnimplementedRBACServiceServer
 
-	rbacUC *biz.RBACUsecase
-	log    *log.Helper
+	rbacUC  *biz.RBACUsecase
+	recycle *biz.RecycleBinUsecase
+	log     *log.Helper
 }
 
-func NewRBACService(rbacUC *biz.RBACUsecase, logger log.Logger) *RBACService {
+func NewRBACService(rbacUC *biz.RBACUsecase, recycle *biz.RecycleBinUsecase, logger log.Logger) *RBACService {
 	return &RBACService{
-		rbacUC: rbacUC,
-		log:    log.NewHelper(logger),
+		rbacUC:  rbacUC,
+		recycle: recycle,
+		log:     log.NewHelper(logger),
 	}
 }
 
@@ -38,8 +41,10 @@ func (s *RBACService) GetRole(ctx context.Context, req *v1.GetRoleRequest) (*v1.
 		Status:      int32(role.Status),
 		CreatedAt:   role.CreatedAtStr,
 		UpdatedAt:   role.UpdatedAtStr,
+		Version:     uint32(role.Version),
 	}
 
+	middleware.SetETag(ctx, role.Version)
 	return &v1.GetRoleResponse{
 		Role: roleProto,
 	}, nil
@@ -65,6 +70,7 @@ func (s *RBACService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest)
 		Status:      int32(role.Status),
 		CreatedAt:   role.CreatedAtStr,
 		UpdatedAt:   role.UpdatedAtStr,
+		Version:     uint32(role.Version),
 	}
 
 	return &v1.CreateRoleResponse{
@@ -73,13 +79,18 @@ func (s *RBACService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest)
 }
 
 func (s *RBACService) UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error) {
+	version, err := middleware.RequestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	roleForm := &biz.RoleForm{
 		Name:        req.Name,
 		Description: req.Description,
 		Status:      int(req.Status),
+		Version:     version,
 	}
 
-	role, err := s.rbacUC.UpdateRole(ctx, req.Id, roleForm)
+	role, err := s.rbacUC.UpdateRole(ctx, req.Id, roleForm, req.GetUpdateMask().GetPaths())
 	if err != nil {
 		return nil, err
 	}
@@ -92,18 +103,23 @@ func (s *RBACService) UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest)
 		Status:      int32(role.Status),
 		CreatedAt:   role.CreatedAtStr,
 		UpdatedAt:   role.UpdatedAtStr,
+		Version:     uint32(role.Version),
 	}
 
+	middleware.SetETag(ctx, role.Version)
 	return &v1.UpdateRoleResponse{
 		Role: roleProto,
 	}, nil
 }
 
 func (s *RBACService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
-	err := s.rbacUC.DeleteRole(ctx, req.Id)
+	version, err := middleware.RequestVersion(ctx, req.Version)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.rbacUC.DeleteRole(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
 
 	return &v1.DeleteRoleResponse{
 		Message: "角色删除成功",
@@ -111,7 +127,13 @@ func (s *RBACService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest)
 }
 
 func (s *RBACService) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (*v1.ListRolesResponse, error) {
-	roles, total, err := s.rbacUC.ListRoles(ctx, req.Page, req.PageSize, req.Name)
+	roles, next, total, err := s.rbacUC.ListRoles(ctx, biz.ListRequest{
+		Filter:    req.Filter,
+		OrderBy:   req.OrderBy,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+		Page:      req.Page,
+	}, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -126,13 +148,15 @@ func (s *RBACService) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (
 			Status:      int32(role.Status),
 			CreatedAt:   role.CreatedAtStr,
 			UpdatedAt:   role.UpdatedAtStr,
+			Version:     uint32(role.Version),
 		}
 		rolesProto = append(rolesProto, roleProto)
 	}
 
 	return &v1.ListRolesResponse{
-		Roles: rolesProto,
-		Total: total,
+		Roles:         rolesProto,
+		Total:         total,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -153,8 +177,10 @@ func (s *RBACService) GetPermission(ctx context.Context, req *v1.GetPermissionRe
 		Status:      int32(permission.Status),
 		CreatedAt:   permission.CreatedAtStr,
 		UpdatedAt:   permission.UpdatedAtStr,
+		Version:     uint32(permission.Version),
 	}
 
+	middleware.SetETag(ctx, permission.Version)
 	return &v1.GetPermissionResponse{
 		Permission: permissionProto,
 	}, nil
@@ -184,6 +210,7 @@ func (s *RBACService) CreatePermission(ctx context.Context, req *v1.CreatePermis
 		Status:      int32(permission.Status),
 		CreatedAt:   permission.CreatedAtStr,
 		UpdatedAt:   permission.UpdatedAtStr,
+		Version:     uint32(permission.Version),
 	}
 
 	return &v1.CreatePermissionResponse{
@@ -192,15 +219,20 @@ func (s *RBACService) CreatePermission(ctx context.Context, req *v1.CreatePermis
 }
 
 func (s *RBACService) UpdatePermission(ctx context.Context, req *v1.UpdatePermissionRequest) (*v1.UpdatePermissionResponse, error) {
+	version, err := middleware.RequestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	permissionForm := &biz.PermissionForm{
 		Name:        req.Name,
 		Resource:    req.Resource,
 		Action:      req.Action,
 		Description: req.Description,
 		Status:      int(req.Status),
+		Version:     version,
 	}
 
-	permission, err := s.rbacUC.UpdatePermission(ctx, req.Id, permissionForm)
+	permission, err := s.rbacUC.UpdatePermission(ctx, req.Id, permissionForm, req.GetUpdateMask().GetPaths())
 	if err != nil {
 		return nil, err
 	}
@@ -215,18 +247,23 @@ func (s *RBACService) UpdatePermission(ctx context.Context, req *v1.UpdatePermis
 		Status:      int32(permission.Status),
 		CreatedAt:   permission.CreatedAtStr,
 		UpdatedAt:   permission.UpdatedAtStr,
+		Version:     uint32(permission.Version),
 	}
 
+	middleware.SetETag(ctx, permission.Version)
 	return &v1.UpdatePermissionResponse{
 		Permission: permissionProto,
 	}, nil
 }
 
 func (s *RBACService) DeletePermission(ctx context.Context, req *v1.DeletePermissionRequest) (*v1.DeletePermissionResponse, error) {
-	err := s.rbacUC.DeletePermission(ctx, req.Id)
+	version, err := middleware.RequestVersion(ctx, req.Version)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.rbacUC.DeletePermission(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
 
 	return &v1.DeletePermissionResponse{
 		Message: "权限删除成功",
@@ -234,7 +271,13 @@ func (s *RBACService) DeletePermission(ctx context.Context, req *v1.DeletePermis
 }
 
 func (s *RBACService) ListPermissions(ctx context.Context, req *v1.ListPermissionsRequest) (*v1.ListPermissionsResponse, error) {
-	permissions, total, err := s.rbacUC.ListPermissions(ctx, req.Page, req.PageSize, req.Name, req.Resource)
+	permissions, next, total, err := s.rbacUC.ListPermissions(ctx, biz.ListRequest{
+		Filter:    req.Filter,
+		OrderBy:   req.OrderBy,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+		Page:      req.Page,
+	}, req.Name, req.Resource)
 	if err != nil {
 		return nil, err
 	}
@@ -251,13 +294,15 @@ func (s *RBACService) ListPermissions(ctx context.Context, req *v1.ListPermissio
 			Status:      int32(permission.Status),
 			CreatedAt:   permission.CreatedAtStr,
 			UpdatedAt:   permission.UpdatedAtStr,
+			Version:     uint32(permission.Version),
 		}
 		permissionsProto = append(permissionsProto, permissionProto)
 	}
 
 	return &v1.ListPermissionsResponse{
-		Permissions: permissionsProto,
-		Total:       total,
+		Permissions:   permissionsProto,
+		Total:         total,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -286,6 +331,7 @@ func (s *RBACService) GetUserRoles(ctx context.Context, req *v1.GetUserRolesRequ
 				Status:      int32(userRole.Role.Status),
 				CreatedAt:   userRole.Role.CreatedAtStr,
 				UpdatedAt:   userRole.Role.UpdatedAtStr,
+				Version:     uint32(userRole.Role.Version),
 			}
 		}
 		userRolesProto = append(userRolesProto, userRoleProto)
@@ -345,6 +391,7 @@ func (s *RBACService) GetRolePermissions(ctx context.Context, req *v1.GetRolePer
 				Status:      int32(rolePermission.Permission.Status),
 				CreatedAt:   rolePermission.Permission.CreatedAtStr,
 				UpdatedAt:   rolePermission.Permission.UpdatedAtStr,
+				Version:     uint32(rolePermission.Permission.Version),
 			}
 		}
 		rolePermissionsProto = append(rolePermissionsProto, rolePermissionProto)
@@ -388,3 +435,98 @@ func (s *RBACService) CheckPermission(ctx context.Context, req *v1.CheckPermissi
 		HasPermission: hasPermission,
 	}, nil
 }
+
+// 回收站服务方法
+func toDeletedRoleProto(role *biz.Role) *v1.Role {
+	return &v1.Role{
+		Id:          uint32(role.ID),
+		Name:        role.Name,
+		Description: role.Description,
+		Status:      int32(role.Status),
+		CreatedAt:   role.CreatedAtStr,
+		UpdatedAt:   role.UpdatedAtStr,
+		Version:     uint32(role.Version),
+		DeletedAt:   biz.DeletedAtStr(role.DeletedAt),
+	}
+}
+
+func toDeletedPermissionProto(permission *biz.Permission) *v1.Permission {
+	return &v1.Permission{
+		Id:          uint32(permission.ID),
+		Name:        permission.Name,
+		Resource:    permission.Resource,
+		Action:      permission.Action,
+		Description: permission.Description,
+		Status:      int32(permission.Status),
+		CreatedAt:   permission.CreatedAtStr,
+		UpdatedAt:   permission.UpdatedAtStr,
+		Version:     uint32(permission.Version),
+		DeletedAt:   biz.DeletedAtStr(permission.DeletedAt),
+	}
+}
+
+func (s *RBACService) ListDeletedRoles(ctx context.Context, req *v1.ListDeletedRolesRequest) (*v1.ListDeletedRolesResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	roles, total, err := s.recycle.ListDeletedRoles(ctx, operatorID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	rolesProto := make([]*v1.Role, 0, len(roles))
+	for _, role := range roles {
+		rolesProto = append(rolesProto, toDeletedRoleProto(role))
+	}
+	return &v1.ListDeletedRolesResponse{Roles: rolesProto, Total: total}, nil
+}
+
+func (s *RBACService) RestoreRole(ctx context.Context, req *v1.RestoreRoleRequest) (*v1.RestoreRoleResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	role, err := s.recycle.RestoreRole(ctx, operatorID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	middleware.SetETag(ctx, role.Version)
+	return &v1.RestoreRoleResponse{Role: toDeletedRoleProto(role)}, nil
+}
+
+func (s *RBACService) PurgeRole(ctx context.Context, req *v1.PurgeRoleRequest) (*v1.PurgeRoleResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.recycle.PurgeRole(ctx, operatorID, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.PurgeRoleResponse{
+		Message: "角色已彻底删除",
+	}, nil
+}
+
+func (s *RBACService) ListDeletedPermissions(ctx context.Context, req *v1.ListDeletedPermissionsRequest) (*v1.ListDeletedPermissionsResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	permissions, total, err := s.recycle.ListDeletedPermissions(ctx, operatorID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	permissionsProto := make([]*v1.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionsProto = append(permissionsProto, toDeletedPermissionProto(permission))
+	}
+	return &v1.ListDeletedPermissionsResponse{Permissions: permissionsProto, Total: total}, nil
+}
+
+func (s *RBACService) RestorePermission(ctx context.Context, req *v1.RestorePermissionRequest) (*v1.RestorePermissionResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	permission, err := s.recycle.RestorePermission(ctx, operatorID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	middleware.SetETag(ctx, permission.Version)
+	return &v1.RestorePermissionResponse{Permission: toDeletedPermissionProto(permission)}, nil
+}
+
+func (s *RBACService) PurgePermission(ctx context.Context, req *v1.PurgePermissionRequest) (*v1.PurgePermissionResponse, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.recycle.PurgePermission(ctx, operatorID, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.PurgePermissionResponse{
+		Message: "权限已彻底删除",
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	pb "student/api/student/v1"
 	"student/internal/biz"
+	"student/internal/pkg/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
@@ -14,18 +15,27 @@ import (
 type StudentService struct {
 	pb.UnimplementedStudentServer
 
-	student *biz.StudentUsecase
-	log     *log.Helper
+	student  *biz.StudentUsecase
+	grade    *biz.GradeUsecase
+	guardian *biz.GuardianUsecase
+	recycle  *biz.RecycleBinUsecase
+	log      *log.Helper
 }
 
-func NewStudentService(student *biz.StudentUsecase, logger log.Logger) *StudentService {
+func NewStudentService(student *biz.StudentUsecase, grade *biz.GradeUsecase, guardian *biz.GuardianUsecase, recycle *biz.RecycleBinUsecase, logger log.Logger) *StudentService {
 	return &StudentService{
-		student: student,
-		log:     log.NewHelper(logger),
+		student:  student,
+		grade:    grade,
+		guardian: guardian,
+		recycle:  recycle,
+		log:      log.NewHelper(logger),
 	}
 }
 
 func (s *StudentService) GetStudent(ctx context.Context, req *pb.GetStudentRequest) (*pb.GetStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
 	stu, err := s.student.Get(ctx, req.Id)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -41,12 +51,17 @@ func (s *StudentService) GetStudent(ctx context.Context, req *pb.GetStudentReque
 		Age:       int32(stu.Age),
 		CreatedAt: stu.CreatedAtStr, // 使用格式化后的字符串，避免每次转换
 		UpdatedAt: stu.UpdatedAtStr, // 使用格式化后的字符串，避免每次转换
+		Version:   uint32(stu.Version),
 	}
+	middleware.SetETag(ctx, stu.Version)
 	// err = gconv.Struct(stu, &student)
 	return &student, err
 }
 
 func (s *StudentService) CreateStudent(ctx context.Context, req *pb.CreateStudentRequest) (*pb.CreateStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
 	s.log.Info("create student", req.Name, req.Age, req.Status, req.Info)
 	stu, err := s.student.Create(ctx, &biz.StudentForm{
 		Name:   req.Name,
@@ -65,29 +80,45 @@ func (s *StudentService) CreateStudent(ctx context.Context, req *pb.CreateStuden
 }
 
 func (s *StudentService) UpdateStudent(ctx context.Context, req *pb.UpdateStudentRequest) (*pb.UpdateStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
 	s.log.Info("update student", req.Id, req.Name, req.Age, req.Status, req.Info)
+	version, err := middleware.RequestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	stu, err := s.student.Update(ctx, req.Id, &biz.StudentForm{
-		Name:   req.Name,
-		Info:   req.Info,
-		Status: int(req.Status),
-		Age:    int(req.Age),
-	})
+		Name:    req.Name,
+		Info:    req.Info,
+		Status:  int(req.Status),
+		Age:     int(req.Age),
+		Version: version,
+	}, req.GetUpdateMask().GetPaths())
 
 	if err != nil {
 		return nil, err
 	}
 	s.log.Info("update student", stu.Message)
+	middleware.SetETag(ctx, stu.Version)
 	return &pb.UpdateStudentReply{
 		Message: stu.Message,
+		Version: uint32(stu.Version),
 	}, nil
 }
 
 func (s *StudentService) DeleteStudent(ctx context.Context, req *pb.DeleteStudentRequest) (*pb.DeleteStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
 	s.log.Info("delete student", req.Id)
-	_, err := s.student.Delete(ctx, req.Id)
+	version, err := middleware.RequestVersion(ctx, req.Version)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := s.student.Delete(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
 	s.log.Info("delete student success")
 	return &pb.DeleteStudentReply{
 		Message: "delete student success",
@@ -95,29 +126,29 @@ func (s *StudentService) DeleteStudent(ctx context.Context, req *pb.DeleteStuden
 }
 
 func (s *StudentService) ListStudents(ctx context.Context, req *pb.ListStudentsRequest) (*pb.ListStudentsReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
 	s.log.Info("list student")
 	var err error
 	var pageSize int
-	var total int32
-	var students []*biz.Student
 	if req.PageSize != "" {
 		pageSize, err = strconv.Atoi(req.PageSize)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		pageSize = 10
 	}
 	var page int
 	if req.Page != "" {
 		page, _ = strconv.Atoi(req.Page)
-		// if err != nil {
-		// return nil, err
-		// }
-	} else {
-		page = 1
 	}
-	students, total, err = s.student.List(ctx, int32(page), int32(pageSize), req.Name)
+	students, next, total, err := s.student.List(ctx, biz.ListRequest{
+		Filter:    req.Filter,
+		OrderBy:   req.OrderBy,
+		PageSize:  int32(pageSize),
+		PageToken: req.PageToken,
+		Page:      int32(page),
+	}, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -131,10 +162,207 @@ func (s *StudentService) ListStudents(ctx context.Context, req *pb.ListStudentsR
 			Age:       int32(stu.Age),
 			CreatedAt: stu.CreatedAtStr, // 使用格式化后的字符串，避免每次转换
 			UpdatedAt: stu.UpdatedAtStr, // 使用格式化后的字符串，避免每次转换
+			Version:   uint32(stu.Version),
 		})
 	}
 	return &pb.ListStudentsReply{
-		Data:  data,
-		Total: int32(total),
+		Data:          data,
+		Total:         total,
+		NextPageToken: next,
 	}, nil
 }
+
+func (s *StudentService) RecordGrade(ctx context.Context, req *pb.RecordGradeRequest) (*pb.RecordGradeReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	s.log.Info("record grade", req.StudentId, req.CourseId, req.Term)
+	grade, err := s.grade.RecordGrade(ctx, &biz.GradeForm{
+		StudentID: uint(req.StudentId),
+		CourseID:  uint(req.CourseId),
+		Term:      req.Term,
+		Score:     req.Score,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecordGradeReply{Grade: toGradeProto(grade)}, nil
+}
+
+func (s *StudentService) DeleteGrade(ctx context.Context, req *pb.DeleteGradeRequest) (*pb.DeleteGradeReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	if err := s.grade.DeleteGrade(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGradeReply{
+		Message: "成绩删除成功",
+	}, nil
+}
+
+func (s *StudentService) ListGrades(ctx context.Context, req *pb.ListGradesRequest) (*pb.ListGradesReply, error) {
+	if err := s.checkStudentAccess(ctx, uint(req.StudentId)); err != nil {
+		return nil, err
+	}
+	grades, total, err := s.grade.ListGrades(ctx, req.Page, req.PageSize, uint(req.StudentId), req.Term)
+	if err != nil {
+		return nil, err
+	}
+	gradeProtos := make([]*pb.Grade, 0, len(grades))
+	for _, grade := range grades {
+		gradeProtos = append(gradeProtos, toGradeProto(grade))
+	}
+	return &pb.ListGradesReply{
+		Grades: gradeProtos,
+		Total:  total,
+	}, nil
+}
+
+func (s *StudentService) GetTranscript(ctx context.Context, req *pb.GetTranscriptRequest) (*pb.GetTranscriptReply, error) {
+	if err := s.checkStudentAccess(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	transcript, err := s.grade.GetTranscript(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetTranscriptReply{
+		StudentId:        int32(transcript.Student.ID),
+		StudentName:      transcript.Student.Name,
+		Terms:            make([]*pb.TermTranscript, 0, len(transcript.Terms)),
+		AttemptedCredits: transcript.AttemptedCredits,
+		EarnedCredits:    transcript.EarnedCredits,
+		Gpa:              transcript.GPA,
+	}
+	for _, term := range transcript.Terms {
+		termProto := &pb.TermTranscript{
+			Term:    term.Term,
+			Grades:  make([]*pb.Grade, 0, len(term.Grades)),
+			Credits: term.Credits,
+			Gpa:     term.GPA,
+		}
+		for _, grade := range term.Grades {
+			termProto.Grades = append(termProto.Grades, toGradeProto(grade))
+		}
+		reply.Terms = append(reply.Terms, termProto)
+	}
+	return reply, nil
+}
+
+func toGradeProto(g *biz.Grade) *pb.Grade {
+	grade := &pb.Grade{
+		Id:        uint32(g.ID),
+		StudentId: uint32(g.StudentID),
+		CourseId:  uint32(g.CourseID),
+		Credits:   g.Credits(),
+		Term:      g.Term,
+		Score:     g.Score,
+		Letter:    g.Letter,
+		Points:    g.Points,
+		CreatedAt: g.CreatedAtStr,
+		UpdatedAt: g.UpdatedAtStr,
+	}
+	if g.Course != nil {
+		grade.CourseCode = g.Course.Code
+		grade.CourseName = g.Course.Name
+	}
+	return grade
+}
+
+func (s *StudentService) ChangeStudentStatus(ctx context.Context, req *pb.ChangeStudentStatusRequest) (*pb.ChangeStudentStatusReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	operatorID, _ := ctx.Value("user_id").(uint)
+	change, err := s.student.ChangeStatus(ctx, req.Id, int(req.Status), req.Reason, req.Term, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangeStudentStatusReply{Change: toStatusChangeProto(change)}, nil
+}
+
+func (s *StudentService) ListStudentStatusChanges(ctx context.Context, req *pb.ListStudentStatusChangesRequest) (*pb.ListStudentStatusChangesReply, error) {
+	if err := s.checkStudentAccess(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	changes, err := s.student.ListStatusChanges(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	changeProtos := make([]*pb.StudentStatusChange, 0, len(changes))
+	for _, change := range changes {
+		changeProtos = append(changeProtos, toStatusChangeProto(change))
+	}
+	return &pb.ListStudentStatusChangesReply{Changes: changeProtos}, nil
+}
+
+func toStatusChangeProto(c *biz.StudentStatusChange) *pb.StudentStatusChange {
+	change := &pb.StudentStatusChange{
+		Id:         uint32(c.ID),
+		FromStatus: int32(c.FromStatus),
+		ToStatus:   int32(c.ToStatus),
+		Reason:     c.Reason,
+		OperatorId: uint32(c.OperatorID),
+		CreatedAt:  c.CreatedAtStr,
+	}
+	if c.Term != nil {
+		change.Term = c.Term.Code
+	}
+	return change
+}
+
+// 回收站中的学生，带删除时间
+func toDeletedStudentProto(stu *biz.Student) *pb.Students {
+	return &pb.Students{
+		Id:        int32(stu.ID),
+		Name:      stu.Name,
+		Info:      stu.Info,
+		Status:    int32(stu.Status),
+		Age:       int32(stu.Age),
+		CreatedAt: stu.CreatedAtStr,
+		UpdatedAt: stu.UpdatedAtStr,
+		Version:   uint32(stu.Version),
+		DeletedAt: biz.DeletedAtStr(stu.DeletedAt),
+	}
+}
+
+func (s *StudentService) ListDeletedStudents(ctx context.Context, req *pb.ListDeletedStudentsRequest) (*pb.ListDeletedStudentsReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	operatorID, _ := ctx.Value("user_id").(uint)
+	stus, total, err := s.recycle.ListDeletedStudents(ctx, operatorID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.Students, 0, len(stus))
+	for _, stu := range stus {
+		data = append(data, toDeletedStudentProto(stu))
+	}
+	return &pb.ListDeletedStudentsReply{Data: data, Total: total}, nil
+}
+
+func (s *StudentService) RestoreStudent(ctx context.Context, req *pb.RestoreStudentRequest) (*pb.RestoreStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	operatorID, _ := ctx.Value("user_id").(uint)
+	stu, err := s.recycle.RestoreStudent(ctx, operatorID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	middleware.SetETag(ctx, stu.Version)
+	return &pb.RestoreStudentReply{Student: toDeletedStudentProto(stu)}, nil
+}
+
+func (s *StudentService) PurgeStudent(ctx context.Context, req *pb.PurgeStudentRequest) (*pb.PurgeStudentReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.recycle.PurgeStudent(ctx, operatorID, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.PurgeStudentReply{Message: "Purge student success"}, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	pb "student/api/student/v1"
+	"student/internal/biz"
+	"student/internal/pkg/spreadsheet"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+var studentExportHeader = []string{"id", "name", "age", "status", "info", "created_at", "updated_at"}
+
+func (s *StudentService) exportStudents(ctx context.Context, name, format string, w io.Writer) error {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return err
+	}
+	return writeExport(w, format, studentExportHeader, func(write func([]any) error) error {
+		return s.student.Export(ctx, name, func(stu *biz.Student) error {
+			return write([]any{stu.ID, stu.Name, stu.Age, stu.Status, stu.Info, stu.CreatedAtStr, stu.UpdatedAtStr})
+		})
+	})
+}
+
+func (s *StudentService) ExportStudents(req *pb.ExportStudentsRequest, stream pb.Student_ExportStudentsServer) error {
+	format, err := exportFormat(req.Format, "")
+	if err != nil {
+		return err
+	}
+	contentType := spreadsheet.ContentType(format)
+	return s.exportStudents(stream.Context(), req.Name, format, chunkSender(func(data []byte) error {
+		chunk := &pb.ExportChunk{Data: data, ContentType: contentType}
+		contentType = ""
+		return stream.Send(chunk)
+	}))
+}
+
+// DownloadStudents 通过 HTTP 下载导出的学生，格式由 format 查询参数或 Accept 请求头决定
+func (s *StudentService) DownloadStudents(ctx http.Context) error {
+	r := ctx.Request()
+	format, err := exportFormat(r.URL.Query().Get("format"), r.Header.Get("Accept"))
+	if err != nil {
+		return err
+	}
+	req := &pb.ExportStudentsRequest{Name: r.URL.Query().Get("name"), Format: format}
+	return streamDownload(ctx, s.log, pb.Student_ExportStudents_FullMethodName, req, "students", format, func(ctx context.Context, w io.Writer) error {
+		return s.exportStudents(ctx, req.Name, format, w)
+	})
+}
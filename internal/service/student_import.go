@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"io"
+	stdhttp "net/http"
+	"strconv"
+
+	pb "student/api/student/v1"
+	"student/internal/biz"
+	"student/internal/pkg/spreadsheet"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// 导入文件的最大大小
+const maxImportFileSize = 10 << 20
+
+// 导入文件的最大列数，支持的列只有 name、age、status、info
+const maxImportColumns = 64
+
+func (s *StudentService) ImportStudents(ctx context.Context, req *pb.ImportStudentsRequest) (*pb.ImportStudentsReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	if len(req.File) == 0 {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "请上传导入文件")
+	}
+	if len(req.File) > maxImportFileSize {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "导入文件不能超过 10MB")
+	}
+	format := req.Format
+	if format == "" {
+		format = spreadsheet.FormatFromFilename(req.Filename)
+	}
+	// 表头加数据行，超过时不再继续解析
+	rows, err := spreadsheet.Read(format, req.File, spreadsheet.Limits{Rows: biz.StudentImportMaxRows + 1, Columns: maxImportColumns})
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+	result, err := s.student.Import(ctx, rows, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ImportStudentsReply{
+		DryRun:   result.DryRun,
+		Total:    int32(result.Total),
+		Valid:    int32(result.Valid),
+		Imported: int32(result.Imported),
+		Errors:   make([]*pb.StudentImportError, 0, len(result.Errors)),
+	}
+	for _, e := range result.Errors {
+		reply.Errors = append(reply.Errors, &pb.StudentImportError{Row: int32(e.Row), Column: e.Column, Message: e.Message})
+	}
+	return reply, nil
+}
+
+// UploadStudents 通过 multipart 上传导入文件，文件字段为 file，dry_run 可以放在表单或查询参数中
+// 与 ImportStudents 使用相同的 operation，经过相同的中间件
+func (s *StudentService) UploadStudents(ctx http.Context) error {
+	r := ctx.Request()
+	r.Body = stdhttp.MaxBytesReader(ctx.Response(), r.Body, maxImportFileSize+1<<20)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return errors.BadRequest("INVALID_ARGUMENT", "请通过 file 字段上传导入文件")
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+	if err != nil {
+		return errors.BadRequest("INVALID_ARGUMENT", "读取导入文件失败")
+	}
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+	in := &pb.ImportStudentsRequest{
+		File:     data,
+		Format:   r.FormValue("format"),
+		Filename: header.Filename,
+		DryRun:   dryRun,
+	}
+
+	http.SetOperation(ctx, pb.OperationStudentImportStudents)
+	h := ctx.Middleware(func(ctx context.Context, req any) (any, error) {
+		return s.ImportStudents(ctx, req.(*pb.ImportStudentsRequest))
+	})
+	out, err := h(ctx, in)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(200, out)
+}
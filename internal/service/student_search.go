@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	pb "student/api/student/v1"
+)
+
+func (s *StudentService) SearchStudents(ctx context.Context, req *pb.SearchStudentsRequest) (*pb.SearchStudentsReply, error) {
+	if err := s.checkStudentAccess(ctx, 0); err != nil {
+		return nil, err
+	}
+	hits, total, err := s.student.Search(ctx, req.Q, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SearchStudentsReply{
+		Hits:  make([]*pb.StudentSearchHit, 0, len(hits)),
+		Total: total,
+	}
+	for _, hit := range hits {
+		stu := hit.Student
+		item := &pb.StudentSearchHit{
+			Student: &pb.Students{
+				Id:        int32(stu.ID),
+				Name:      stu.Name,
+				Info:      stu.Info,
+				Status:    int32(stu.Status),
+				Age:       int32(stu.Age),
+				CreatedAt: stu.CreatedAtStr,
+				UpdatedAt: stu.UpdatedAtStr,
+				Version:   uint32(stu.Version),
+			},
+			Score: hit.Score,
+		}
+		// 按字段名排序，保证响应稳定
+		fields := make([]string, 0, len(hit.Highlights))
+		for field := range hit.Highlights {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			item.Highlights = append(item.Highlights, &pb.SearchHighlight{Field: field, Fragments: hit.Highlights[field]})
+		}
+		reply.Hits = append(reply.Hits, item)
+	}
+	return reply, nil
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"strconv"
+	"time"
 
 	pb "student/api/user/v1"
 	"student/internal/biz"
+	"student/internal/pkg/middleware"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
@@ -14,14 +16,26 @@ import (
 type UserService struct {
 	pb.UnimplementedUserServer
 
-	user *biz.UserUsecase
-	log  *log.Helper
+	user          *biz.UserUsecase
+	account       *biz.AccountUsecase
+	apiKeys       *biz.APIKeyUsecase
+	oidc          *biz.OIDCUsecase
+	externalLogin *biz.ExternalLoginUsecase
+	impersonation *biz.ImpersonationUsecase
+	recycle       *biz.RecycleBinUsecase
+	log           *log.Helper
 }
 
-func NewUserService(user *biz.UserUsecase, logger log.Logger) *UserService {
+func NewUserService(user *biz.UserUsecase, account *biz.AccountUsecase, apiKeys *biz.APIKeyUsecase, oidc *biz.OIDCUsecase, externalLogin *biz.ExternalLoginUsecase, impersonation *biz.ImpersonationUsecase, recycle *biz.RecycleBinUsecase, logger log.Logger) *UserService {
 	return &UserService{
-		user: user,
-		log:  log.NewHelper(logger),
+		user:          user,
+		account:       account,
+		apiKeys:       apiKeys,
+		oidc:          oidc,
+		externalLogin: externalLogin,
+		impersonation: impersonation,
+		recycle:       recycle,
+		log:           log.NewHelper(logger),
 	}
 }
 
@@ -44,7 +58,9 @@ func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 		Avatar:    user.Avatar,
 		CreatedAt: user.CreatedAtStr, // 使用格式化后的字符串，避免每次转换
 		UpdatedAt: user.UpdatedAtStr, // 使用格式化后的字符串，避免每次转换
+		Version:   uint32(user.Version),
 	}
+	middleware.SetETag(ctx, user.Version)
 	return &userReply, err
 }
 
@@ -71,6 +87,10 @@ func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 
 func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserReply, error) {
 	s.log.Info("update user", req.Id, req.Username, req.Email, req.Phone, req.Status, req.Age, req.Avatar)
+	version, err := middleware.RequestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	user, err := s.user.Update(ctx, req.Id, &biz.UserForm{
 		Username: req.Username,
 		Email:    req.Email,
@@ -79,23 +99,29 @@ func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		Status:   int(req.Status),
 		Age:      int(req.Age),
 		Avatar:   req.Avatar,
-	})
+		Version:  version,
+	}, req.GetUpdateMask().GetPaths())
 
 	if err != nil {
 		return nil, err
 	}
 	s.log.Info("update user", user.Message)
+	middleware.SetETag(ctx, user.Version)
 	return &pb.UpdateUserReply{
 		Message: user.Message,
+		Version: uint32(user.Version),
 	}, nil
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserReply, error) {
 	s.log.Info("delete user", req.Id)
-	_, err := s.user.Delete(ctx, req.Id)
+	version, err := middleware.RequestVersion(ctx, req.Version)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := s.user.Delete(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
 	s.log.Info("delete user success")
 	return &pb.DeleteUserReply{
 		Message: "delete user success",
@@ -106,26 +132,25 @@ func (s *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 	s.log.Info("list users")
 	var err error
 	var pageSize int
-	var total int32
-	var users []*biz.User
-
 	if req.PageSize != "" {
 		pageSize, err = strconv.Atoi(req.PageSize)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		pageSize = 10
 	}
 
 	var page int
 	if req.Page != "" {
 		page, _ = strconv.Atoi(req.Page)
-	} else {
-		page = 1
 	}
 
-	users, total, err = s.user.List(ctx, int32(page), int32(pageSize), req.Username, req.Email)
+	users, next, total, err := s.user.List(ctx, biz.ListRequest{
+		Filter:    req.Filter,
+		OrderBy:   req.OrderBy,
+		PageSize:  int32(pageSize),
+		PageToken: req.PageToken,
+		Page:      int32(page),
+	}, req.Username, req.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -142,12 +167,14 @@ func (s *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 			Avatar:    user.Avatar,
 			CreatedAt: user.CreatedAtStr, // 使用格式化后的字符串，避免每次转换
 			UpdatedAt: user.UpdatedAtStr, // 使用格式化后的字符串，避免每次转换
+			Version:   uint32(user.Version),
 		})
 	}
 
 	return &pb.ListUsersReply{
-		Data:  data,
-		Total: int32(total),
+		Data:          data,
+		Total:         total,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -155,8 +182,10 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	s.log.Info("user login", req.Username)
 
 	loginForm := &biz.LoginForm{
-		Username: req.Username,
-		Password: req.Password,
+		Username:  req.Username,
+		Password:  req.Password,
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: middleware.GetUserAgent(ctx),
 	}
 
 	loginResult, err := s.user.Login(ctx, loginForm)
@@ -167,9 +196,13 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	s.log.Info("login result", "success", loginResult.Success, "token_length", len(loginResult.Token), "token", loginResult.Token)
 
 	reply := &pb.LoginReply{
-		Success: loginResult.Success,
-		Message: loginResult.Message,
-		Token:   loginResult.Token,
+		Success:      loginResult.Success,
+		Message:      loginResult.Message,
+		Token:        loginResult.Token,
+		RefreshToken: loginResult.RefreshToken,
+		ExpiresIn:    loginResult.ExpiresIn,
+		MfaRequired:  loginResult.MFARequired,
+		MfaToken:     loginResult.MFAToken,
 	}
 
 	if loginResult.Success && loginResult.User != nil {
@@ -191,6 +224,233 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	return reply, nil
 }
 
+func (s *UserService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenReply, error) {
+	result, err := s.user.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefreshTokenReply{
+		Success:      result.Success,
+		Message:      result.Message,
+		Token:        result.Token,
+		RefreshToken: result.RefreshToken,
+		ExpiresIn:    result.ExpiresIn,
+	}, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutReply, error) {
+	claims, ok := middleware.GetClaimsFromContext(ctx)
+	if !ok {
+		return &pb.LogoutReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	if err := s.user.Logout(ctx, claims); err != nil {
+		return nil, err
+	}
+	return &pb.LogoutReply{
+		Success: true,
+		Message: "退出登录成功",
+	}, nil
+}
+
+func (s *UserService) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsReply, error) {
+	s.log.Info("revoke all sessions", req.Id)
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.user.RevokeUserSessions(ctx, operatorID, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAllSessionsReply{
+		Success: true,
+		Message: "已撤销全部会话",
+	}, nil
+}
+
+func (s *UserService) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserReply, error) {
+	s.log.Info("unlock user", req.Id)
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.user.Unlock(ctx, operatorID, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.UnlockUserReply{
+		Success: true,
+		Message: "账户已解锁",
+	}, nil
+}
+
+func (s *UserService) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.LoginReply, error) {
+	result, err := s.user.VerifyMFA(ctx, req.MfaToken, req.Code, biz.ClientInfo{
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: middleware.GetUserAgent(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.LoginReply{
+		Success:      result.Success,
+		Message:      result.Message,
+		Token:        result.Token,
+		RefreshToken: result.RefreshToken,
+		ExpiresIn:    result.ExpiresIn,
+	}
+	if result.Success && result.User != nil {
+		reply.UserInfo = toUserInfo(result.User)
+	}
+	return reply, nil
+}
+
+func (s *UserService) StartExternalLogin(ctx context.Context, req *pb.StartExternalLoginRequest) (*pb.StartExternalLoginReply, error) {
+	result, err := s.externalLogin.Start(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartExternalLoginReply{
+		Success: result.Success,
+		Message: result.Message,
+		Url:     result.URL,
+	}, nil
+}
+
+func (s *UserService) ExternalLoginCallback(ctx context.Context, req *pb.ExternalLoginCallbackRequest) (*pb.LoginReply, error) {
+	result, err := s.externalLogin.Callback(ctx, req.Provider, req.Code, req.State, biz.ClientInfo{
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: middleware.GetUserAgent(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.LoginReply{
+		Success:      result.Success,
+		Message:      result.Message,
+		Token:        result.Token,
+		RefreshToken: result.RefreshToken,
+		ExpiresIn:    result.ExpiresIn,
+		MfaRequired:  result.MFARequired,
+		MfaToken:     result.MFAToken,
+	}
+	if result.Success && result.User != nil {
+		reply.UserInfo = toUserInfo(result.User)
+	}
+	return reply, nil
+}
+
+func (s *UserService) SetupMFA(ctx context.Context, req *pb.SetupMFARequest) (*pb.SetupMFAReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.SetupMFAReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.user.SetupMFA(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetupMFAReply{
+		Success:    result.Success,
+		Message:    result.Message,
+		Secret:     result.Secret,
+		OtpauthUrl: result.OTPAuthURL,
+	}, nil
+}
+
+func (s *UserService) EnableMFA(ctx context.Context, req *pb.EnableMFARequest) (*pb.EnableMFAReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.EnableMFAReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.user.EnableMFA(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EnableMFAReply{
+		Success:       result.Success,
+		Message:       result.Message,
+		RecoveryCodes: result.RecoveryCodes,
+	}, nil
+}
+
+func (s *UserService) DisableMFA(ctx context.Context, req *pb.DisableMFARequest) (*pb.DisableMFAReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.DisableMFAReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.user.DisableMFA(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisableMFAReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
+func (s *UserService) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetReply, error) {
+	result, err := s.account.RequestPasswordReset(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestPasswordResetReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordReply, error) {
+	result, err := s.account.ResetPassword(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetPasswordReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
+func (s *UserService) SendVerificationEmail(ctx context.Context, req *pb.SendVerificationEmailRequest) (*pb.SendVerificationEmailReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.SendVerificationEmailReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.account.SendVerificationEmail(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SendVerificationEmailReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
+func (s *UserService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailReply, error) {
+	result, err := s.account.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyEmailReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
 func (s *UserService) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.GetMeReply, error) {
 	s.log.Info("get current user info")
 
@@ -216,22 +476,281 @@ func (s *UserService) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.GetM
 	}
 
 	if meResult.Success && meResult.User != nil {
-		reply.UserInfo = &pb.UserInfo{
-			Id:        int32(meResult.User.ID),
-			Username:  meResult.User.Username,
-			Email:     meResult.User.Email,
-			Phone:     meResult.User.Phone,
-			Status:    int32(meResult.User.Status),
-			Age:       int32(meResult.User.Age),
-			Avatar:    meResult.User.Avatar,
-			CreatedAt: meResult.User.CreatedAtStr,
-			UpdatedAt: meResult.User.UpdatedAtStr,
+		reply.UserInfo = toUserInfo(meResult.User)
+	}
+	if claims, ok := middleware.GetClaimsFromContext(ctx); ok && claims.Impersonated() {
+		reply.Impersonator = &pb.Impersonator{
+			Id:       int32(claims.Actor.UserID),
+			Username: claims.Actor.Username,
 		}
 	}
 
 	return reply, nil
 }
 
+func (s *UserService) Impersonate(ctx context.Context, req *pb.ImpersonateRequest) (*pb.ImpersonateReply, error) {
+	// 只能使用登录会话发起模拟，API Key 不支持
+	claims, ok := middleware.GetClaimsFromContext(ctx)
+	if !ok {
+		return &pb.ImpersonateReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.impersonation.Impersonate(ctx, claims, uint(req.UserId), req.Reason, biz.ClientInfo{
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: middleware.GetUserAgent(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ImpersonateReply{
+		Success:   result.Success,
+		Message:   result.Message,
+		Token:     result.Token,
+		ExpiresIn: result.ExpiresIn,
+	}
+	if result.Success && result.User != nil {
+		reply.UserInfo = toUserInfo(result.User)
+	}
+	return reply, nil
+}
+
+func (s *UserService) UpdateMe(ctx context.Context, req *pb.UpdateMeRequest) (*pb.UpdateMeReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.UpdateMeReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.account.UpdateMe(ctx, userID, &biz.ProfileForm{
+		Email:  req.Email,
+		Phone:  req.Phone,
+		Avatar: req.Avatar,
+		Age:    int(req.Age),
+	}, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.UpdateMeReply{
+		Success: result.Success,
+		Message: result.Message,
+	}
+	if result.Success && result.User != nil {
+		reply.UserInfo = toUserInfo(result.User)
+	}
+	return reply, nil
+}
+
+func (s *UserService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordReply, error) {
+	claims, ok := middleware.GetClaimsFromContext(ctx)
+	if !ok {
+		return &pb.ChangePasswordReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	result, err := s.account.ChangePassword(ctx, claims, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangePasswordReply{
+		Success: result.Success,
+		Message: result.Message,
+	}, nil
+}
+
+func (s *UserService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.CreateAPIKeyReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	// 模拟登录期间不能创建长期有效的凭证
+	if claims, ok := middleware.GetClaimsFromContext(ctx); ok && claims.Impersonated() {
+		return &pb.CreateAPIKeyReply{
+			Success: false,
+			Message: "模拟登录期间不能创建 API Key",
+		}, nil
+	}
+
+	// API Key 不能再创建新的 Key，否则可以绕过自身的权限范围
+	if _, ok := middleware.GetAPIKeyFromContext(ctx); ok {
+		return &pb.CreateAPIKeyReply{
+			Success: false,
+			Message: "不能使用 API Key 创建 API Key，请登录后操作",
+		}, nil
+	}
+
+	expiresIn := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	result, err := s.apiKeys.Create(ctx, userID, req.Name, req.Scopes, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.CreateAPIKeyReply{
+		Success: result.Success,
+		Message: result.Message,
+		Key:     result.Key,
+	}
+	if result.APIKey != nil {
+		reply.ApiKey = toAPIKeyInfo(result.APIKey)
+	}
+	return reply, nil
+}
+
+func (s *UserService) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.ListAPIKeysReply{}, nil
+	}
+
+	keys, err := s.apiKeys.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListAPIKeysReply{
+		ApiKeys: make([]*pb.APIKeyInfo, 0, len(keys)),
+	}
+	for _, key := range keys {
+		reply.ApiKeys = append(reply.ApiKeys, toAPIKeyInfo(key))
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.RevokeAPIKeyReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	revoked, err := s.apiKeys.Revoke(ctx, userID, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return &pb.RevokeAPIKeyReply{
+			Success: false,
+			Message: "API Key 不存在或已撤销",
+		}, nil
+	}
+	return &pb.RevokeAPIKeyReply{
+		Success: true,
+		Message: "API Key 已撤销",
+	}, nil
+}
+
+func (s *UserService) ListMySessions(ctx context.Context, req *pb.ListMySessionsRequest) (*pb.ListMySessionsReply, error) {
+	claims, ok := middleware.GetClaimsFromContext(ctx)
+	if !ok {
+		return &pb.ListMySessionsReply{}, nil
+	}
+
+	sessions, err := s.user.ListSessions(ctx, claims.UserID, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListMySessionsReply{
+		Sessions: make([]*pb.SessionInfo, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		reply.Sessions = append(reply.Sessions, toSessionInfo(session))
+	}
+	return reply, nil
+}
+
+func (s *UserService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionReply, error) {
+	userID, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return &pb.RevokeSessionReply{
+			Success: false,
+			Message: "未找到用户信息",
+		}, nil
+	}
+
+	revoked, err := s.user.RevokeSession(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return &pb.RevokeSessionReply{
+			Success: false,
+			Message: "会话不存在或已撤销",
+		}, nil
+	}
+	return &pb.RevokeSessionReply{
+		Success: true,
+		Message: "会话已撤销",
+	}, nil
+}
+
+func (s *UserService) CreateOAuthClient(ctx context.Context, req *pb.CreateOAuthClientRequest) (*pb.CreateOAuthClientReply, error) {
+	userID, _ := ctx.Value("user_id").(uint)
+
+	result, err := s.oidc.CreateClient(ctx, userID, req.Name, req.RedirectUris, req.Public)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.CreateOAuthClientReply{
+		Success:      result.Success,
+		Message:      result.Message,
+		ClientSecret: result.ClientSecret,
+	}
+	if result.Client != nil {
+		reply.Client = toOAuthClientInfo(result.Client)
+	}
+	return reply, nil
+}
+
+func (s *UserService) ListOAuthClients(ctx context.Context, req *pb.ListOAuthClientsRequest) (*pb.ListOAuthClientsReply, error) {
+	userID, _ := ctx.Value("user_id").(uint)
+	clients, err := s.oidc.ListClients(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListOAuthClientsReply{
+		Clients: make([]*pb.OAuthClientInfo, 0, len(clients)),
+	}
+	for _, client := range clients {
+		reply.Clients = append(reply.Clients, toOAuthClientInfo(client))
+	}
+	return reply, nil
+}
+
+func (s *UserService) DeleteOAuthClient(ctx context.Context, req *pb.DeleteOAuthClientRequest) (*pb.DeleteOAuthClientReply, error) {
+	userID, _ := ctx.Value("user_id").(uint)
+	deleted, err := s.oidc.DeleteClient(ctx, userID, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return &pb.DeleteOAuthClientReply{
+			Success: false,
+			Message: "客户端不存在",
+		}, nil
+	}
+	return &pb.DeleteOAuthClientReply{
+		Success: true,
+		Message: "客户端已删除",
+	}, nil
+}
+
 func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
 	s.log.Info("user register", req.Username, req.Email, req.Phone, req.Age, req.Avatar)
 
@@ -270,3 +789,110 @@ func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 
 	return reply, nil
 }
+
+// 转换为不包含密码的用户信息
+func toUserInfo(u *biz.User) *pb.UserInfo {
+	return &pb.UserInfo{
+		Id:            int32(u.ID),
+		Username:      u.Username,
+		Email:         u.Email,
+		Phone:         u.Phone,
+		Status:        int32(u.Status),
+		Age:           int32(u.Age),
+		Avatar:        u.Avatar,
+		CreatedAt:     u.CreatedAtStr,
+		UpdatedAt:     u.UpdatedAtStr,
+		MfaEnabled:    u.MFAEnabled,
+		EmailVerified: u.EmailVerifiedAt != nil,
+	}
+}
+
+// 转换为不包含密钥的 API Key 信息
+func toAPIKeyInfo(k *biz.APIKey) *pb.APIKeyInfo {
+	return &pb.APIKeyInfo{
+		Id:         int32(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.ScopeList(),
+		ExpiresAt:  formatTime(k.ExpiresAt),
+		LastUsedAt: formatTime(k.LastUsedAt),
+		RevokedAt:  formatTime(k.RevokedAt),
+		CreatedAt:  formatTime(k.CreatedAt),
+	}
+}
+
+func toSessionInfo(session *biz.Session) *pb.SessionInfo {
+	return &pb.SessionInfo{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		Ip:         session.IP,
+		CreatedAt:  formatTime(session.CreatedAt),
+		LastSeenAt: formatTime(session.LastSeenAt),
+		Current:    session.Current,
+	}
+}
+
+func toOAuthClientInfo(client *biz.OAuthClient) *pb.OAuthClientInfo {
+	return &pb.OAuthClientInfo{
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIList(),
+		Public:       client.Public(),
+		CreatedAt:    formatTime(client.CreatedAt),
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(biz.TimeFormat)
+}
+
+// 回收站中的用户，带删除时间
+func toDeletedUserProto(u *biz.User) *pb.Users {
+	return &pb.Users{
+		Id:        int32(u.ID),
+		Username:  u.Username,
+		Email:     u.Email,
+		Phone:     u.Phone,
+		Status:    int32(u.Status),
+		Age:       int32(u.Age),
+		Avatar:    u.Avatar,
+		CreatedAt: u.CreatedAtStr,
+		UpdatedAt: u.UpdatedAtStr,
+		Version:   uint32(u.Version),
+		DeletedAt: biz.DeletedAtStr(u.DeletedAt),
+	}
+}
+
+func (s *UserService) ListDeletedUsers(ctx context.Context, req *pb.ListDeletedUsersRequest) (*pb.ListDeletedUsersReply, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	users, total, err := s.recycle.ListDeletedUsers(ctx, operatorID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.Users, 0, len(users))
+	for _, u := range users {
+		data = append(data, toDeletedUserProto(u))
+	}
+	return &pb.ListDeletedUsersReply{Data: data, Total: total}, nil
+}
+
+func (s *UserService) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserReply, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	u, err := s.recycle.RestoreUser(ctx, operatorID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	middleware.SetETag(ctx, u.Version)
+	return &pb.RestoreUserReply{User: toDeletedUserProto(u)}, nil
+}
+
+func (s *UserService) PurgeUser(ctx context.Context, req *pb.PurgeUserRequest) (*pb.PurgeUserReply, error) {
+	operatorID, _ := ctx.Value("user_id").(uint)
+	if err := s.recycle.PurgeUser(ctx, operatorID, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.PurgeUserReply{Message: "Purge user success"}, nil
+}
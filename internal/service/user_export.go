@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	pb "student/api/user/v1"
+	"student/internal/biz"
+	"student/internal/pkg/spreadsheet"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+var userExportHeader = []string{"id", "username", "email", "phone", "status", "age", "email_verified_at", "created_at", "updated_at"}
+
+func (s *UserService) exportUsers(ctx context.Context, username, email, format string, w io.Writer) error {
+	return writeExport(w, format, userExportHeader, func(write func([]any) error) error {
+		return s.user.Export(ctx, username, email, func(u *biz.User) error {
+			return write([]any{u.ID, u.Username, u.Email, u.Phone, u.Status, u.Age, formatTime(u.EmailVerifiedAt), u.CreatedAtStr, u.UpdatedAtStr})
+		})
+	})
+}
+
+func (s *UserService) ExportUsers(req *pb.ExportUsersRequest, stream pb.User_ExportUsersServer) error {
+	format, err := exportFormat(req.Format, "")
+	if err != nil {
+		return err
+	}
+	contentType := spreadsheet.ContentType(format)
+	return s.exportUsers(stream.Context(), req.Username, req.Email, format, chunkSender(func(data []byte) error {
+		chunk := &pb.ExportChunk{Data: data, ContentType: contentType}
+		contentType = ""
+		return stream.Send(chunk)
+	}))
+}
+
+// DownloadUsers 通过 HTTP 下载导出的用户，格式由 format 查询参数或 Accept 请求头决定
+func (s *UserService) DownloadUsers(ctx http.Context) error {
+	r := ctx.Request()
+	format, err := exportFormat(r.URL.Query().Get("format"), r.Header.Get("Accept"))
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	req := &pb.ExportUsersRequest{Username: query.Get("username"), Email: query.Get("email"), Format: format}
+	return streamDownload(ctx, s.log, pb.User_ExportUsers_FullMethodName, req, "users", format, func(ctx context.Context, w io.Writer) error {
+		return s.exportUsers(ctx, req.Username, req.Email, format, w)
+	})
+}
@@ -14,21 +14,27 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, rbacUC *biz.RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Bootstrap, student *service.StudentService, rbacUC *biz.RBACUsecase, userUC *biz.UserUsecase, apiKeyUC *biz.APIKeyUsecase, impersonationUC *biz.ImpersonationUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			// JWT认证中间件
 			middleware.JWTAuth(&middleware.JWTConfig{
-				JWTUtil: jwtUtil,
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
+				Sessions:   userUC,
+				Audit:      impersonationUC,
 				SkipPaths: []string{
 					"/student.v1.Student/HealthCheck",
 				},
 			}),
 			// RBAC权限中间件
 			middleware.RBACMiddleware(&middleware.RBACConfig{
-				RBACUC:  rbacUC,
-				JWTUtil: jwtUtil,
+				RBACUC:     rbacUC,
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
 				SkipPaths: []string{
 					"/student.v1.Student/HealthCheck",
 				},
@@ -15,13 +15,17 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, rbacUC *biz.RBACUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, rbacUC *biz.RBACUsecase, userUC *biz.UserUsecase, apiKeyUC *biz.APIKeyUsecase, impersonationUC *biz.ImpersonationUsecase, jwtUtil *jwt.JWTUtil, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			// JWT认证中间件
 			middleware.JWTAuth(&middleware.JWTConfig{
-				JWTUtil: jwtUtil,
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
+				Sessions:   userUC,
+				Audit:      impersonationUC,
 				SkipPaths: []string{
 					"/health",
 					"/v1/students/health",
@@ -29,8 +33,10 @@ func NewHTTPServer(c *conf.Bootstrap, student *service.StudentService, rbacUC *b
 			}),
 			// RBAC权限中间件
 			middleware.RBACMiddleware(&middleware.RBACConfig{
-				RBACUC:  rbacUC,
-				JWTUtil: jwtUtil,
+				RBACUC:     rbacUC,
+				JWTUtil:    jwtUtil,
+				Revocation: userUC,
+				APIKeys:    apiKeyUC,
 				SkipPaths: []string{
 					"/health",
 					"/v1/students/health",
@@ -72,6 +72,16 @@ func NewJWTConfig(c *conf.Bootstrap) *jwt.Config {
 	config := &jwt.Config{
 		SecretKey: c.Jwt.SecretKey,
 		Expire:    c.Jwt.Expire.AsDuration(),
+		Algorithm: c.Jwt.Algorithm,
+		ActiveKid: c.Jwt.ActiveKid,
+		JWKSURL:   c.Jwt.JwksUrl,
+	}
+	for _, k := range c.Jwt.Keys {
+		config.Keys = append(config.Keys, jwt.KeyConfig{
+			Kid:            k.Kid,
+			PrivateKeyFile: k.PrivateKeyFile,
+			PublicKeyFile:  k.PublicKeyFile,
+		})
 	}
 
 	// 添加调试日志
@@ -82,6 +92,6 @@ func NewJWTConfig(c *conf.Bootstrap) *jwt.Config {
 }
 
 // NewJWTUtil 创建JWT工具
-func NewJWTUtil(config *jwt.Config) *jwt.JWTUtil {
+func NewJWTUtil(config *jwt.Config) (*jwt.JWTUtil, error) {
 	return jwt.NewJWTUtil(config)
 }
@@ -15,6 +15,7 @@ func NewGRPCServer(c *conf.Bootstrap, user *service.UserService, logger log.Logg
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			implementedOnly(),
 		),
 	}
 
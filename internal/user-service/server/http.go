@@ -4,6 +4,7 @@ import (
 	stdhttp "net/http"
 	userV1 "student/api/user/v1"
 	"student/internal/conf"
+	"student/internal/pkg/jwt"
 	"student/internal/user-service/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -12,10 +13,11 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Bootstrap, user *service.UserService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, user *service.UserService, jwtUtil *jwt.JWTUtil, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			implementedOnly(),
 		),
 	}
 	if c.Server.Http.Network != "" {
@@ -32,6 +34,9 @@ func NewHTTPServer(c *conf.Bootstrap, user *service.UserService, logger log.Logg
 	// 注册用户服务
 	userV1.RegisterUserHTTPServer(srv, user)
 
+	// 公钥集合，供其他服务和网关验证token
+	srv.HandleFunc("/.well-known/jwks.json", jwtUtil.JWKSHandler())
+
 	// 添加健康检查端点
 	srv.HandleFunc("/health", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 		w.WriteHeader(stdhttp.StatusOK)
@@ -1,8 +1,39 @@
 package server
 
 import (
+	"context"
+
+	userV1 "student/api/user/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+
+// 独立的用户服务只实现了以下方法，刷新令牌、会话、MFA 等只由单体服务提供
+var implementedOperations = map[string]bool{
+	userV1.OperationUserGetMe:      true,
+	userV1.OperationUserGetUser:    true,
+	userV1.OperationUserCreateUser: true,
+	userV1.OperationUserUpdateUser: true,
+	userV1.OperationUserDeleteUser: true,
+	userV1.OperationUserListUsers:  true,
+	userV1.OperationUserLogin:      true,
+	userV1.OperationUserRegister:   true,
+}
+
+// 共享的 proto 会注册全部路由，未实现的方法按路由不存在处理
+func implementedOnly() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok && !implementedOperations[tr.Operation()] {
+				return nil, errors.NotFound("NOT_FOUND", "not found")
+			}
+			return handler(ctx, req)
+		}
+	}
+}
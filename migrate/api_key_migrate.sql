@@ -0,0 +1,17 @@
+-- 创建 API Key 表
+CREATE TABLE `api_keys` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL COMMENT '所属用户ID',
+  `name` varchar(100) CHARACTER SET utf8mb4 NOT NULL COMMENT '名称',
+  `prefix` varchar(16) CHARACTER SET utf8mb4 NOT NULL COMMENT '公开前缀，用于查找',
+  `key_hash` char(64) CHARACTER SET utf8mb4 NOT NULL COMMENT 'Key 的 SHA-256 哈希',
+  `scopes` varchar(1000) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '权限范围，逗号分隔',
+  `expires_at` datetime DEFAULT NULL COMMENT '过期时间，为空表示永不过期',
+  `last_used_at` datetime DEFAULT NULL COMMENT '最后使用时间',
+  `revoked_at` datetime DEFAULT NULL COMMENT '撤销时间',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_prefix` (`prefix`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='API Key 表';
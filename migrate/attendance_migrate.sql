@@ -0,0 +1,51 @@
+-- 创建点名表，同一日期和分组只有一次点名
+CREATE TABLE `attendance_sessions` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `date` date NOT NULL COMMENT '点名日期',
+  `group_label` varchar(100) CHARACTER SET utf8mb4 NOT NULL COMMENT '分组名称',
+  `teacher_id` int(11) NOT NULL COMMENT '点名教师的用户ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_date_group` (`date`, `group_label`),
+  KEY `idx_teacher_date` (`teacher_id`, `date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='点名表';
+
+-- 创建出勤记录表
+CREATE TABLE `attendance_records` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `session_id` int(11) NOT NULL COMMENT '点名ID',
+  `student_id` int(11) NOT NULL COMMENT '学生ID',
+  `status` tinyint(1) NOT NULL COMMENT '状态：1-出勤，2-缺勤，3-迟到，4-请假',
+  `note` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '备注',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_session_student` (`session_id`, `student_id`),
+  KEY `idx_student_id` (`student_id`),
+  CONSTRAINT `fk_attendance_records_session_id` FOREIGN KEY (`session_id`) REFERENCES `attendance_sessions` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='出勤记录表';
+
+-- 考勤相关错误
+INSERT INTO `errors` (`error_code`, `error_type`, `error_message`, `error_description`, `solution`) VALUES
+(2015, 'ATTENDANCE', 'Attendance owned by another teacher', '该班级当天的考勤已由其他教师提交', '请联系提交考勤的教师或管理员');
+
+-- 考勤权限，admin 和 manager 可以点名和查看自己的考勤，只有 admin 可以查看所有教师的考勤
+-- attendance:all 不是请求路径，由业务层检查，读权限不要使用 /v1/attendance* 这类会覆盖它的前缀
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('attendance:submit', '/v1/attendance/roll-calls', 'POST', '提交点名', 1),
+('attendance:read', '/v1/attendance/sessions*', 'GET', '查看点名记录', 1),
+('attendance:report', '/v1/attendance/absence-rates', 'GET', '查看缺勤率', 1),
+('attendance:read_all', 'attendance:all', 'GET', '查看所有教师的考勤', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('attendance:submit', 'attendance:read', 'attendance:report', 'attendance:read_all');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name IN ('attendance:submit', 'attendance:read', 'attendance:report');
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('attendance:submit', 'attendance:read', 'attendance:report', 'attendance:read_all');
@@ -0,0 +1,32 @@
+-- 创建审计日志表
+CREATE TABLE `audit_logs` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `actor_id` int(11) NOT NULL COMMENT '实际操作人ID',
+  `user_id` int(11) NOT NULL COMMENT '被操作或被模拟的用户ID',
+  `session_id` char(32) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '操作人的会话ID',
+  `action` varchar(50) CHARACTER SET utf8mb4 NOT NULL COMMENT '操作类型',
+  `method` varchar(10) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '请求方法',
+  `path` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '请求路径',
+  `reason` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '操作原因',
+  `ip` varchar(45) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '客户端IP',
+  `user_agent` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_actor_id` (`actor_id`, `created_at`),
+  KEY `idx_user_id` (`user_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审计日志表';
+
+-- 模拟登录权限，只分配给 admin 角色
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('user:impersonate', '/v1/impersonations', 'POST', '以其他用户身份登录', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name = 'user:impersonate';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('user:impersonate');
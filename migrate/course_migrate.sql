@@ -0,0 +1,79 @@
+-- 创建课程表
+CREATE TABLE `courses` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `code` varchar(50) CHARACTER SET utf8mb4 NOT NULL COMMENT '课程编号',
+  `name` varchar(100) CHARACTER SET utf8mb4 NOT NULL COMMENT '课程名称',
+  `description` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '课程描述',
+  `credits` decimal(4,1) NOT NULL DEFAULT 0 COMMENT '学分',
+  `status` tinyint(1) NOT NULL DEFAULT 1 COMMENT '状态：1-启用，0-禁用',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `deleted_at` datetime DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='课程表';
+
+-- 创建班级表，班级为课程在某个学期的开课
+CREATE TABLE `classes` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `course_id` int(11) NOT NULL COMMENT '课程ID',
+  `term` varchar(20) CHARACTER SET utf8mb4 NOT NULL COMMENT '学期',
+  `teacher` varchar(100) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '任课教师',
+  `capacity` int(11) NOT NULL COMMENT '容量',
+  `enrolled` int(11) NOT NULL DEFAULT 0 COMMENT '当前选课人数',
+  `status` tinyint(1) NOT NULL DEFAULT 1 COMMENT '状态：1-开放选课，0-关闭选课',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  `deleted_at` datetime DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_course_id` (`course_id`),
+  KEY `idx_term` (`term`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='班级表';
+
+-- 创建选课记录表，同一学生在同一班级只有一条记录，退课后重新选课复用该记录
+CREATE TABLE `enrollments` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `class_id` int(11) NOT NULL COMMENT '班级ID',
+  `student_id` int(11) NOT NULL COMMENT '学生ID',
+  `status` tinyint(1) NOT NULL DEFAULT 1 COMMENT '状态：1-已选课，2-已退课',
+  `enrolled_at` datetime DEFAULT NULL COMMENT '选课时间',
+  `dropped_at` datetime DEFAULT NULL COMMENT '退课时间',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_class_student` (`class_id`, `student_id`),
+  KEY `idx_student_id` (`student_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='选课记录表';
+
+-- 选课相关错误
+INSERT INTO `errors` (`error_code`, `error_type`, `error_message`, `error_description`, `solution`) VALUES
+(2009, 'COURSE', 'Class is full', '班级人数已满', '请选择其他班级或联系管理员扩容'),
+(2010, 'COURSE', 'Student already enrolled', '学生已选修该班级', '无需重复选课'),
+(2011, 'COURSE', 'Student not enrolled', '学生未选修该班级', '请确认班级和学生是否正确'),
+(2012, 'COURSE', 'Class closed', '班级未开放选课', '请等待班级开放选课'),
+(2013, 'COURSE', 'Capacity too small', '班级容量不能小于已选人数', '请先处理已选课学生或设置更大的容量'),
+(2014, 'COURSE', 'Course in use', '课程或班级仍被引用，不能删除', '请先删除课程下的班级或班级中的选课记录');
+
+-- 课程和班级权限，admin 拥有全部权限，manager 和 user 只读
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('course:read', '/v1/courses*', 'GET', '查看课程', 1),
+('course:manage', '/v1/courses*', '*', '管理课程', 1),
+('class:read', '/v1/classes*', 'GET', '查看班级和选课记录', 1),
+('class:manage', '/v1/classes*', '*', '管理班级和选课', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('course:read', 'course:manage', 'class:read', 'class:manage');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name IN ('course:read', 'class:read');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 3, id FROM `permissions` WHERE name IN ('course:read', 'class:read');
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('course:read', 'course:manage', 'class:read', 'class:manage');
@@ -23,4 +23,9 @@ INSERT INTO `errors` (`error_code`, `error_type`, `error_message`, `error_descri
 (2005, 'AUTH', 'Password too weak', '密码强度不够', '请使用包含字母、数字和特殊字符的强密码'),
 (2006, 'VALIDATION', 'Invalid phone number', '手机号格式无效', '请使用正确的手机号格式'),
 (2007, 'DATABASE', 'Database connection failed', '数据库连接失败', '请检查数据库配置或联系管理员'),
-(2008, 'EXTERNAL', 'External service unavailable', '外部服务不可用', '请稍后重试或联系技术支持');
\ No newline at end of file
+(2008, 'EXTERNAL', 'External service unavailable', '外部服务不可用', '请稍后重试或联系技术支持');
+
+-- 登录限流相关错误
+INSERT INTO `errors` (`error_code`, `error_type`, `error_message`, `error_description`, `solution`) VALUES
+(1004, 'AUTH', 'Account Locked', '登录失败次数过多，账户已被临时锁定', '请在 retry_after 秒后重试，或联系管理员解锁'),
+(1007, 'AUTH', 'Rate Limit Exceeded', '登录尝试过于频繁', '请在 retry_after 秒后重试');
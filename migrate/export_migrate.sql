@@ -0,0 +1,18 @@
+-- 学生和用户导出权限
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('student:export', '/v1/students/export', 'GET', '导出学生', 1),
+('user:export', '/v1/users/export', 'GET', '导出用户', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('student:export', 'user:export');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name = 'student:export';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('student:export', 'user:export');
@@ -0,0 +1,14 @@
+-- 创建外部账号关联表
+CREATE TABLE `external_identities` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL COMMENT '关联的用户ID',
+  `provider` varchar(50) CHARACTER SET utf8mb4 NOT NULL COMMENT '身份提供方名称',
+  `subject` varchar(255) CHARACTER SET utf8mb4 NOT NULL COMMENT '提供方返回的用户标识（sub）',
+  `email` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '提供方返回的邮箱',
+  `last_login_at` datetime DEFAULT NULL COMMENT '最后登录时间',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_subject` (`provider`, `subject`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='外部账号关联表';
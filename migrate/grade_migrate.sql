@@ -0,0 +1,37 @@
+-- 创建成绩表，同一学生、课程和学期只有一条成绩
+CREATE TABLE `grades` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `student_id` int(11) NOT NULL COMMENT '学生ID',
+  `course_id` int(11) NOT NULL COMMENT '课程ID',
+  `term` varchar(20) CHARACTER SET utf8mb4 NOT NULL COMMENT '学期',
+  `score` decimal(5,2) NOT NULL COMMENT '分数',
+  `letter` varchar(5) CHARACTER SET utf8mb4 NOT NULL COMMENT '等级，录入时根据评分等级换算',
+  `points` decimal(3,2) NOT NULL DEFAULT 0 COMMENT '绩点，录入时根据评分等级换算',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_student_course_term` (`student_id`, `course_id`, `term`),
+  KEY `idx_course_id` (`course_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='成绩表';
+
+-- 成绩权限，admin 和 manager 可以录入成绩，user 只读
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('grade:read', '/v1/grades*', 'GET', '查看成绩', 1),
+('grade:manage', '/v1/grades*', '*', '录入和删除成绩', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('grade:read', 'grade:manage');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name IN ('grade:read', 'grade:manage');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 3, id FROM `permissions` WHERE name = 'grade:read';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('grade:read', 'grade:manage');
@@ -0,0 +1,53 @@
+-- 创建监护人表，user_id 为监护人的登录账号
+CREATE TABLE `guardians` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `name` varchar(50) CHARACTER SET utf8mb4 NOT NULL COMMENT '姓名',
+  `phone` varchar(30) NOT NULL COMMENT '联系电话',
+  `email` varchar(100) NOT NULL DEFAULT '' COMMENT '邮箱',
+  `user_id` int(11) DEFAULT NULL COMMENT '关联的登录账号',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='监护人表';
+
+-- 创建学生监护人关联表，每个学生最多一个主要联系人
+CREATE TABLE `student_guardians` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `student_id` int(11) NOT NULL COMMENT '学生ID',
+  `guardian_id` int(11) NOT NULL COMMENT '监护人ID',
+  `relationship` varchar(20) NOT NULL COMMENT '监护关系：father、mother、grandparent、guardian、other',
+  `is_primary` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否主要联系人',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_student_guardian` (`student_id`, `guardian_id`),
+  KEY `idx_guardian_id` (`guardian_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='学生监护人关联表';
+
+-- 监护人角色，不授予学生相关权限，只能通过关联学生时写入的策略查看自己的孩子
+INSERT INTO `roles` (`name`, `description`, `status`) VALUES
+('guardian', '监护人，只能查看自己监护的学生', 1);
+
+-- 监护人管理权限
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('guardian:read', '/v1/student/*/guardians*', 'GET', '查看学生的监护人', 1),
+('guardian:manage', '/v1/student/*/guardians*', '*', '管理学生的监护人', 1),
+('guardian:link', '/v1/guardians*', '*', '关联监护人的登录账号', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('guardian:read', 'guardian:manage', 'guardian:link');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name IN ('guardian:read', 'guardian:manage');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 3, id FROM `permissions` WHERE name = 'guardian:read';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('guardian:read', 'guardian:manage', 'guardian:link');
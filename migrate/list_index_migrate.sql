@@ -0,0 +1,7 @@
+-- 学生列表常用的过滤和排序字段索引，InnoDB 二级索引包含主键，可以直接用于按 (字段, id) 的键集分页
+-- users 表的 status、created_at 索引已在 user_migrate.sql 中创建
+ALTER TABLE `students`
+  ADD INDEX `idx_status` (`status`),
+  ADD INDEX `idx_age` (`age`),
+  ADD INDEX `idx_name` (`name`),
+  ADD INDEX `idx_created_at` (`created_at`);
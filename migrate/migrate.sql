@@ -2,7 +2,7 @@ CREATE TABLE `students` (
   `id` int(11) NOT NULL AUTO_INCREMENT,
   `name` varchar(255) CHARACTER SET latin1 DEFAULT NULL,
   `info` varchar(255) CHARACTER SET latin1 DEFAULT NULL,
-  `status` varchar(255) CHARACTER SET latin1 DEFAULT NULL,
+  `status` tinyint(1) NOT NULL DEFAULT 0 COMMENT '学籍状态：0-申请中，1-在读，2-休学，3-毕业，4-退学',
   `age` int(10) unsigned not null default 0,
   `updated_at` datetime DEFAULT NULL,
   `created_at` datetime DEFAULT NULL,
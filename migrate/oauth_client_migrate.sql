@@ -0,0 +1,30 @@
+-- 创建单点登录客户端表
+CREATE TABLE `oauth_clients` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `client_id` char(32) CHARACTER SET utf8mb4 NOT NULL COMMENT '客户端ID',
+  `name` varchar(100) CHARACTER SET utf8mb4 NOT NULL COMMENT '应用名称',
+  `secret_hash` varchar(64) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '客户端密钥的 SHA-256 哈希，为空表示公开客户端',
+  `redirect_uris` varchar(2000) CHARACTER SET utf8mb4 NOT NULL COMMENT '允许的回调地址，空格分隔',
+  `created_by` int(11) NOT NULL DEFAULT 0 COMMENT '创建人ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_client_id` (`client_id`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='单点登录客户端表';
+
+-- 客户端管理权限，只分配给 admin 角色
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('oauth_client:read', '/v1/oauth/clients', 'GET', '查看单点登录客户端', 1),
+('oauth_client:create', '/v1/oauth/clients', 'POST', '注册单点登录客户端', 1),
+('oauth_client:delete', '/v1/oauth/clients/*', 'DELETE', '删除单点登录客户端', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('oauth_client:read', 'oauth_client:create', 'oauth_client:delete');
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('oauth_client:read', 'oauth_client:create', 'oauth_client:delete');
@@ -0,0 +1,20 @@
+-- 回收站按删除时间排序，定时清理按删除时间查找过期数据
+ALTER TABLE `students` ADD KEY `idx_deleted_at` (`deleted_at`);
+ALTER TABLE `users` ADD KEY `idx_deleted_at` (`deleted_at`);
+ALTER TABLE `roles` ADD KEY `idx_deleted_at` (`deleted_at`);
+ALTER TABLE `permissions` ADD KEY `idx_deleted_at` (`deleted_at`);
+
+-- 回收站权限，默认只授予管理员
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('recycle_bin:manage', '/v1/recycle-bin*', '*', '查看、恢复和彻底删除回收站中的数据', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name = 'recycle_bin:manage';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('recycle_bin:manage');
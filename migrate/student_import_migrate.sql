@@ -0,0 +1,17 @@
+-- 批量导入学生权限，multipart 上传地址 /v1/students/import/upload 使用相同的权限
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('student:import', '/v1/students/import*', 'POST', '批量导入学生', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name = 'student:import';
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name = 'student:import';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('student:import');
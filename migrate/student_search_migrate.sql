@@ -0,0 +1,23 @@
+-- 学生全文搜索：姓名和备注上的 FULLTEXT 索引，使用 ngram 解析器支持中文部分匹配
+-- 默认 ngram_token_size 为 2，少于两个字的关键词在 MySQL 实现中搜索不到
+ALTER TABLE `students` ADD FULLTEXT INDEX `ft_name_info` (`name`, `info`) WITH PARSER ngram;
+
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('student:search', '/v1/students/search', 'GET', '搜索学生', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name = 'student:search';
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name = 'student:search';
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 3, id FROM `permissions` WHERE name = 'student:search';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('student:search');
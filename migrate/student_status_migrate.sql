@@ -0,0 +1,61 @@
+-- 学籍状态改为整数，无法识别的旧值按申请中处理
+UPDATE `students` SET `status` = '0' WHERE `status` IS NULL OR `status` NOT IN ('0', '1', '2', '3', '4');
+ALTER TABLE `students` MODIFY `status` tinyint(1) NOT NULL DEFAULT 0 COMMENT '学籍状态：0-申请中，1-在读，2-休学，3-毕业，4-退学';
+
+-- 创建学期表，学期之间的日期不重叠
+CREATE TABLE `academic_terms` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `code` varchar(20) CHARACTER SET utf8mb4 NOT NULL COMMENT '学期编号',
+  `name` varchar(100) CHARACTER SET utf8mb4 NOT NULL COMMENT '学期名称',
+  `start_date` date NOT NULL COMMENT '开始日期',
+  `end_date` date NOT NULL COMMENT '结束日期',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_code` (`code`),
+  KEY `idx_start_end` (`start_date`, `end_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='学期表';
+
+-- 已有班级的学期需要先在学期表中创建，之后创建和修改班级时会校验学期编号
+
+-- 创建学籍变动记录表
+CREATE TABLE `student_status_changes` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `student_id` int(11) NOT NULL COMMENT '学生ID',
+  `from_status` tinyint(1) NOT NULL COMMENT '变动前状态',
+  `to_status` tinyint(1) NOT NULL COMMENT '变动后状态',
+  `term_id` int(11) NOT NULL COMMENT '学期ID',
+  `reason` varchar(255) CHARACTER SET utf8mb4 NOT NULL COMMENT '变动原因',
+  `operator_id` int(11) NOT NULL DEFAULT 0 COMMENT '操作人的用户ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_student_id` (`student_id`),
+  KEY `idx_term_id` (`term_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='学籍变动记录表';
+
+-- 学籍相关错误
+INSERT INTO `errors` (`error_code`, `error_type`, `error_message`, `error_description`, `solution`) VALUES
+(2016, 'STUDENT', 'Invalid status transition', '不允许的学籍状态变动', '请检查学生当前的学籍状态，毕业和退学后不能再变动');
+
+-- 学期和学籍变动权限
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('term:read', '/v1/terms*', 'GET', '查看学期', 1),
+('term:manage', '/v1/terms*', '*', '管理学期', 1),
+('student:status', '/v1/student/*/status', 'POST', '变更学籍状态', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('term:read', 'term:manage', 'student:status');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 2, id FROM `permissions` WHERE name IN ('term:read', 'student:status');
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 3, id FROM `permissions` WHERE name = 'term:read';
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('term:read', 'term:manage', 'student:status');
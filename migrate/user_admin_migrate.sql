@@ -0,0 +1,16 @@
+-- 用户管理接口的权限，只分配给 admin 角色
+-- 用例中会再次检查这些权限，不依赖 RBAC 中间件是否生效
+INSERT INTO `permissions` (`name`, `resource`, `action`, `description`, `status`) VALUES
+('user:revoke_sessions', '/v1/user/*/sessions/revoke', 'POST', '撤销指定用户的全部会话', 1),
+('user:unlock', '/v1/user/*/unlock', 'POST', '解除账户锁定', 1);
+
+INSERT INTO `role_permissions` (`role_id`, `permission_id`)
+SELECT 1, id FROM `permissions` WHERE name IN ('user:revoke_sessions', 'user:unlock');
+
+-- 写入对应的 Casbin 策略，与通过接口为角色分配权限时一致
+INSERT INTO `casbin_rule` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', r.name, p.resource, p.action
+FROM `role_permissions` rp
+JOIN `roles` r ON r.id = rp.role_id
+JOIN `permissions` p ON p.id = rp.permission_id
+WHERE p.name IN ('user:revoke_sessions', 'user:unlock');
@@ -0,0 +1,3 @@
+-- 已有用户表增加邮箱验证字段
+ALTER TABLE `users`
+  ADD COLUMN `email_verified_at` datetime DEFAULT NULL COMMENT '邮箱验证时间' AFTER `mfa_recovery_codes`;
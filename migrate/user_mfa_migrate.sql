@@ -0,0 +1,5 @@
+-- 已有用户表增加两步验证字段
+ALTER TABLE `users`
+  ADD COLUMN `mfa_enabled` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否启用两步验证' AFTER `avatar`,
+  ADD COLUMN `mfa_secret` varchar(64) CHARACTER SET utf8mb4 DEFAULT NULL COMMENT '两步验证密钥' AFTER `mfa_enabled`,
+  ADD COLUMN `mfa_recovery_codes` text CHARACTER SET utf8mb4 COMMENT '两步验证恢复码哈希' AFTER `mfa_secret`;
@@ -8,6 +8,10 @@ CREATE TABLE `users` (
   `status` int(11) NOT NULL DEFAULT '1' COMMENT '状态：1-正常，0-禁用',
   `age` int(11) DEFAULT '0' COMMENT '年龄',
   `avatar` varchar(500) CHARACTER SET utf8mb4 DEFAULT NULL COMMENT '头像URL',
+  `mfa_enabled` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否启用两步验证',
+  `mfa_secret` varchar(64) CHARACTER SET utf8mb4 DEFAULT NULL COMMENT '两步验证密钥',
+  `mfa_recovery_codes` text CHARACTER SET utf8mb4 COMMENT '两步验证恢复码哈希',
+  `email_verified_at` datetime DEFAULT NULL COMMENT '邮箱验证时间',
   `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   `deleted_at` datetime DEFAULT NULL COMMENT '删除时间',
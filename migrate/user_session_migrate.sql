@@ -0,0 +1,12 @@
+-- 创建登录会话表
+CREATE TABLE `user_sessions` (
+  `id` char(32) CHARACTER SET utf8mb4 NOT NULL COMMENT '会话ID，与令牌族ID一致',
+  `user_id` int(11) NOT NULL COMMENT '所属用户ID',
+  `user_agent` varchar(255) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+  `ip` varchar(45) CHARACTER SET utf8mb4 NOT NULL DEFAULT '' COMMENT '登录 IP',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `last_seen_at` datetime DEFAULT NULL COMMENT '最后活跃时间',
+  `revoked_at` datetime DEFAULT NULL COMMENT '撤销时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='登录会话表';
@@ -0,0 +1,5 @@
+-- 乐观锁版本号，已有数据的版本号为 1，每次修改加一
+ALTER TABLE `students` ADD COLUMN `version` int(10) unsigned NOT NULL DEFAULT '1' COMMENT '版本号' AFTER `age`;
+ALTER TABLE `users` ADD COLUMN `version` int(10) unsigned NOT NULL DEFAULT '1' COMMENT '版本号' AFTER `avatar`;
+ALTER TABLE `roles` ADD COLUMN `version` int(10) unsigned NOT NULL DEFAULT '1' COMMENT '版本号' AFTER `status`;
+ALTER TABLE `permissions` ADD COLUMN `version` int(10) unsigned NOT NULL DEFAULT '1' COMMENT '版本号' AFTER `status`;